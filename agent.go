@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type AgentStart struct {
+	IdleTimeout time.Duration
+	MaxLifetime time.Duration
+	Foreground  bool
+}
+
+func (a *AgentStart) Run(store vaulted.Store) error {
+	if vaulted.AgentRunning() {
+		return vaulted.ErrAgentAlreadyRunning
+	}
+
+	if a.Foreground {
+		return a.serve()
+	}
+
+	return a.daemonize()
+}
+
+func (a *AgentStart) serve() error {
+	listener, err := vaulted.ListenAgent()
+	if err != nil {
+		return err
+	}
+
+	agent := vaulted.NewAgent(a.IdleTimeout, a.MaxLifetime)
+
+	// keys are wiped when the agent is terminated
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	go func() {
+		if _, ok := <-signals; ok {
+			agent.Stop()
+		}
+	}()
+
+	return agent.Serve(listener)
+}
+
+func (a *AgentStart) daemonize() error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(
+		executable, "agent", "start", "--foreground",
+		"--idle-timeout", a.IdleTimeout.String(),
+		"--max-lifetime", a.MaxLifetime.String(),
+	)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+	cmd.Process.Release()
+
+	// wait for the agent to start listening
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(50 * time.Millisecond) {
+		if vaulted.AgentRunning() {
+			return nil
+		}
+	}
+
+	return vaulted.ErrAgentNotRunning
+}
+
+type AgentStop struct{}
+
+func (s *AgentStop) Run(store vaulted.Store) error {
+	return vaulted.StopAgent()
+}
+
+type AgentLock struct{}
+
+func (l *AgentLock) Run(store vaulted.Store) error {
+	return vaulted.LockAgent()
+}
+
+type AgentStatus struct{}
+
+func (s *AgentStatus) Run(store vaulted.Store) error {
+	status, err := vaulted.GetAgentStatus()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Agent running (pid %d)\n", status.PID)
+	fmt.Printf("Idle timeout: %s\n", status.IdleTimeout)
+	fmt.Printf("Max lifetime: %s\n", status.MaxLifetime)
+
+	for _, v := range status.Vaults {
+		fmt.Printf("%s  expires %s\n", v.Name, v.Expires.Format("2 Jan 2006 15:04:05 MST"))
+	}
+
+	return nil
+}
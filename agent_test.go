@@ -0,0 +1,32 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestAgentNotRunning(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(path string) {
+		vaulted.AgentSocketPath = path
+	}(vaulted.AgentSocketPath)
+	vaulted.AgentSocketPath = filepath.Join(dir, "agent.sock")
+
+	store := NewTestStore()
+
+	for _, command := range []Command{&AgentStop{}, &AgentLock{}, &AgentStatus{}} {
+		err = command.Run(store)
+		if err != vaulted.ErrAgentNotRunning {
+			t.Errorf("Expected ErrAgentNotRunning from %T, got: %v", command, err)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/pflag"
@@ -19,12 +20,22 @@ var (
 	ErrNotEnoughArguments          = ErrorWithExitCode{errors.New("not enough arguments provided"), EX_USAGE_ERROR}
 	ErrVaultNameRequired           = ErrorWithExitCode{errors.New("A vault name must be specified"), EX_USAGE_ERROR}
 	ErrMixingCommandAndInteractive = ErrorWithExitCode{errors.New("Cannot mix an interactive shell with command arguments"), EX_USAGE_ERROR}
-
-	ErrUnknownShell = errors.New("Unknown shell")
+	ErrInvalidKeyMethod            = ErrorWithExitCode{fmt.Errorf("Invalid key derivation method (valid methods: %s)", strings.Join(vaulted.KeyMethods, ", ")), EX_USAGE_ERROR}
+	ErrInvalidMethod               = ErrorWithExitCode{fmt.Errorf("Invalid cipher (valid ciphers: %s)", strings.Join(vaulted.Methods, ", ")), EX_USAGE_ERROR}
+	ErrInvalidVersion              = ErrorWithExitCode{errors.New("Invalid vault version"), EX_USAGE_ERROR}
+	ErrInvalidDuration             = ErrorWithExitCode{errors.New("Invalid duration"), EX_USAGE_ERROR}
+	ErrInvalidFormat               = ErrorWithExitCode{errors.New("Invalid format (valid formats: text, json)"), EX_USAGE_ERROR}
+	ErrConflictingKeyfileOptions   = ErrorWithExitCode{errors.New("Cannot both add and remove a keyfile"), EX_USAGE_ERROR}
+	ErrInvalidConflictMode         = ErrorWithExitCode{errors.New("Invalid conflict handling (valid options: skip, rename, overwrite)"), EX_USAGE_ERROR}
+	ErrAllWithVaultNames           = ErrorWithExitCode{errors.New("Cannot specify vault names with --all"), EX_USAGE_ERROR}
+	ErrOutputRequired              = ErrorWithExitCode{errors.New("An output file must be specified with --output"), EX_USAGE_ERROR}
+	ErrTargetRequiresCalibrate     = ErrorWithExitCode{errors.New("--target can only be used with --calibrate"), EX_USAGE_ERROR}
 )
 
 var (
 	HelpRequested bool
+	VaultDir      string
+	Keyfile       string
 )
 
 type Command interface {
@@ -89,6 +100,12 @@ func parseArgs(args []string) (Command, error) {
 	case "add", "create", "new":
 		return parseAddArgs(commandArgs[1:])
 
+	case "agent":
+		return parseAgentArgs(commandArgs[1:])
+
+	case "config":
+		return parseConfigArgs(commandArgs[1:])
+
 	case "cp", "copy":
 		return parseCopyArgs(commandArgs[1:])
 
@@ -104,12 +121,24 @@ func parseArgs(args []string) (Command, error) {
 	case "exec":
 		return parseExecArgs(commandArgs[1:])
 
+	case "export":
+		return parseExportArgs(commandArgs[1:])
+
+	case "fix-permissions":
+		return parseFixPermissionsArgs(commandArgs[1:])
+
 	case "help":
 		return parseHelpArgs(commandArgs[1:])
 
+	case "history":
+		return parseHistoryArgs(commandArgs[1:])
+
 	case "ls", "list":
 		return parseListArgs(commandArgs[1:])
 
+	case "import":
+		return parseImportArgs(commandArgs[1:])
+
 	case "load":
 		return parseLoadArgs(commandArgs[1:])
 
@@ -119,12 +148,30 @@ func parseArgs(args []string) (Command, error) {
 	case "rm", "delete", "remove":
 		return parseRemoveArgs(commandArgs[1:])
 
+	case "recipients":
+		return parseRecipientsArgs(commandArgs[1:])
+
+	case "restore":
+		return parseRestoreArgs(commandArgs[1:])
+
+	case "session":
+		return parseSessionArgs(commandArgs[1:])
+
 	case "shell":
 		return parseShellArgs(commandArgs[1:])
 
+	case "sync":
+		return parseSyncArgs(commandArgs[1:])
+
+	case "trash":
+		return parseTrashArgs(commandArgs[1:])
+
 	case "upgrade":
 		return parseUpgradeArgs(commandArgs[1:])
 
+	case "verify":
+		return parseVerifyArgs(commandArgs[1:])
+
 	case "version":
 		return &Version{}, nil
 
@@ -139,6 +186,8 @@ func spawnFlagSet() *pflag.FlagSet {
 	flag.StringP("name", "n", "", "Name of the vault to use")
 	flag.BoolP("interactive", "i", false, "Spawn interactive shell (if -n is used, but no additional arguments a provided, interactive is the default)")
 	flag.BoolP("version", "V", false, "Specify current version of Vaulted")
+	flag.StringVar(&VaultDir, "dir", "", "Directory to store vaults in (instead of the XDG data directories)")
+	flag.StringVar(&Keyfile, "keyfile", "", "Keyfile to use for vaults that require one")
 	return flag
 }
 
@@ -172,7 +221,7 @@ func parseSpawnArgs(args []string) (Command, error) {
 	s := &Spawn{}
 	s.VaultName = name
 	if interactive || flag.NArg() == 0 {
-		s.Command = interactiveShellCommand()
+		s.Command = interactiveShellCommand(name)
 		s.DisplayStatus = true
 	} else {
 		s.Command = flag.Args()
@@ -180,8 +229,79 @@ func parseSpawnArgs(args []string) (Command, error) {
 	return s, nil
 }
 
+func parseAgentArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted agent")
+	flag.SetInterspersed(false)
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	subcommandArgs := flag.Args()
+	switch subcommandArgs[0] {
+	case "start":
+		return parseAgentStartArgs(subcommandArgs[1:])
+
+	case "stop":
+		return parseAgentNoArgs("vaulted agent stop", subcommandArgs[1:], &AgentStop{})
+
+	case "lock":
+		return parseAgentNoArgs("vaulted agent lock", subcommandArgs[1:], &AgentLock{})
+
+	case "status":
+		return parseAgentNoArgs("vaulted agent status", subcommandArgs[1:], &AgentStatus{})
+
+	default:
+		return nil, fmt.Errorf("Unknown agent command: %s", subcommandArgs[0])
+	}
+}
+
+func parseAgentStartArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted agent start")
+	flag.Duration("idle-timeout", vaulted.DefaultAgentIdleTimeout, "Wipe keys that haven't been used for this long")
+	flag.Duration("max-lifetime", vaulted.DefaultAgentMaxLifetime, "Wipe keys that have been held for this long")
+	flag.Bool("foreground", false, "Run the agent in the foreground")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	a := &AgentStart{}
+	a.IdleTimeout, _ = flag.GetDuration("idle-timeout")
+	a.MaxLifetime, _ = flag.GetDuration("max-lifetime")
+	a.Foreground, _ = flag.GetBool("foreground")
+	if a.IdleTimeout <= 0 || a.MaxLifetime <= 0 {
+		return nil, ErrInvalidDuration
+	}
+	return a, nil
+}
+
+func parseAgentNoArgs(name string, args []string, command Command) (Command, error) {
+	flag := NewFlagSet(name)
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return command, nil
+}
+
 func parseAddArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted add")
+	flag.String("kdf", "", "Key derivation method to use for the new vault")
+	flag.String("cipher", "", "Encryption method to use for the new vault")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -198,11 +318,141 @@ func parseAddArgs(args []string) (Command, error) {
 	e := &edit.Edit{}
 	e.New = true
 	e.VaultName = flag.Arg(0)
+	e.SealOptions, err = parseSealOptions(flag)
+	if err != nil {
+		return nil, err
+	}
 	return e, nil
 }
 
+func parseConfigArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted config")
+	flag.SetInterspersed(false)
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	subcommandArgs := flag.Args()
+	switch subcommandArgs[0] {
+	case "ls", "list":
+		return parseConfigListArgs(subcommandArgs[1:])
+
+	case "get":
+		return parseConfigGetArgs(subcommandArgs[1:])
+
+	case "set":
+		return parseConfigSetArgs(subcommandArgs[1:])
+
+	case "unset":
+		return parseConfigUnsetArgs(subcommandArgs[1:])
+
+	default:
+		return nil, fmt.Errorf("Unknown config command: %s", subcommandArgs[0])
+	}
+}
+
+func configFlagSet(name string) *pflag.FlagSet {
+	flag := NewFlagSet(name)
+	flag.String("vault", "", "Use the settings of the vault (instead of the global settings)")
+	return flag
+}
+
+func parseConfigListArgs(args []string) (Command, error) {
+	flag := configFlagSet("vaulted config list")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	l := &ConfigList{}
+	l.VaultName, _ = flag.GetString("vault")
+	return l, nil
+}
+
+func parseConfigGetArgs(args []string) (Command, error) {
+	flag := configFlagSet("vaulted config get")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkConfigSettingArgs(flag, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &ConfigGet{}
+	g.Setting = flag.Arg(0)
+	g.VaultName, _ = flag.GetString("vault")
+	return g, nil
+}
+
+func parseConfigSetArgs(args []string) (Command, error) {
+	flag := configFlagSet("vaulted config set")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkConfigSettingArgs(flag, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ConfigSet{}
+	s.Setting = flag.Arg(0)
+	s.Value = flag.Arg(1)
+	s.VaultName, _ = flag.GetString("vault")
+	return s, nil
+}
+
+func parseConfigUnsetArgs(args []string) (Command, error) {
+	flag := configFlagSet("vaulted config unset")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkConfigSettingArgs(flag, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &ConfigUnset{}
+	u.Setting = flag.Arg(0)
+	u.VaultName, _ = flag.GetString("vault")
+	return u, nil
+}
+
+// checkConfigSettingArgs checks that the arguments are a known setting
+// followed by the number of values required by the subcommand.
+func checkConfigSettingArgs(flag *pflag.FlagSet, nargs int) error {
+	if flag.NArg() < nargs {
+		return ErrNotEnoughArguments
+	}
+	if flag.NArg() > nargs {
+		return ErrTooManyArguments
+	}
+
+	if findSetting(flag.Arg(0)) == nil {
+		return ErrUnknownSetting
+	}
+	return nil
+}
+
 func parseCopyArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted copy")
+	flag.String("kdf", "", "Key derivation method to use for the new vault")
+	flag.String("cipher", "", "Encryption method to use for the new vault")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -219,6 +469,10 @@ func parseCopyArgs(args []string) (Command, error) {
 	c := &Copy{}
 	c.OldVaultName = flag.Arg(0)
 	c.NewVaultName = flag.Arg(1)
+	c.SealOptions, err = parseSealOptions(flag)
+	if err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
@@ -300,12 +554,7 @@ func parseEnvArgs(args []string) (Command, error) {
 		}
 	}
 
-	shell, err := detectShell()
-	if err == ErrUnknownShell {
-		shell = "sh"
-	}
-
-	e.DetectedShell = shell
+	e.DetectedShell = detectShell(e.VaultName)
 	e.Command = strings.Join(os.Args, " ")
 
 	e.Interactive = true
@@ -405,8 +654,31 @@ func parseHelpArgs(args []string) (Command, error) {
 	return &h, nil
 }
 
+func parseHistoryArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted history")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	h := &History{}
+	h.VaultName = flag.Arg(0)
+	return h, nil
+}
+
 func parseListArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted list")
+	flag.BoolP("long", "l", false, "List the metadata of each vault")
+	flag.StringArray("tag", nil, "Only list vaults with this tag (may be repeated)")
+	flag.String("format", "text", "Output format (text or json)")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -416,7 +688,50 @@ func parseListArgs(args []string) (Command, error) {
 		return nil, ErrTooManyArguments
 	}
 
-	return &List{Active: os.Getenv("VAULTED_ENV")}, nil
+	l := &List{Active: os.Getenv("VAULTED_ENV")}
+	l.Long, _ = flag.GetBool("long")
+	if tags, _ := flag.GetStringArray("tag"); len(tags) > 0 {
+		l.Tags = tags
+	}
+	l.Format, _ = flag.GetString("format")
+	switch l.Format {
+	case "text":
+		l.Format = ""
+	case "json":
+	default:
+		return nil, ErrInvalidFormat
+	}
+	return l, nil
+}
+
+func parseImportArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted import")
+	flag.String("on-conflict", ConflictSkip, "How to handle vaults that already exist (skip, rename, or overwrite)")
+	flag.Bool("bundle-password", false, "Seal the imported vaults with the bundle's password")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	i := &Import{}
+	i.Bundle = flag.Arg(0)
+	if flag.NArg() > 1 {
+		i.VaultNames = flag.Args()[1:]
+	}
+	i.BundlePassword, _ = flag.GetBool("bundle-password")
+
+	i.OnConflict, _ = flag.GetString("on-conflict")
+	switch i.OnConflict {
+	case ConflictSkip, ConflictRename, ConflictOverwrite:
+	default:
+		return nil, ErrInvalidConflictMode
+	}
+
+	return i, nil
 }
 
 func parseLoadArgs(args []string) (Command, error) {
@@ -441,27 +756,169 @@ func parseLoadArgs(args []string) (Command, error) {
 
 func parsePasswdArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted passwd")
+	flag.Bool("all", false, "Change the password of all vaults")
+	flag.String("kdf", "", "Key derivation method to use for the vault (e.g. to migrate to argon2id)")
+	flag.String("cipher", "", "Encryption method to use for the vault (e.g. to re-encrypt with aes-256-gcm)")
+	flag.Bool("calibrate", false, "Calibrate the key derivation parameters to this machine")
+	flag.Duration("target", vaulted.DefaultCalibrationTarget, "How long deriving the key should take when calibrating")
+	flag.String("add-keyfile", "", "Require a keyfile (in addition to the password) to open the vault")
+	flag.Bool("remove-keyfile", false, "Remove the keyfile requirement of the vault")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
 	}
 
-	if flag.NArg() < 1 {
+	all, _ := flag.GetBool("all")
+	if all && flag.NArg() > 0 {
+		return nil, ErrAllWithVaultNames
+	}
+
+	if !all && flag.NArg() < 1 {
 		return nil, ErrNotEnoughArguments
 	}
 
-	if flag.NArg() > 1 {
-		return nil, ErrTooManyArguments
+	sealOptions, err := parseSealOptions(flag)
+	if err != nil {
+		return nil, err
+	}
+	sealOptions.Keyfile, _ = flag.GetString("add-keyfile")
+	sealOptions.RemoveKeyfile, _ = flag.GetBool("remove-keyfile")
+	if sealOptions.Keyfile != "" && sealOptions.RemoveKeyfile {
+		return nil, ErrConflictingKeyfileOptions
+	}
+
+	calibrate, _ := flag.GetBool("calibrate")
+	if calibrate {
+		sealOptions.CalibrationTarget, _ = flag.GetDuration("target")
+		if sealOptions.CalibrationTarget <= 0 {
+			return nil, ErrInvalidDuration
+		}
+	} else if flag.Changed("target") {
+		return nil, ErrTargetRequiresCalibrate
+	}
+
+	// the content of the vault doesn't change, so its sessions remain valid
+	sealOptions.KeepSessionCache = true
+
+	if all || flag.NArg() > 1 || strings.ContainsAny(flag.Arg(0), "*?[") {
+		p := &Passwd{}
+		p.All = all
+		if flag.NArg() > 0 {
+			p.VaultNames = flag.Args()
+		}
+		p.SealOptions = sealOptions
+		return p, nil
 	}
 
 	c := &Copy{}
 	c.OldVaultName = flag.Arg(0)
 	c.NewVaultName = flag.Arg(0)
+	c.SealOptions = sealOptions
 	return c, nil
 }
 
+func parseRecipientsArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients")
+	flag.SetInterspersed(false)
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	subcommandArgs := flag.Args()
+	switch subcommandArgs[0] {
+	case "ls", "list":
+		return parseRecipientsListArgs(subcommandArgs[1:])
+
+	case "add":
+		return parseRecipientsAddArgs(subcommandArgs[1:])
+
+	case "rm", "remove":
+		return parseRecipientsRemoveArgs(subcommandArgs[1:])
+
+	case "identity":
+		return parseRecipientsIdentityArgs(subcommandArgs[1:])
+
+	default:
+		return nil, fmt.Errorf("Unknown recipients command: %s", subcommandArgs[0])
+	}
+}
+
+func parseRecipientsListArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients list")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	l := &RecipientsList{}
+	l.VaultName = flag.Arg(0)
+	return l, nil
+}
+
+func parseRecipientsAddArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients add")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 2 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	a := &RecipientsAdd{}
+	a.VaultName = flag.Arg(0)
+	a.Recipients = flag.Args()[1:]
+	return a, nil
+}
+
+func parseRecipientsRemoveArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients remove")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 2 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	r := &RecipientsRemove{}
+	r.VaultName = flag.Arg(0)
+	r.Recipients = flag.Args()[1:]
+	return r, nil
+}
+
+func parseRecipientsIdentityArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients identity")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return &RecipientsIdentity{}, nil
+}
+
 func parseRemoveArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted remove")
+	flag.Bool("permanent", false, "Remove the vaults (and their history) permanently instead of moving them to the trash")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -473,6 +930,33 @@ func parseRemoveArgs(args []string) (Command, error) {
 
 	r := &Remove{}
 	r.VaultNames = flag.Args()
+	r.Permanent, _ = flag.GetBool("permanent")
+	return r, nil
+}
+
+func parseRestoreArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted restore")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 2 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 2 {
+		return nil, ErrTooManyArguments
+	}
+
+	version, err := strconv.Atoi(flag.Arg(1))
+	if err != nil || version < 1 {
+		return nil, ErrInvalidVersion
+	}
+
+	r := &Restore{}
+	r.VaultName = flag.Arg(0)
+	r.Version = version
 	return r, nil
 }
 
@@ -498,7 +982,6 @@ func parseShellArgs(args []string) (Command, error) {
 	s.Refresh, _ = flag.GetBool("refresh")
 	s.Region, _ = flag.GetString("region")
 	s.SigningUrl, _ = flag.GetString("ssh-signing-url")
-	s.Command = interactiveShellCommand()
 	s.DisplayStatus = true
 
 	if flag.Changed("ssh-signing-users") {
@@ -537,9 +1020,249 @@ func parseShellArgs(args []string) (Command, error) {
 		return nil, ErrNotEnoughArguments
 	}
 
+	s.Command = interactiveShellCommand(s.VaultName)
+	return s, nil
+}
+
+func parseSyncArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted sync")
+	flag.String("remote", "", "Git repository to sync vaults with")
+	flag.Bool("keep-both", false, "Resolve conflicts by keeping the local version and saving the remote version as a new vault")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	s := &Sync{}
+	s.SyncOptions.Remote, _ = flag.GetString("remote")
+	s.SyncOptions.KeepBoth, _ = flag.GetBool("keep-both")
 	return s, nil
 }
 
+func parseSessionArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted session")
+	flag.SetInterspersed(false)
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	subcommandArgs := flag.Args()
+	switch subcommandArgs[0] {
+	case "ls", "list":
+		return parseSessionListArgs(subcommandArgs[1:])
+
+	case "rm", "remove":
+		return parseSessionRemoveArgs(subcommandArgs[1:])
+
+	case "purge":
+		return parseSessionPurgeArgs(subcommandArgs[1:])
+
+	default:
+		return nil, fmt.Errorf("Unknown session command: %s", subcommandArgs[0])
+	}
+}
+
+func parseSessionListArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted session list")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	l := &SessionList{}
+	l.VaultName = flag.Arg(0)
+	return l, nil
+}
+
+func parseSessionRemoveArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted session remove")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	r := &SessionRemove{}
+	r.VaultNames = flag.Args()
+	return r, nil
+}
+
+func parseSessionPurgeArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted session purge")
+	flag.Bool("orphaned", false, "Only purge the session caches of vaults that no longer exist")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	p := &SessionPurge{}
+	p.Orphaned, _ = flag.GetBool("orphaned")
+	return p, nil
+}
+
+func parseTrashArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted trash")
+	flag.SetInterspersed(false)
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	subcommandArgs := flag.Args()
+	switch subcommandArgs[0] {
+	case "ls", "list":
+		return parseTrashListArgs(subcommandArgs[1:])
+
+	case "restore":
+		return parseTrashRestoreArgs(subcommandArgs[1:])
+
+	case "purge":
+		return parseTrashPurgeArgs(subcommandArgs[1:])
+
+	default:
+		return nil, fmt.Errorf("Unknown trash command: %s", subcommandArgs[0])
+	}
+}
+
+func parseTrashListArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted trash list")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return &TrashList{}, nil
+}
+
+func parseTrashRestoreArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted trash restore")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	r := &TrashRestore{}
+	r.VaultName = flag.Arg(0)
+	return r, nil
+}
+
+func parseTrashPurgeArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted trash purge")
+	flag.Duration("older-than", 0, "Only purge vaults that were removed longer ago than this")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	p := &TrashPurge{}
+	p.OlderThan, _ = flag.GetDuration("older-than")
+	if p.OlderThan < 0 {
+		return nil, ErrInvalidDuration
+	}
+	return p, nil
+}
+
+func parseVerifyArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted verify")
+	flag.Bool("open", false, "Open each vault to verify its content")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Verify{}
+	if flag.NArg() > 0 {
+		v.VaultNames = flag.Args()
+	}
+	v.Open, _ = flag.GetBool("open")
+	return v, nil
+}
+
+func parseExportArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted export")
+	flag.Bool("all", false, "Export all vaults")
+	flag.StringP("output", "o", "", "File to write the bundle to")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Export{}
+	e.All, _ = flag.GetBool("all")
+	e.Output, _ = flag.GetString("output")
+	if flag.NArg() > 0 {
+		e.VaultNames = flag.Args()
+	}
+
+	if e.All && len(e.VaultNames) > 0 {
+		return nil, ErrAllWithVaultNames
+	}
+
+	if !e.All && len(e.VaultNames) == 0 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if e.Output == "" {
+		return nil, ErrOutputRequired
+	}
+
+	return e, nil
+}
+
+func parseFixPermissionsArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted fix-permissions")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return &FixPermissions{}, nil
+}
+
 func parseUpgradeArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted upgrade")
 	err := flag.Parse(args)
@@ -554,20 +1277,25 @@ func parseUpgradeArgs(args []string) (Command, error) {
 	return &Upgrade{}, nil
 }
 
-func interactiveShellCommand() []string {
-	shell := os.Getenv("SHELL")
-	if shell == "" {
-		shell = "/bin/sh"
+func parseSealOptions(flag *pflag.FlagSet) (vaulted.SealOptions, error) {
+	options := vaulted.SealOptions{}
+	options.KeyMethod, _ = flag.GetString("kdf")
+	options.Method, _ = flag.GetString("cipher")
+
+	if options.KeyMethod != "" && !vaulted.ValidKeyMethod(options.KeyMethod) {
+		return options, ErrInvalidKeyMethod
+	}
+	if options.Method != "" && !vaulted.ValidMethod(options.Method) {
+		return options, ErrInvalidMethod
 	}
 
-	return []string{shell, "--login"}
+	return options, nil
 }
 
-func detectShell() (string, error) {
-	shell := os.Getenv("SHELL")
-	if shell != "" {
-		return filepath.Base(shell), nil
-	}
+func interactiveShellCommand(vaultName string) []string {
+	return []string{configValue("shell", vaultName), "--login"}
+}
 
-	return "", ErrUnknownShell
+func detectShell(vaultName string) string {
+	return filepath.Base(configValue("shell", vaultName))
 }
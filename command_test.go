@@ -5,8 +5,10 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/miquella/vaulted/edit"
+	"github.com/miquella/vaulted/lib"
 )
 
 type parseCase struct {
@@ -137,6 +139,26 @@ var (
 				VaultName: "one",
 			},
 		},
+		{
+			Args: []string{"add", "--kdf", "argon2id", "one"},
+			Command: &edit.Edit{
+				New:       true,
+				VaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					KeyMethod: "argon2id",
+				},
+			},
+		},
+		{
+			Args: []string{"add", "--cipher", "xchacha20-poly1305", "one"},
+			Command: &edit.Edit{
+				New:       true,
+				VaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					Method: "xchacha20-poly1305",
+				},
+			},
+		},
 		{
 			Args:    []string{"add", "--help"},
 			Command: &Help{Subcommand: "add"},
@@ -150,6 +172,69 @@ var (
 			Command: &Help{Subcommand: "create"},
 		},
 
+		// Agent
+		{
+			Args: []string{"agent", "start"},
+			Command: &AgentStart{
+				IdleTimeout: 15 * time.Minute,
+				MaxLifetime: 8 * time.Hour,
+			},
+		},
+		{
+			Args: []string{"agent", "start", "--idle-timeout", "5m", "--max-lifetime", "1h", "--foreground"},
+			Command: &AgentStart{
+				IdleTimeout: 5 * time.Minute,
+				MaxLifetime: time.Hour,
+				Foreground:  true,
+			},
+		},
+		{
+			Args:    []string{"agent", "stop"},
+			Command: &AgentStop{},
+		},
+		{
+			Args:    []string{"agent", "lock"},
+			Command: &AgentLock{},
+		},
+		{
+			Args:    []string{"agent", "status"},
+			Command: &AgentStatus{},
+		},
+		{
+			Args:    []string{"agent", "--help"},
+			Command: &Help{Subcommand: "agent"},
+		},
+
+		// Config
+		{
+			Args:    []string{"config", "ls"},
+			Command: &ConfigList{},
+		},
+		{
+			Args:    []string{"config", "list", "--vault", "one"},
+			Command: &ConfigList{VaultName: "one"},
+		},
+		{
+			Args:    []string{"config", "get", "shell"},
+			Command: &ConfigGet{Setting: "shell"},
+		},
+		{
+			Args: []string{"config", "set", "--vault", "one", "session_duration", "15m"},
+			Command: &ConfigSet{
+				Setting:   "session_duration",
+				Value:     "15m",
+				VaultName: "one",
+			},
+		},
+		{
+			Args:    []string{"config", "unset", "password_tries"},
+			Command: &ConfigUnset{Setting: "password_tries"},
+		},
+		{
+			Args:    []string{"config", "--help"},
+			Command: &Help{Subcommand: "config"},
+		},
+
 		// Copy
 		{
 			Args: []string{"cp", "one", "two"},
@@ -324,6 +409,36 @@ var (
 			Command: &Help{Subcommand: "exec"},
 		},
 
+		// Export
+		{
+			Args: []string{"export", "one", "two", "-o", "backup"},
+			Command: &Export{
+				VaultNames: []string{"one", "two"},
+				Output:     "backup",
+			},
+		},
+		{
+			Args: []string{"export", "--all", "--output", "backup"},
+			Command: &Export{
+				All:    true,
+				Output: "backup",
+			},
+		},
+		{
+			Args:    []string{"export", "--help"},
+			Command: &Help{Subcommand: "export"},
+		},
+
+		// Fix permissions
+		{
+			Args:    []string{"fix-permissions"},
+			Command: &FixPermissions{},
+		},
+		{
+			Args:    []string{"fix-permissions", "--help"},
+			Command: &Help{Subcommand: "fix-permissions"},
+		},
+
 		// Help
 		{
 			Args:    []string{"help", "add"},
@@ -410,6 +525,18 @@ var (
 			Command: &Help{},
 		},
 
+		// History
+		{
+			Args: []string{"history", "one"},
+			Command: &History{
+				VaultName: "one",
+			},
+		},
+		{
+			Args:    []string{"history", "--help"},
+			Command: &Help{Subcommand: "history"},
+		},
+
 		// List
 		{
 			Args:    []string{"ls"},
@@ -437,6 +564,29 @@ var (
 				Active: "active-env",
 			},
 		},
+		{
+			Args: []string{"ls", "--long"},
+			Command: &List{
+				Long: true,
+			},
+		},
+		{
+			Args: []string{"ls", "-l", "--tag", "prod", "--tag", "aws"},
+			Command: &List{
+				Long: true,
+				Tags: []string{"prod", "aws"},
+			},
+		},
+		{
+			Args: []string{"ls", "--format", "json"},
+			Command: &List{
+				Format: "json",
+			},
+		},
+		{
+			Args:    []string{"ls", "--format", "text"},
+			Command: &List{},
+		},
 		{
 			Args:    []string{"list", "--help"},
 			Command: &Help{Subcommand: "list"},
@@ -446,6 +596,28 @@ var (
 			Command: &Help{Subcommand: "ls"},
 		},
 
+		// Import
+		{
+			Args: []string{"import", "backup"},
+			Command: &Import{
+				Bundle:     "backup",
+				OnConflict: "skip",
+			},
+		},
+		{
+			Args: []string{"import", "--on-conflict", "rename", "--bundle-password", "backup", "one", "two"},
+			Command: &Import{
+				Bundle:         "backup",
+				VaultNames:     []string{"one", "two"},
+				OnConflict:     "rename",
+				BundlePassword: true,
+			},
+		},
+		{
+			Args:    []string{"import", "--help"},
+			Command: &Help{Subcommand: "import"},
+		},
+
 		// Load
 		{
 			Args: []string{"load", "one"},
@@ -464,6 +636,9 @@ var (
 			Command: &Copy{
 				OldVaultName: "one",
 				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					KeepSessionCache: true,
+				},
 			},
 		},
 		{
@@ -471,6 +646,114 @@ var (
 			Command: &Copy{
 				OldVaultName: "one",
 				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--kdf", "argon2id", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					KeyMethod:        "argon2id",
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--cipher", "aes-256-gcm", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					Method:           "aes-256-gcm",
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--add-keyfile", "/media/usb/keyfile", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					Keyfile:          "/media/usb/keyfile",
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--remove-keyfile", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					RemoveKeyfile:    true,
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--calibrate", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					CalibrationTarget: 500 * time.Millisecond,
+					KeepSessionCache:  true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--calibrate", "--target", "1s", "--kdf", "argon2id", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				SealOptions: vaulted.SealOptions{
+					KeyMethod:         "argon2id",
+					CalibrationTarget: time.Second,
+					KeepSessionCache:  true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "one", "two"},
+			Command: &Passwd{
+				VaultNames: []string{"one", "two"},
+				SealOptions: vaulted.SealOptions{
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "prod-*"},
+			Command: &Passwd{
+				VaultNames: []string{"prod-*"},
+				SealOptions: vaulted.SealOptions{
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--all", "--kdf", "argon2id"},
+			Command: &Passwd{
+				All: true,
+				SealOptions: vaulted.SealOptions{
+					KeyMethod:        "argon2id",
+					KeepSessionCache: true,
+				},
+			},
+		},
+		{
+			Args: []string{"passwd", "--all", "--calibrate", "--target", "2s"},
+			Command: &Passwd{
+				All: true,
+				SealOptions: vaulted.SealOptions{
+					CalibrationTarget: 2 * time.Second,
+					KeepSessionCache:  true,
+				},
 			},
 		},
 		{
@@ -527,11 +810,31 @@ var (
 			Args:    []string{"remove", "--help"},
 			Command: &Help{Subcommand: "remove"},
 		},
+		{
+			Args: []string{"rm", "--permanent", "one", "two"},
+			Command: &Remove{
+				VaultNames: []string{"one", "two"},
+				Permanent:  true,
+			},
+		},
 		{
 			Args:    []string{"delete", "--help"},
 			Command: &Help{Subcommand: "delete"},
 		},
 
+		// Restore
+		{
+			Args: []string{"restore", "one", "3"},
+			Command: &Restore{
+				VaultName: "one",
+				Version:   3,
+			},
+		},
+		{
+			Args:    []string{"restore", "--help"},
+			Command: &Help{Subcommand: "restore"},
+		},
+
 		// Shell
 		{
 			Args: []string{"shell", "one"},
@@ -580,6 +883,136 @@ var (
 			Command: &Help{Subcommand: "shell"},
 		},
 
+		// Sync
+		{
+			Args:    []string{"sync"},
+			Command: &Sync{},
+		},
+		{
+			Args: []string{"sync", "--remote", "git@example.com:vaults.git", "--keep-both"},
+			Command: &Sync{
+				SyncOptions: vaulted.SyncOptions{
+					Remote:   "git@example.com:vaults.git",
+					KeepBoth: true,
+				},
+			},
+		},
+		{
+			Args:    []string{"sync", "--help"},
+			Command: &Help{Subcommand: "sync"},
+		},
+
+		// Recipients
+		{
+			Args: []string{"recipients", "ls", "one"},
+			Command: &RecipientsList{
+				VaultName: "one",
+			},
+		},
+		{
+			Args: []string{"recipients", "list", "one"},
+			Command: &RecipientsList{
+				VaultName: "one",
+			},
+		},
+		{
+			Args: []string{"recipients", "add", "one", "key1", "key2"},
+			Command: &RecipientsAdd{
+				VaultName:  "one",
+				Recipients: []string{"key1", "key2"},
+			},
+		},
+		{
+			Args: []string{"recipients", "rm", "one", "key1"},
+			Command: &RecipientsRemove{
+				VaultName:  "one",
+				Recipients: []string{"key1"},
+			},
+		},
+		{
+			Args: []string{"recipients", "remove", "one", "key1"},
+			Command: &RecipientsRemove{
+				VaultName:  "one",
+				Recipients: []string{"key1"},
+			},
+		},
+		{
+			Args:    []string{"recipients", "identity"},
+			Command: &RecipientsIdentity{},
+		},
+		{
+			Args:    []string{"recipients", "--help"},
+			Command: &Help{Subcommand: "recipients"},
+		},
+
+		// Session
+		{
+			Args:    []string{"session", "ls"},
+			Command: &SessionList{},
+		},
+		{
+			Args: []string{"session", "list", "one"},
+			Command: &SessionList{
+				VaultName: "one",
+			},
+		},
+		{
+			Args: []string{"session", "rm", "one", "two"},
+			Command: &SessionRemove{
+				VaultNames: []string{"one", "two"},
+			},
+		},
+		{
+			Args: []string{"session", "remove", "one"},
+			Command: &SessionRemove{
+				VaultNames: []string{"one"},
+			},
+		},
+		{
+			Args:    []string{"session", "purge"},
+			Command: &SessionPurge{},
+		},
+		{
+			Args: []string{"session", "purge", "--orphaned"},
+			Command: &SessionPurge{
+				Orphaned: true,
+			},
+		},
+		{
+			Args:    []string{"session", "--help"},
+			Command: &Help{Subcommand: "session"},
+		},
+
+		// Trash
+		{
+			Args:    []string{"trash", "ls"},
+			Command: &TrashList{},
+		},
+		{
+			Args:    []string{"trash", "list"},
+			Command: &TrashList{},
+		},
+		{
+			Args: []string{"trash", "restore", "one"},
+			Command: &TrashRestore{
+				VaultName: "one",
+			},
+		},
+		{
+			Args:    []string{"trash", "purge"},
+			Command: &TrashPurge{},
+		},
+		{
+			Args: []string{"trash", "purge", "--older-than", "720h"},
+			Command: &TrashPurge{
+				OlderThan: 720 * time.Hour,
+			},
+		},
+		{
+			Args:    []string{"trash", "--help"},
+			Command: &Help{Subcommand: "trash"},
+		},
+
 		// Upgrade
 		{
 			Args:    []string{"upgrade"},
@@ -590,6 +1023,23 @@ var (
 			Command: &Help{Subcommand: "upgrade"},
 		},
 
+		// Verify
+		{
+			Args:    []string{"verify"},
+			Command: &Verify{},
+		},
+		{
+			Args: []string{"verify", "--open", "one", "two"},
+			Command: &Verify{
+				VaultNames: []string{"one", "two"},
+				Open:       true,
+			},
+		},
+		{
+			Args:    []string{"verify", "--help"},
+			Command: &Help{Subcommand: "verify"},
+		},
+
 		//Version
 		{
 			Args:    []string{"version"},
@@ -620,6 +1070,64 @@ var (
 		{
 			Args: []string{"add", "one", "two"},
 		},
+		{
+			Args: []string{"add", "--kdf", "bogus", "one"},
+		},
+		{
+			Args: []string{"add", "--cipher", "bogus", "one"},
+		},
+
+		// Agent
+		{
+			Args: []string{"agent"},
+		},
+		{
+			Args: []string{"agent", "bogus"},
+		},
+		{
+			Args: []string{"agent", "start", "one"},
+		},
+		{
+			Args: []string{"agent", "start", "--idle-timeout", "0"},
+		},
+		{
+			Args: []string{"agent", "start", "--max-lifetime", "-1h"},
+		},
+		{
+			Args: []string{"agent", "stop", "one"},
+		},
+		{
+			Args: []string{"agent", "lock", "one"},
+		},
+		{
+			Args: []string{"agent", "status", "one"},
+		},
+
+		// Config
+		{
+			Args: []string{"config"},
+		},
+		{
+			Args: []string{"config", "bogus"},
+		},
+		{
+			Args: []string{"config", "ls", "extra"},
+		},
+		{
+			Args: []string{"config", "get"},
+		},
+		{
+			Args: []string{"config", "get", "bogus"},
+		},
+		{
+			Args: []string{"config", "set", "shell"},
+		},
+		{
+			Args: []string{"config", "set", "shell", "/bin/zsh", "extra"},
+		},
+		{
+			Args: []string{"config", "unset", "shell", "extra"},
+		},
 
 		// Copy
 		{
@@ -712,6 +1220,30 @@ var (
 			Args: []string{"exec", "one", "--no-session", "--refresh", "cmd"},
 		},
 
+		// Export
+		{
+			Args: []string{"export", "-o", "backup"},
+		},
+		{
+			Args: []string{"export", "one"},
+		},
+		{
+			Args: []string{"export", "--all", "one", "-o", "backup"},
+		},
+
+		// Fix permissions
+		{
+			Args: []string{"fix-permissions", "one"},
+		},
+
+		// History
+		{
+			Args: []string{"history"},
+		},
+		{
+			Args: []string{"history", "one", "two"},
+		},
+
 		// List
 		{
 			Args: []string{"ls", "one"},
@@ -719,6 +1251,20 @@ var (
 		{
 			Args: []string{"list", "one"},
 		},
+		{
+			Args: []string{"ls", "--format", "yaml"},
+		},
+		{
+			Args: []string{"ls", "--tag"},
+		},
+
+		// Import
+		{
+			Args: []string{"import"},
+		},
+		{
+			Args: []string{"import", "--on-conflict", "merge", "backup"},
+		},
 
 		// Load
 		{
@@ -736,10 +1282,28 @@ var (
 			Args: []string{"password"},
 		},
 		{
-			Args: []string{"passwd", "one", "two"},
+			Args: []string{"passwd", "--all", "one"},
+		},
+		{
+			Args: []string{"password", "--all", "--add-keyfile", "/media/usb/keyfile", "--remove-keyfile"},
+		},
+		{
+			Args: []string{"passwd", "--kdf", "bogus", "one"},
+		},
+		{
+			Args: []string{"passwd", "--cipher", "bogus", "one"},
+		},
+		{
+			Args: []string{"passwd", "--add-keyfile", "/media/usb/keyfile", "--remove-keyfile", "one"},
+		},
+		{
+			Args: []string{"passwd", "--target", "1s", "one"},
 		},
 		{
-			Args: []string{"password", "one", "two"},
+			Args: []string{"passwd", "--calibrate", "--target", "0s", "one"},
+		},
+		{
+			Args: []string{"passwd", "--calibrate", "--target", "soon", "one"},
 		},
 
 		// Remove
@@ -747,6 +1311,20 @@ var (
 			Args: []string{"rm"},
 		},
 
+		// Restore
+		{
+			Args: []string{"restore", "one"},
+		},
+		{
+			Args: []string{"restore", "one", "1", "2"},
+		},
+		{
+			Args: []string{"restore", "one", "latest"},
+		},
+		{
+			Args: []string{"restore", "one", "0"},
+		},
+
 		// Shell
 		{
 			Args: []string{"shell"},
@@ -764,11 +1342,87 @@ var (
 			Args: []string{"shell", "one", "--no-session", "--refresh"},
 		},
 
+		// Sync
+		{
+			Args: []string{"sync", "one"},
+		},
+
+		// Recipients
+		{
+			Args: []string{"recipients"},
+		},
+		{
+			Args: []string{"recipients", "bogus"},
+		},
+		{
+			Args: []string{"recipients", "ls"},
+		},
+		{
+			Args: []string{"recipients", "ls", "one", "two"},
+		},
+		{
+			Args: []string{"recipients", "add", "one"},
+		},
+		{
+			Args: []string{"recipients", "rm", "one"},
+		},
+		{
+			Args: []string{"recipients", "identity", "one"},
+		},
+
+		// Session
+		{
+			Args: []string{"session"},
+		},
+		{
+			Args: []string{"session", "bogus"},
+		},
+		{
+			Args: []string{"session", "ls", "one", "two"},
+		},
+		{
+			Args: []string{"session", "rm"},
+		},
+		{
+			Args: []string{"session", "purge", "one"},
+		},
+
+		// Trash
+		{
+			Args: []string{"trash"},
+		},
+		{
+			Args: []string{"trash", "bogus"},
+		},
+		{
+			Args: []string{"trash", "ls", "one"},
+		},
+		{
+			Args: []string{"trash", "restore"},
+		},
+		{
+			Args: []string{"trash", "restore", "one", "two"},
+		},
+		{
+			Args: []string{"trash", "purge", "one"},
+		},
+		{
+			Args: []string{"trash", "purge", "--older-than", "bogus"},
+		},
+		{
+			Args: []string{"trash", "purge", "--older-than", "-1h"},
+		},
+
 		// Upgrade
 		{
 			Args: []string{"upgrade", "one"},
 		},
 
+		// Verify
+		{
+			Args: []string{"verify", "--bogus"},
+		},
+
 		// Misc
 		{
 			Args: []string{},
@@ -787,6 +1441,45 @@ type parseExpectation struct {
 	Command Command
 }
 
+func TestParseVaultDir(t *testing.T) {
+	command, err := ParseArgs([]string{"--dir", "/vaults", "ls"})
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if !reflect.DeepEqual(command, &List{}) {
+		t.Errorf("Expected command: %#v, got: %#v", &List{}, command)
+	}
+	if VaultDir != "/vaults" {
+		t.Errorf("Expected vault dir: /vaults, got: %s", VaultDir)
+	}
+
+	_, err = ParseArgs([]string{"ls"})
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if VaultDir != "" {
+		t.Errorf("Expected no vault dir, got: %s", VaultDir)
+	}
+}
+
+func TestParseKeyfile(t *testing.T) {
+	_, err := ParseArgs([]string{"--keyfile", "/media/usb/keyfile", "ls"})
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if Keyfile != "/media/usb/keyfile" {
+		t.Errorf("Expected keyfile: /media/usb/keyfile, got: %s", Keyfile)
+	}
+
+	_, err = ParseArgs([]string{"ls"})
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if Keyfile != "" {
+		t.Errorf("Expected no keyfile, got: %s", Keyfile)
+	}
+}
+
 func TestParseArgs(t *testing.T) {
 	// backup & nuke env vars we don't want
 	savedEnv := make(map[string]string)
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type ConfigList struct {
+	VaultName string
+}
+
+func (l *ConfigList) Run(store vaulted.Store) error {
+	config, err := currentConfig()
+	if err != nil {
+		return err
+	}
+
+	for _, setting := range Settings {
+		value, source := config.Lookup(setting, l.VaultName)
+		if value == "" {
+			value = "(not set)"
+		}
+		fmt.Printf("%s = %s (%s)\n", setting.Name, value, source)
+	}
+
+	if l.VaultName != "" {
+		return nil
+	}
+
+	for _, name := range config.VaultNames() {
+		fmt.Printf("\nvault '%s':\n", name)
+		for _, setting := range Settings {
+			if value, exists := config.Vaults[name][setting.Name]; exists {
+				fmt.Printf("  %s = %s\n", setting.Name, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+type ConfigGet struct {
+	Setting   string
+	VaultName string
+}
+
+func (g *ConfigGet) Run(store vaulted.Store) error {
+	config, err := currentConfig()
+	if err != nil {
+		return err
+	}
+
+	value, _ := config.Lookup(findSetting(g.Setting), g.VaultName)
+	fmt.Println(value)
+
+	return nil
+}
+
+type ConfigSet struct {
+	Setting   string
+	Value     string
+	VaultName string
+}
+
+func (s *ConfigSet) Run(store vaulted.Store) error {
+	setting := findSetting(s.Setting)
+	err := setting.Validate(s.Value)
+	if err != nil {
+		return err
+	}
+
+	config, err := currentConfig()
+	if err != nil {
+		return err
+	}
+
+	if s.VaultName != "" {
+		if config.Vaults[s.VaultName] == nil {
+			config.Vaults[s.VaultName] = make(map[string]string)
+		}
+		config.Vaults[s.VaultName][s.Setting] = s.Value
+	} else {
+		config.Global[s.Setting] = s.Value
+	}
+
+	err = config.Save(configPath())
+	if err != nil {
+		return err
+	}
+
+	warnEnvOverride(setting)
+	return nil
+}
+
+type ConfigUnset struct {
+	Setting   string
+	VaultName string
+}
+
+func (u *ConfigUnset) Run(store vaulted.Store) error {
+	config, err := currentConfig()
+	if err != nil {
+		return err
+	}
+
+	if u.VaultName != "" {
+		delete(config.Vaults[u.VaultName], u.Setting)
+	} else {
+		delete(config.Global, u.Setting)
+	}
+
+	return config.Save(configPath())
+}
+
+// warnEnvOverride warns that the environment overrides the setting (so
+// changing the configuration file has no effect).
+func warnEnvOverride(setting *Setting) {
+	if setting.Env != "" && os.Getenv(setting.Env) != "" {
+		fmt.Fprintf(os.Stderr, "Note: %s is set, and takes precedence over the configuration file\n", setting.Env)
+	}
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+// withConfigFile points the configuration at a file in a temporary directory,
+// with the given content (if any).
+func withConfigFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "vaulted-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "vaulted", "config")
+	if content != "" {
+		err = os.MkdirAll(filepath.Dir(path), 0700)
+		if err == nil {
+			err = ioutil.WriteFile(path, []byte(content), 0600)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	os.Setenv("VAULTED_CONFIG", path)
+	loadedConfig, loadedConfigErr = nil, nil
+
+	return path, func() {
+		os.Unsetenv("VAULTED_CONFIG")
+		loadedConfig, loadedConfigErr = nil, nil
+		os.RemoveAll(dir)
+	}
+}
+
+func TestConfigLookup(t *testing.T) {
+	_, cleanup := withConfigFile(t, `
+session_tolerance = "5m"
+password_tries = 5
+
+[vault.one]
+session_tolerance = "1m"
+
+[vault."with.dot"]
+password_tries = 1
+`)
+	defer cleanup()
+
+	config, err := currentConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.Unsetenv("VAULTED_SESSION_TOLERANCE")
+	tolerance := findSetting("session_tolerance")
+	cases := []struct {
+		Vault  string
+		Value  string
+		Source string
+	}{
+		{"", "5m", "configuration file"},
+		{"one", "1m", "vault 'one'"},
+		{"two", "5m", "configuration file"},
+	}
+	for _, c := range cases {
+		value, source := config.Lookup(tolerance, c.Vault)
+		if value != c.Value || source != c.Source {
+			t.Errorf("Expected %s from %s for vault '%s', got %s from %s", c.Value, c.Source, c.Vault, value, source)
+		}
+	}
+
+	// the environment takes precedence over the vault's settings
+	os.Setenv("VAULTED_SESSION_TOLERANCE", "10m")
+	value, source := config.Lookup(tolerance, "one")
+	os.Unsetenv("VAULTED_SESSION_TOLERANCE")
+	if value != "10m" || source != "environment variable VAULTED_SESSION_TOLERANCE" {
+		t.Errorf("Expected the environment to take precedence, got %s from %s", value, source)
+	}
+
+	value, source = config.Lookup(findSetting("role_duration"), "one")
+	if value != "1h" || source != "default" {
+		t.Errorf("Expected the default, got %s from %s", value, source)
+	}
+
+	if configValue("password_tries", "with.dot") != "1" {
+		t.Errorf("Expected the vault's setting, got: %s", configValue("password_tries", "with.dot"))
+	}
+
+	steward := &ConfiguredSteward{}
+	if tries := steward.GetMaxOpenTriesForVault("one"); tries != 5 {
+		t.Errorf("Expected 5 tries, got: %d", tries)
+	}
+	if tries := steward.GetMaxOpenTriesForVault("with.dot"); tries != 1 {
+		t.Errorf("Expected 1 try, got: %d", tries)
+	}
+}
+
+func TestConfigInvalid(t *testing.T) {
+	contents := []string{
+		`session_tolerance = "soon"`,
+		`bogus = "value"`,
+		`password_tries = 0`,
+		`shell = true`,
+		`vault = "one"`,
+		"[vault.one]\nrole_duration = \"1m\"",
+		`not toml`,
+	}
+
+	for _, content := range contents {
+		_, cleanup := withConfigFile(t, content)
+		_, err := currentConfig()
+		if err == nil || !strings.HasPrefix(err.Error(), "Invalid configuration file") {
+			t.Errorf("Expected an invalid configuration error for %q, got: %v", content, err)
+		}
+		cleanup()
+	}
+}
+
+func TestConfigSetAndUnset(t *testing.T) {
+	path, cleanup := withConfigFile(t, "")
+	defer cleanup()
+
+	commands := []Command{
+		&ConfigSet{Setting: "session_duration", Value: "2h"},
+		&ConfigSet{Setting: "password_tries", Value: "4"},
+		&ConfigSet{Setting: "askpass", Value: "/usr/bin/ssh-askpass", VaultName: "one"},
+		&ConfigSet{Setting: "shell", Value: "/bin/zsh", VaultName: "one"},
+		&ConfigSet{Setting: "password_tries", Value: "1", VaultName: "with.dot"},
+		&ConfigUnset{Setting: "shell", VaultName: "one"},
+	}
+	for _, command := range commands {
+		err := command.Run(NewTestStore())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "password_tries = 4\nsession_duration = \"2h\"\n\n[vault.one]\naskpass = \"/usr/bin/ssh-askpass\"\n\n[vault.\"with.dot\"]\npassword_tries = 1\n"
+	if string(content) != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, content)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected the file to have mode 0600, got: %o", info.Mode().Perm())
+	}
+
+	// the file can be read back
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedConfig := &Config{
+		Global: map[string]string{
+			"session_duration": "2h",
+			"password_tries":   "4",
+		},
+		Vaults: map[string]map[string]string{
+			"one":      {"askpass": "/usr/bin/ssh-askpass"},
+			"with.dot": {"password_tries": "1"},
+		},
+	}
+	if !reflect.DeepEqual(config, expectedConfig) {
+		t.Errorf("Expected: %#v\nGot: %#v", expectedConfig, config)
+	}
+
+	err = (&ConfigSet{Setting: "session_duration", Value: "1m"}).Run(NewTestStore())
+	if err == nil {
+		t.Error("Expected an invalid value to be rejected")
+	}
+}
+
+func TestConfigList(t *testing.T) {
+	_, cleanup := withConfigFile(t, `
+password_tries = 5
+
+[vault.one]
+session_duration = "15m"
+`)
+	defer cleanup()
+
+	os.Setenv("SHELL", "/bin/fish")
+	os.Unsetenv("VAULTED_ASKPASS")
+	os.Unsetenv("VAULTED_SESSION_TOLERANCE")
+
+	output := CaptureStdout(func() {
+		err := (&ConfigList{}).Run(NewTestStore())
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := `session_duration = 1h (default)
+session_tolerance = 15m (default)
+role_duration = 1h (default)
+askpass = (not set) (default)
+password_tries = 5 (configuration file)
+shell = /bin/fish (environment variable SHELL)
+
+vault 'one':
+  session_duration = 15m
+`
+	if !bytes.Equal(output, []byte(expected)) {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestConfigureSettings(t *testing.T) {
+	_, cleanup := withConfigFile(t, `
+session_duration = "2h"
+
+[vault.one]
+session_duration = "30m"
+role_duration = "4h"
+`)
+	defer cleanup()
+
+	defer func(duration, tolerance, role time.Duration) {
+		vaulted.STSDurationDefault = duration
+		vaulted.SessionTolerance = tolerance
+		vaulted.MaxRoleSessionDuration = role
+	}(vaulted.STSDurationDefault, vaulted.SessionTolerance, vaulted.MaxRoleSessionDuration)
+
+	os.Unsetenv("VAULTED_SESSION_TOLERANCE")
+	err := configureSettings("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vaulted.STSDurationDefault != 2*time.Hour || vaulted.MaxRoleSessionDuration != time.Hour {
+		t.Errorf("Expected the global settings, got: %s, %s", vaulted.STSDurationDefault, vaulted.MaxRoleSessionDuration)
+	}
+
+	err = configureSettings("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vaulted.STSDurationDefault != 30*time.Minute || vaulted.MaxRoleSessionDuration != 4*time.Hour {
+		t.Errorf("Expected the vault's settings, got: %s, %s", vaulted.STSDurationDefault, vaulted.MaxRoleSessionDuration)
+	}
+
+	os.Setenv("VAULTED_SESSION_TOLERANCE", "-1m")
+	err = configureSettings("")
+	os.Unsetenv("VAULTED_SESSION_TOLERANCE")
+	if err == nil {
+		t.Error("Expected an invalid environment variable to be rejected")
+	}
+}
+
+func TestConfigShell(t *testing.T) {
+	_, cleanup := withConfigFile(t, `
+[vault.one]
+shell = "/bin/zsh"
+`)
+	defer cleanup()
+
+	defer os.Setenv("SHELL", os.Getenv("SHELL"))
+	os.Setenv("SHELL", "/bin/fish")
+
+	// the vault's setting takes precedence over SHELL
+	command := interactiveShellCommand("one")
+	if !reflect.DeepEqual(command, []string{"/bin/zsh", "--login"}) {
+		t.Errorf("Expected the vault's shell, got: %v", command)
+	}
+	if shell := detectShell("one"); shell != "zsh" {
+		t.Errorf("Expected zsh, got: %s", shell)
+	}
+
+	command = interactiveShellCommand("two")
+	if !reflect.DeepEqual(command, []string{"/bin/fish", "--login"}) {
+		t.Errorf("Expected SHELL, got: %v", command)
+	}
+
+	os.Unsetenv("SHELL")
+	if shell := detectShell("two"); shell != "sh" {
+		t.Errorf("Expected the default shell, got: %s", shell)
+	}
+}
@@ -7,6 +7,8 @@ import (
 type Copy struct {
 	OldVaultName string
 	NewVaultName string
+
+	SealOptions vaulted.SealOptions
 }
 
 func (c *Copy) Run(store vaulted.Store) error {
@@ -15,7 +17,12 @@ func (c *Copy) Run(store vaulted.Store) error {
 		return err
 	}
 
-	err = store.SealVault(vault, c.NewVaultName)
+	password, err := store.Steward().GetPassword(vaulted.SealOperation, c.NewVaultName)
+	if err != nil {
+		return err
+	}
+
+	err = store.SealVaultWithOptions(vault, c.NewVaultName, password, &c.SealOptions)
 	if err != nil {
 		return err
 	}
@@ -69,3 +69,24 @@ func TestCopyToSelf(t *testing.T) {
 		t.Fatal("Passwords should be different, but aren't!")
 	}
 }
+
+func TestCopyWithKeyMethod(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	c := Copy{
+		OldVaultName: "one",
+		NewVaultName: "one",
+		SealOptions: vaulted.SealOptions{
+			KeyMethod: "argon2id",
+		},
+	}
+	err := c.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.SealOptions["one"].KeyMethod != "argon2id" {
+		t.Fatalf("Expected key method: argon2id, got: %s", store.SealOptions["one"].KeyMethod)
+	}
+}
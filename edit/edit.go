@@ -16,6 +16,8 @@ var (
 type Edit struct {
 	New       bool
 	VaultName string
+
+	SealOptions vaulted.SealOptions
 }
 
 func (e *Edit) Run(store vaulted.Store) error {
@@ -60,10 +62,13 @@ func (e *Edit) Run(store vaulted.Store) error {
 	}
 
 	if e.New {
-		err = store.SealVault(vault, e.VaultName)
-	} else {
-		err = store.SealVaultWithPassword(vault, e.VaultName, password)
+		password, err = store.Steward().GetPassword(vaulted.SealOperation, e.VaultName)
+		if err != nil {
+			return err
+		}
 	}
+
+	err = store.SealVaultWithOptions(vault, e.VaultName, password, &e.SealOptions)
 	if err != nil {
 		return err
 	}
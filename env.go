@@ -96,7 +96,10 @@ func (e *Env) Run(store vaulted.Store) error {
 	}
 	tmpl, err := template.New("sessionTmpl").Funcs(templateFuncMap).Parse(templateStr)
 
-	variables := session.Variables()
+	variables, err := session.Variables()
+	if err != nil {
+		return err
+	}
 	sort.Strings(variables.Unset)
 
 	vals := templateVals{
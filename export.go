@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+var (
+	ErrExportFailed    = errors.New("Some vaults could not be exported")
+	ErrNothingToExport = errors.New("No vaults to export")
+)
+
+type Export struct {
+	VaultNames []string
+	All        bool
+	Output     string
+}
+
+func (e *Export) Run(store vaulted.Store) error {
+	names := e.VaultNames
+	if e.All {
+		var err error
+		names, err = store.ListVaults()
+		if err != nil {
+			return err
+		}
+		sort.Strings(names)
+	}
+
+	bundle := &vaulted.Bundle{}
+	failures := 0
+	for _, name := range names {
+		vault, _, err := store.OpenVault(name)
+		if err != nil {
+			failures++
+			fmt.Printf("%s: %v\n", name, err)
+			continue
+		}
+
+		metadata := vault.Metadata
+		vault.Metadata = nil
+		bundle.Vaults = append(bundle.Vaults, &vaulted.BundledVault{
+			Name:     name,
+			Metadata: metadata,
+			Vault:    vault,
+		})
+	}
+
+	if len(bundle.Vaults) == 0 {
+		if failures > 0 {
+			return ErrorWithExitCode{ErrExportFailed, failures}
+		}
+		return ErrorWithExitCode{ErrNothingToExport, EX_USAGE_ERROR}
+	}
+
+	content, err := store.SealBundle(bundle, filepath.Base(e.Output))
+	if err != nil {
+		return err
+	}
+
+	// the bundle replaces an existing file (rather than being written into
+	// it), so it is never readable with the existing file's permissions
+	err = vaulted.WriteFileAtomic(e.Output, content, 0600)
+	if err != nil {
+		return err
+	}
+
+	for _, bundled := range bundle.Vaults {
+		fmt.Printf("%s: exported\n", bundled.Name)
+	}
+
+	if failures > 0 {
+		return ErrorWithExitCode{ErrExportFailed, failures}
+	}
+
+	return nil
+}
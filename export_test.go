@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Vars: map[string]string{"ONE": "1"},
+		Metadata: &vaulted.VaultMetadata{
+			Description: "The first vault",
+		},
+	}
+	store.Vaults["two"] = &vaulted.Vault{
+		Vars: map[string]string{"TWO": "2"},
+	}
+	store.Vaults["three"] = &vaulted.Vault{}
+
+	// an existing file is replaced (rather than keeping its permissions)
+	output := filepath.Join(dir, "backup")
+	err = ioutil.WriteFile(output, []byte("previous"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := CaptureStdout(func() {
+		e := Export{
+			All:    true,
+			Output: output,
+		}
+		err := e.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expectedStdout := []byte("one: exported\nthree: exported\ntwo: exported\n")
+	if !bytes.Equal(stdout, expectedStdout) {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expectedStdout, stdout)
+	}
+
+	info, err := os.Stat(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected the bundle to be private, got mode %04o", info.Mode().Perm())
+	}
+
+	content, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &vaulted.Bundle{
+		Vaults: []*vaulted.BundledVault{
+			{
+				Name: "one",
+				Metadata: &vaulted.VaultMetadata{
+					Description: "The first vault",
+				},
+				Vault: cloneVault(&vaulted.Vault{
+					Vars: map[string]string{"ONE": "1"},
+				}),
+			},
+			{
+				Name:  "three",
+				Vault: cloneVault(&vaulted.Vault{}),
+			},
+			{
+				Name: "two",
+				Vault: cloneVault(&vaulted.Vault{
+					Vars: map[string]string{"TWO": "2"},
+				}),
+			},
+		},
+	}
+	expectedContent, _ := json.Marshal(expected)
+	if !bytes.Equal(expectedContent, content) {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expectedContent, content)
+	}
+}
+
+func TestExportMissingVault(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	output := filepath.Join(dir, "backup")
+	CaptureStdout(func() {
+		e := Export{
+			VaultNames: []string{"one", "missing"},
+			Output:     output,
+		}
+		err := e.Run(store)
+		if exiterr, ok := err.(ErrorWithExitCode); !ok || exiterr.ExitCode != 1 {
+			t.Fatalf("Expected an exit code of 1, got: %v", err)
+		}
+	})
+
+	// the vaults that could be opened are still exported
+	_, err = os.Stat(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
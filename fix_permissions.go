@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+var (
+	ErrFixPermissionsFailed = errors.New("Some permissions could not be fixed")
+)
+
+type FixPermissions struct{}
+
+func (f *FixPermissions) Run(store vaulted.Store) error {
+	fixed, remaining, err := store.FixPermissions()
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range fixed {
+		fmt.Printf("fixed: %s\n", problem)
+	}
+	for _, problem := range remaining {
+		fmt.Printf("could not fix: %s\n", problem)
+	}
+
+	if len(remaining) > 0 {
+		return ErrorWithExitCode{ErrFixPermissionsFailed, EX_NO_PERMISSION}
+	}
+
+	return nil
+}
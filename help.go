@@ -19,25 +19,37 @@ var (
 	ErrHelp = errors.New("help requested")
 
 	HelpAliases = map[string]string{
-		"add":      "add",
-		"create":   "add",
-		"new":      "add",
-		"cp":       "cp",
-		"copy":     "cp",
-		"dump":     "dump",
-		"edit":     "edit",
-		"env":      "env",
-		"exec":     "exec",
-		"ls":       "ls",
-		"list":     "ls",
-		"load":     "load",
-		"passwd":   "passwd",
-		"password": "passwd",
-		"rm":       "rm",
-		"delete":   "rm",
-		"remove":   "rm",
-		"shell":    "shell",
-		"upgrade":  "upgrade",
+		"add":             "add",
+		"agent":           "agent",
+		"config":          "config",
+		"create":          "add",
+		"new":             "add",
+		"cp":              "cp",
+		"copy":            "cp",
+		"dump":            "dump",
+		"edit":            "edit",
+		"env":             "env",
+		"exec":            "exec",
+		"export":          "export",
+		"fix-permissions": "fix-permissions",
+		"history":         "history",
+		"ls":              "ls",
+		"list":            "ls",
+		"import":          "import",
+		"load":            "load",
+		"passwd":          "passwd",
+		"password":        "passwd",
+		"recipients":      "recipients",
+		"rm":              "rm",
+		"delete":          "rm",
+		"remove":          "rm",
+		"restore":         "restore",
+		"session":         "session",
+		"shell":           "shell",
+		"sync":            "sync",
+		"trash":           "trash",
+		"upgrade":         "upgrade",
+		"verify":          "verify",
 	}
 )
 
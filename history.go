@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type History struct {
+	VaultName string
+}
+
+func (h *History) Run(store vaulted.Store) error {
+	versions, err := store.ListVaultVersions(h.VaultName)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		fmt.Printf("%d  %s\n", version.Version, version.Sealed.Format("2 Jan 2006 15:04:05 MST"))
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestHistory(t *testing.T) {
+	store := NewTestStore()
+	store.SealVaultWithPassword(&vaulted.Vault{}, "one", "password")
+	store.SealVaultWithPassword(&vaulted.Vault{}, "one", "password")
+	store.SealVaultWithPassword(&vaulted.Vault{}, "one", "password")
+
+	output := CaptureStdout(func() {
+		h := History{
+			VaultName: "one",
+		}
+		err := h.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := "2  2 Jan 2006 22:04:05 UTC\n1  2 Jan 2006 22:04:05 UTC\n"
+	if string(output) != expected {
+		t.Fatalf("Incorrect output!\nExpected:\n%s\ngot:\n%s", expected, output)
+	}
+}
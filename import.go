@@ -0,0 +1,153 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+const (
+	ConflictSkip      = "skip"
+	ConflictRename    = "rename"
+	ConflictOverwrite = "overwrite"
+)
+
+var (
+	ErrImportFailed       = errors.New("Some vaults could not be imported")
+	ErrNotInBundle        = errors.New("Vault is not in the bundle")
+	ErrInvalidBundledName = errors.New("Invalid vault name")
+)
+
+type Import struct {
+	Bundle         string
+	VaultNames     []string
+	OnConflict     string
+	BundlePassword bool
+}
+
+func (i *Import) Run(store vaulted.Store) error {
+	content, err := ioutil.ReadFile(i.Bundle)
+	if os.IsNotExist(err) {
+		return ErrFileNotExist
+	}
+	if err != nil {
+		return err
+	}
+
+	bundle, password, err := store.OpenBundle(content, filepath.Base(i.Bundle))
+	if err != nil {
+		return err
+	}
+
+	// collect the current list of vaults (so we don't overwrite any)
+	vaults, _ := store.ListVaults()
+	existingVaults := map[string]bool{}
+	for _, name := range vaults {
+		existingVaults[name] = true
+	}
+
+	failures := 0
+	for _, bundled := range i.selectVaults(bundle, &failures) {
+		name := bundled.Name
+		if !validBundledName(name) || bundled.Vault == nil {
+			failures++
+			fmt.Printf("%s: %v\n", name, ErrInvalidBundledName)
+			continue
+		}
+
+		status := "imported"
+		if existingVaults[name] {
+			switch i.OnConflict {
+			case ConflictRename:
+				name = uniqueVaultName(name, existingVaults)
+				status = fmt.Sprintf("imported as %s", name)
+
+			case ConflictOverwrite:
+				status = "overwritten"
+
+			default:
+				fmt.Printf("%s: skipped (vault already exists)\n", name)
+				continue
+			}
+		}
+
+		vault := bundled.Vault
+		vault.Metadata = bundled.Metadata
+		if i.BundlePassword {
+			err = store.SealVaultWithPassword(vault, name, password)
+		} else {
+			err = store.SealVault(vault, name)
+		}
+		if err != nil {
+			failures++
+			fmt.Printf("%s: %v\n", bundled.Name, err)
+			continue
+		}
+
+		existingVaults[name] = true
+		fmt.Printf("%s: %s\n", bundled.Name, status)
+	}
+
+	if failures > 0 {
+		return ErrorWithExitCode{ErrImportFailed, failures}
+	}
+
+	return nil
+}
+
+// selectVaults returns the bundled vaults named by the command (or all of
+// them, if none were named). Names that aren't in the bundle are reported
+// and counted as failures.
+func (i *Import) selectVaults(bundle *vaulted.Bundle, failures *int) []*vaulted.BundledVault {
+	if len(i.VaultNames) == 0 {
+		return bundle.Vaults
+	}
+
+	bundled := map[string]*vaulted.BundledVault{}
+	for _, v := range bundle.Vaults {
+		bundled[v.Name] = v
+	}
+
+	var selected []*vaulted.BundledVault
+	for _, name := range i.VaultNames {
+		v, exists := bundled[name]
+		if !exists {
+			*failures++
+			fmt.Printf("%s: %v\n", name, ErrNotInBundle)
+			continue
+		}
+		selected = append(selected, v)
+	}
+	return selected
+}
+
+// validBundledName reports whether a vault name from a bundle can be used
+// safely (it must not escape the vault directory or be hidden).
+func validBundledName(name string) bool {
+	if name == "" || strings.HasPrefix(name, "/") {
+		return false
+	}
+
+	for _, part := range strings.Split(name, "/") {
+		if part == "" || strings.HasPrefix(part, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+// uniqueVaultName finds a name for a vault that doesn't conflict with an
+// existing vault by appending a number to it.
+func uniqueVaultName(name string, existingVaults map[string]bool) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", name, n)
+		if !existingVaults[candidate] {
+			return candidate
+		}
+	}
+}
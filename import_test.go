@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func writeTestBundle(t *testing.T, bundle *vaulted.Bundle) (string, func()) {
+	dir, err := ioutil.TempDir("", "vaulted-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "backup")
+	err = ioutil.WriteFile(path, content, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func testBundle() *vaulted.Bundle {
+	return &vaulted.Bundle{
+		Vaults: []*vaulted.BundledVault{
+			{
+				Name: "one",
+				Metadata: &vaulted.VaultMetadata{
+					Description: "Imported",
+				},
+				Vault: &vaulted.Vault{
+					Vars: map[string]string{"ONE": "imported"},
+				},
+			},
+			{
+				Name: "two",
+				Vault: &vaulted.Vault{
+					Vars: map[string]string{"TWO": "imported"},
+				},
+			},
+		},
+	}
+}
+
+func TestImport(t *testing.T) {
+	path, cleanup := writeTestBundle(t, testBundle())
+	defer cleanup()
+
+	existing := &vaulted.Vault{
+		Vars: map[string]string{"ONE": "existing"},
+	}
+
+	cases := []struct {
+		OnConflict string
+		Stdout     string
+		Vaults     map[string]string
+	}{
+		{
+			OnConflict: ConflictSkip,
+			Stdout:     "one: skipped (vault already exists)\ntwo: imported\n",
+			Vaults: map[string]string{
+				"one": "existing",
+				"two": "imported",
+			},
+		},
+		{
+			OnConflict: ConflictRename,
+			Stdout:     "one: imported as one-2\ntwo: imported\n",
+			Vaults: map[string]string{
+				"one":   "existing",
+				"one-2": "imported",
+				"two":   "imported",
+			},
+		},
+		{
+			OnConflict: ConflictOverwrite,
+			Stdout:     "one: overwritten\ntwo: imported\n",
+			Vaults: map[string]string{
+				"one": "imported",
+				"two": "imported",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		store := NewTestStore()
+		store.Vaults["one"] = cloneVault(existing)
+
+		stdout := CaptureStdout(func() {
+			i := Import{
+				Bundle:     path,
+				OnConflict: c.OnConflict,
+			}
+			err := i.Run(store)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		if string(stdout) != c.Stdout {
+			t.Fatalf("%s: expected:\n%s\ngot:\n%s", c.OnConflict, c.Stdout, stdout)
+		}
+
+		vaults := map[string]string{}
+		for name, vault := range store.Vaults {
+			for _, value := range vault.Vars {
+				vaults[name] = value
+			}
+		}
+		if !reflect.DeepEqual(c.Vaults, vaults) {
+			t.Fatalf("%s: expected: %#v, got: %#v", c.OnConflict, c.Vaults, vaults)
+		}
+	}
+}
+
+func TestImportPasswords(t *testing.T) {
+	path, cleanup := writeTestBundle(t, testBundle())
+	defer cleanup()
+
+	store := NewTestStore()
+	CaptureStdout(func() {
+		i := Import{
+			Bundle:     path,
+			VaultNames: []string{"one"},
+		}
+		err := i.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if store.Passwords["one"] != "sealed" {
+		t.Fatalf("Expected the vault to be sealed with its own password, got: %s", store.Passwords["one"])
+	}
+	if store.VaultExists("two") {
+		t.Fatal("Expected only the named vault to be imported")
+	}
+	if store.Vaults["one"].Metadata == nil || store.Vaults["one"].Metadata.Description != "Imported" {
+		t.Fatalf("Expected the metadata to be imported, got: %#v", store.Vaults["one"].Metadata)
+	}
+
+	store = NewTestStore()
+	CaptureStdout(func() {
+		i := Import{
+			Bundle:         path,
+			BundlePassword: true,
+		}
+		err := i.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	for _, name := range []string{"one", "two"} {
+		if store.Passwords[name] != "prompted open password" {
+			t.Fatalf("Expected %s to be sealed with the bundle password, got: %s", name, store.Passwords[name])
+		}
+	}
+}
+
+func TestImportInvalidNames(t *testing.T) {
+	bundle := &vaulted.Bundle{
+		Vaults: []*vaulted.BundledVault{
+			{Name: "../escape", Vault: &vaulted.Vault{}},
+			{Name: ".hidden", Vault: &vaulted.Vault{}},
+			{Name: "/absolute", Vault: &vaulted.Vault{}},
+			{Name: "ok", Vault: &vaulted.Vault{}},
+		},
+	}
+	path, cleanup := writeTestBundle(t, bundle)
+	defer cleanup()
+
+	store := NewTestStore()
+	stdout := CaptureStdout(func() {
+		i := Import{
+			Bundle:     path,
+			VaultNames: []string{"ok", "missing"},
+		}
+		err := i.Run(store)
+		if exiterr, ok := err.(ErrorWithExitCode); !ok || exiterr.ExitCode != 1 {
+			t.Fatalf("Expected an exit code of 1, got: %v", err)
+		}
+
+		i = Import{
+			Bundle: path,
+		}
+		err = i.Run(store)
+		if exiterr, ok := err.(ErrorWithExitCode); !ok || exiterr.ExitCode != 3 {
+			t.Fatalf("Expected an exit code of 3, got: %v", err)
+		}
+	})
+
+	expected := []byte("missing: Vault is not in the bundle\nok: imported\n" +
+		"../escape: Invalid vault name\n.hidden: Invalid vault name\n/absolute: Invalid vault name\nok: skipped (vault already exists)\n")
+	if !bytes.Equal(stdout, expected) {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, stdout)
+	}
+	if len(store.Vaults) != 1 {
+		t.Fatalf("Expected only the valid vault to be imported, got: %v", store.Vaults)
+	}
+}
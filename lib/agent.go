@@ -0,0 +1,424 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/miquella/xdg"
+)
+
+const (
+	DefaultAgentIdleTimeout = 15 * time.Minute
+	DefaultAgentMaxLifetime = 8 * time.Hour
+)
+
+var (
+	ErrAgentNotRunning     = errors.New("The agent is not running")
+	ErrAgentAlreadyRunning = errors.New("The agent is already running")
+	ErrAgentKeyNotFound    = errors.New("Key not found in the agent")
+)
+
+// AgentSocketPath is the path of the agent's socket. If blank, the socket is
+// kept in $XDG_RUNTIME_DIR/vaulted (or $XDG_CACHE_HOME/vaulted, if there is no
+// runtime directory).
+var AgentSocketPath string
+
+func agentSocketPath() string {
+	if AgentSocketPath != "" {
+		return AgentSocketPath
+	}
+
+	if xdg.RUNTIME_DIR != "" {
+		return xdg.RUNTIME_DIR.Join("vaulted", "agent.sock")
+	}
+	return xdg.CACHE_HOME.Join("vaulted", "agent.sock")
+}
+
+// AgentStatus describes a running agent and the vault keys it holds.
+type AgentStatus struct {
+	PID         int           `json:"pid"`
+	Started     time.Time     `json:"started"`
+	IdleTimeout time.Duration `json:"idle_timeout"`
+	MaxLifetime time.Duration `json:"max_lifetime"`
+	Vaults      []AgentVault  `json:"vaults"`
+}
+
+// AgentVault describes a vault key held by the agent.
+type AgentVault struct {
+	Name    string    `json:"name"`
+	Added   time.Time `json:"added"`
+	Expires time.Time `json:"expires"`
+}
+
+type agentRequest struct {
+	Command string `json:"command"`
+	Name    string `json:"name,omitempty"`
+	ID      string `json:"id,omitempty"`
+	Key     []byte `json:"key,omitempty"`
+}
+
+type agentResponse struct {
+	Key    []byte       `json:"key,omitempty"`
+	Status *AgentStatus `json:"status,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+type agentKey struct {
+	id       string
+	key      []byte
+	added    time.Time
+	lastUsed time.Time
+}
+
+func (k *agentKey) wipe() {
+	for i := range k.key {
+		k.key[i] = 0
+	}
+}
+
+// Agent holds vault keys in memory, so vaults can be opened without deriving
+// their keys again. Keys are wiped once they have been idle for IdleTimeout,
+// have been held for MaxLifetime, or the agent is locked (or stopped).
+type Agent struct {
+	IdleTimeout time.Duration
+	MaxLifetime time.Duration
+
+	mutex    sync.Mutex
+	started  time.Time
+	keys     map[string]*agentKey
+	listener net.Listener
+	stopped  chan struct{}
+}
+
+func NewAgent(idleTimeout, maxLifetime time.Duration) *Agent {
+	return &Agent{
+		IdleTimeout: idleTimeout,
+		MaxLifetime: maxLifetime,
+		started:     time.Now(),
+		keys:        make(map[string]*agentKey),
+		stopped:     make(chan struct{}),
+	}
+}
+
+// checkAgentPermissions reports the problems with the ownership and
+// permissions of the socket (or directory) at path. Keys are sent to whoever
+// listens on the socket, so it (and its directory) must not be a symbolic link
+// and must not be accessible by other users at all.
+func checkAgentPermissions(path string) []PermissionProblem {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return []PermissionProblem{{Path: path, Problem: err.Error()}}
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return []PermissionProblem{{Path: path, Problem: "is a symbolic link"}}
+	}
+
+	problems := checkInfoPermissions(path, info, true)
+	if info.Mode().Perm()&0011 != 0 {
+		problems = append(problems, PermissionProblem{Path: path, Problem: "is accessible by other users", fix: 0011})
+	}
+	return problems
+}
+
+// ListenAgent creates the agent's socket. The socket (and the directory it is
+// in) is only accessible by the current user; an existing directory that is
+// accessible by other users is rejected.
+func ListenAgent() (net.Listener, error) {
+	path := agentSocketPath()
+
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := checkAgentPermissions(filepath.Dir(path))
+	if len(problems) > 0 {
+		return nil, &InsecurePermissionsError{Problems: problems}
+	}
+
+	if AgentRunning() {
+		return nil, ErrAgentAlreadyRunning
+	}
+
+	// remove a stale socket (left behind by an agent that didn't exit cleanly)
+	os.Remove(path)
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(path, 0600)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	problems = checkAgentPermissions(path)
+	if len(problems) > 0 {
+		listener.Close()
+		return nil, &InsecurePermissionsError{Problems: problems}
+	}
+
+	return listener, nil
+}
+
+// Serve handles requests on the listener until the agent is stopped.
+func (a *Agent) Serve(listener net.Listener) error {
+	a.mutex.Lock()
+	a.listener = listener
+	a.mutex.Unlock()
+
+	go a.expireKeys()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-a.stopped:
+				return nil
+			default:
+				return err
+			}
+		}
+
+		go a.handle(conn)
+	}
+}
+
+// Lock wipes all of the keys held by the agent.
+func (a *Agent) Lock() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.wipeKeys()
+}
+
+func (a *Agent) wipeKeys() {
+	for name, k := range a.keys {
+		k.wipe()
+		delete(a.keys, name)
+	}
+}
+
+// Stop wipes all of the keys held by the agent and stops serving requests.
+func (a *Agent) Stop() {
+	a.Lock()
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	select {
+	case <-a.stopped:
+		return
+	default:
+	}
+
+	close(a.stopped)
+	if a.listener != nil {
+		a.listener.Close()
+	}
+}
+
+func (a *Agent) expireKeys() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stopped:
+			return
+
+		case now := <-ticker.C:
+			a.mutex.Lock()
+			for name, k := range a.keys {
+				if !now.Before(a.expiration(k)) {
+					k.wipe()
+					delete(a.keys, name)
+				}
+			}
+			a.mutex.Unlock()
+		}
+	}
+}
+
+func (a *Agent) expiration(k *agentKey) time.Time {
+	expires := k.lastUsed.Add(a.IdleTimeout)
+	if lifetime := k.added.Add(a.MaxLifetime); lifetime.Before(expires) {
+		expires = lifetime
+	}
+	return expires
+}
+
+func (a *Agent) handle(conn net.Conn) {
+	defer conn.Close()
+
+	request := agentRequest{}
+	err := json.NewDecoder(conn).Decode(&request)
+	if err != nil {
+		return
+	}
+
+	response := a.respond(&request)
+	json.NewEncoder(conn).Encode(response)
+
+	if request.Command == "stop" {
+		a.Stop()
+	}
+}
+
+func (a *Agent) respond(request *agentRequest) *agentResponse {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	now := time.Now()
+
+	switch request.Command {
+	case "get":
+		k, ok := a.keys[request.Name]
+		if !ok || k.id != request.ID || !now.Before(a.expiration(k)) {
+			return &agentResponse{Error: ErrAgentKeyNotFound.Error()}
+		}
+		k.lastUsed = now
+		return &agentResponse{Key: append([]byte{}, k.key...)}
+
+	case "add":
+		if k, ok := a.keys[request.Name]; ok {
+			k.wipe()
+		}
+		a.keys[request.Name] = &agentKey{
+			id:       request.ID,
+			key:      request.Key,
+			added:    now,
+			lastUsed: now,
+		}
+		return &agentResponse{}
+
+	case "lock":
+		a.wipeKeys()
+		return &agentResponse{}
+
+	case "status":
+		status := &AgentStatus{
+			PID:         os.Getpid(),
+			Started:     a.started,
+			IdleTimeout: a.IdleTimeout,
+			MaxLifetime: a.MaxLifetime,
+		}
+		for name, k := range a.keys {
+			status.Vaults = append(status.Vaults, AgentVault{
+				Name:    name,
+				Added:   k.added,
+				Expires: a.expiration(k),
+			})
+		}
+		sort.Slice(status.Vaults, func(i, j int) bool {
+			return status.Vaults[i].Name < status.Vaults[j].Name
+		})
+		return &agentResponse{Status: status}
+
+	case "stop":
+		return &agentResponse{}
+
+	default:
+		return &agentResponse{Error: "Unknown agent command: " + request.Command}
+	}
+}
+
+// agentClient talks to the agent (if it is running). Failing to reach the
+// agent isn't an error, vaults are simply opened without it.
+type agentClient struct{}
+
+func (c *agentClient) key(name, id string) []byte {
+	response, err := agentCall(&agentRequest{
+		Command: "get",
+		Name:    name,
+		ID:      id,
+	})
+	if err != nil {
+		return nil
+	}
+	return response.Key
+}
+
+func (c *agentClient) addKey(name, id string, key []byte) {
+	agentCall(&agentRequest{
+		Command: "add",
+		Name:    name,
+		ID:      id,
+		Key:     key,
+	})
+}
+
+func agentCall(request *agentRequest) (*agentResponse, error) {
+	path := agentSocketPath()
+
+	// keys are only sent to an agent listening on a socket that nobody else
+	// could have created (or replaced)
+	if _, err := os.Lstat(path); err != nil {
+		return nil, ErrAgentNotRunning
+	}
+	problems := checkAgentPermissions(filepath.Dir(path))
+	problems = append(problems, checkAgentPermissions(path)...)
+	if len(problems) > 0 {
+		return nil, &InsecurePermissionsError{Problems: problems}
+	}
+
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err != nil {
+		return nil, ErrAgentNotRunning
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	err = json.NewEncoder(conn).Encode(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := agentResponse{}
+	err = json.NewDecoder(conn).Decode(&response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return &response, nil
+}
+
+// AgentRunning reports whether the agent is running.
+func AgentRunning() bool {
+	_, err := GetAgentStatus()
+	return err == nil
+}
+
+// GetAgentStatus returns the status of the running agent.
+func GetAgentStatus() (*AgentStatus, error) {
+	response, err := agentCall(&agentRequest{Command: "status"})
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, nil
+}
+
+// LockAgent wipes all of the keys held by the running agent.
+func LockAgent() error {
+	_, err := agentCall(&agentRequest{Command: "lock"})
+	return err
+}
+
+// StopAgent wipes all of the keys held by the running agent and stops it.
+func StopAgent() error {
+	_, err := agentCall(&agentRequest{Command: "stop"})
+	return err
+}
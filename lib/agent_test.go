@@ -0,0 +1,252 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func startTestAgent(t *testing.T, idleTimeout time.Duration) func() {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+
+	previousPath := vaulted.AgentSocketPath
+	vaulted.AgentSocketPath = filepath.Join(dir, "agent.sock")
+
+	listener, err := vaulted.ListenAgent()
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	agent := vaulted.NewAgent(idleTimeout, vaulted.DefaultAgentMaxLifetime)
+	served := make(chan error)
+	go func() {
+		served <- agent.Serve(listener)
+	}()
+
+	return func() {
+		agent.Stop()
+		err := <-served
+		if err != nil {
+			t.Errorf("failed to serve: %v", err)
+		}
+
+		vaulted.AgentSocketPath = previousPath
+		os.RemoveAll(dir)
+	}
+}
+
+func TestAgent(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	stop := startTestAgent(t, vaulted.DefaultAgentIdleTimeout)
+	defer stop()
+
+	if !vaulted.AgentRunning() {
+		t.Fatal("expected the agent to be running")
+	}
+
+	_, err := vaulted.ListenAgent()
+	if err != vaulted.ErrAgentAlreadyRunning {
+		t.Fatalf("expected ErrAgentAlreadyRunning, got %v", err)
+	}
+
+	// opening the vault with the password adds its key to the agent
+	_, _, err = testStore().OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	status, err := vaulted.GetAgentStatus()
+	if err != nil {
+		t.Fatalf("failed to get agent status: %v", err)
+	}
+	if len(status.Vaults) != 1 || status.Vaults[0].Name != "aaa" {
+		t.Fatalf("expected the agent to hold the key for 'aaa', got %#v", status.Vaults)
+	}
+
+	// other processes open the vault with the agent (without the password)
+	agentStore := testStoreWithPassword("invalid password")
+	vault, password, err := agentStore.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault with the agent: %v", err)
+	}
+	if password != "" {
+		t.Fatalf("expected no password, got %s", password)
+	}
+	if vault.Vars["TEST"] != "AAA" {
+		t.Fatalf("expected: AAA, got %s", vault.Vars["TEST"])
+	}
+
+	// and can seal it again (with the same password)
+	vault.Vars["TEST"] = "AGENT"
+	err = agentStore.SealVaultWithPassword(vault, "aaa", password)
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vault, _, err = testStore().OpenVaultWithPassword("aaa", "password")
+	if err != nil {
+		t.Fatalf("failed to open vault with password: %v", err)
+	}
+	if vault.Vars["TEST"] != "AGENT" {
+		t.Fatalf("expected: AGENT, got %s", vault.Vars["TEST"])
+	}
+
+	// changing the password invalidates the key held by the agent
+	err = testStore().SealVaultWithPassword(vault, "aaa", "new password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, _, err = testStoreWithPassword("invalid password").OpenVault("aaa")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected ErrIncorrectPassword, got %v", err)
+	}
+
+	// locking the agent wipes the keys
+	_, _, err = testStoreWithPassword("new password").OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	err = vaulted.LockAgent()
+	if err != nil {
+		t.Fatalf("failed to lock agent: %v", err)
+	}
+
+	_, _, err = testStoreWithPassword("invalid password").OpenVault("aaa")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected ErrIncorrectPassword, got %v", err)
+	}
+}
+
+func TestAgentIdleTimeout(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	stop := startTestAgent(t, 100*time.Millisecond)
+	defer stop()
+
+	_, _, err := testStore().OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+
+	_, _, err = testStoreWithPassword("invalid password").OpenVault("aaa")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected ErrIncorrectPassword, got %v", err)
+	}
+}
+
+func TestStopAgent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(path string) {
+		vaulted.AgentSocketPath = path
+	}(vaulted.AgentSocketPath)
+	vaulted.AgentSocketPath = filepath.Join(dir, "agent.sock")
+
+	listener, err := vaulted.ListenAgent()
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	served := make(chan error)
+	go func() {
+		served <- vaulted.NewAgent(vaulted.DefaultAgentIdleTimeout, vaulted.DefaultAgentMaxLifetime).Serve(listener)
+	}()
+
+	err = vaulted.StopAgent()
+	if err != nil {
+		t.Fatalf("failed to stop agent: %v", err)
+	}
+
+	err = <-served
+	if err != nil {
+		t.Fatalf("failed to serve: %v", err)
+	}
+
+	if vaulted.AgentRunning() {
+		t.Fatal("expected the agent to be stopped")
+	}
+
+	err = vaulted.StopAgent()
+	if err != vaulted.ErrAgentNotRunning {
+		t.Fatalf("expected ErrAgentNotRunning, got %v", err)
+	}
+}
+
+func TestAgentInsecurePermissions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(path string) {
+		vaulted.AgentSocketPath = path
+	}(vaulted.AgentSocketPath)
+	vaulted.AgentSocketPath = filepath.Join(dir, "agent", "agent.sock")
+
+	// an existing directory that is accessible by other users is rejected
+	err = os.Mkdir(filepath.Dir(vaulted.AgentSocketPath), 0755)
+	if err != nil {
+		t.Fatalf("failed to create socket dir: %v", err)
+	}
+	_, err = vaulted.ListenAgent()
+	if _, ok := err.(*vaulted.InsecurePermissionsError); !ok {
+		t.Fatalf("expected an insecure permissions error, got %v", err)
+	}
+
+	err = os.Chmod(filepath.Dir(vaulted.AgentSocketPath), 0700)
+	if err != nil {
+		t.Fatalf("failed to chmod socket dir: %v", err)
+	}
+	listener, err := vaulted.ListenAgent()
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	served := make(chan error)
+	go func() {
+		served <- vaulted.NewAgent(vaulted.DefaultAgentIdleTimeout, vaulted.DefaultAgentMaxLifetime).Serve(listener)
+	}()
+
+	// a socket that is accessible by other users isn't connected to
+	err = os.Chmod(vaulted.AgentSocketPath, 0666)
+	if err != nil {
+		t.Fatalf("failed to chmod socket: %v", err)
+	}
+	_, err = vaulted.GetAgentStatus()
+	if _, ok := err.(*vaulted.InsecurePermissionsError); !ok {
+		t.Fatalf("expected an insecure permissions error, got %v", err)
+	}
+
+	err = os.Chmod(vaulted.AgentSocketPath, 0600)
+	if err != nil {
+		t.Fatalf("failed to chmod socket: %v", err)
+	}
+	err = vaulted.StopAgent()
+	if err != nil {
+		t.Fatalf("failed to stop agent: %v", err)
+	}
+
+	err = <-served
+	if err != nil {
+		t.Fatalf("failed to serve: %v", err)
+	}
+}
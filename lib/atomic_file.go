@@ -0,0 +1,70 @@
+package vaulted
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic writes content into filename.
+//
+// The content is written to a temporary (hidden) file in the same directory,
+// synced to disk, and then renamed over filename. This ensures filename always
+// contains either the previous content or the complete new content, even if
+// the process crashes or the disk fills up part way through.
+func WriteFileAtomic(filename string, content []byte, perm os.FileMode) error {
+	dir, base := filepath.Split(filename)
+	f, err := ioutil.TempFile(dir, "."+base+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpname := f.Name()
+
+	// ensure the temporary file doesn't linger if anything fails
+	committed := false
+	defer func() {
+		if !committed {
+			f.Close()
+			os.Remove(tmpname)
+		}
+	}()
+
+	err = f.Chmod(perm)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(content)
+	if err != nil {
+		return err
+	}
+
+	err = f.Sync()
+	if err != nil {
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmpname, filename)
+	if err != nil {
+		return err
+	}
+	committed = true
+
+	return syncDir(dir)
+}
+
+// syncDir flushes a directory's entries (e.g. a rename) to disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
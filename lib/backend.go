@@ -0,0 +1,56 @@
+package vaulted
+
+import (
+	"time"
+)
+
+// BlobKind identifies the kind of content a blob holds. Backends may store
+// each kind of blob separately (e.g. session caches in a cache directory).
+type BlobKind int
+
+const (
+	// VaultBlob blobs hold sealed vaults.
+	VaultBlob BlobKind = iota
+
+	// SessionBlob blobs hold sealed session caches.
+	SessionBlob
+
+	// HistoryBlob blobs hold previously sealed versions of vaults. They are
+	// named "<vault>/<version>".
+	HistoryBlob
+
+	// TrashBlob blobs hold vaults that have been moved to the trash.
+	TrashBlob
+)
+
+// BlobInfo describes a blob stored in a backend.
+type BlobInfo struct {
+	Name     string
+	Modified time.Time
+}
+
+// Backend stores the blobs that make up a store.
+//
+// Blobs are opaque to the backend; all encryption happens before content is
+// handed to the backend.
+type Backend interface {
+	// Read returns the content of the named blob, or os.ErrNotExist if the
+	// blob doesn't exist.
+	Read(kind BlobKind, name string) ([]byte, error)
+
+	// Write replaces the content of the named blob. Writes must be atomic:
+	// readers see either the previous content or the new content.
+	Write(kind BlobKind, name string, content []byte) error
+
+	// Remove removes the named blob, or returns os.ErrNotExist if the blob
+	// doesn't exist.
+	Remove(kind BlobKind, name string) error
+
+	// List lists the blobs of the specified kind.
+	List(kind BlobKind) ([]BlobInfo, error)
+
+	// Lock acquires an exclusive lock for the named vault, blocking until it
+	// is available. The lock covers the vault and all of its related blobs.
+	// The returned function releases the lock.
+	Lock(name string) (func(), error)
+}
@@ -0,0 +1,229 @@
+package vaulted
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/miquella/xdg"
+)
+
+// fileLayout describes where a file backend stores each kind of blob.
+type fileLayout struct {
+	// VaultDirs lists the directories vaults are read from, in order of
+	// precedence. Vaults are only written to (and removed from) the first.
+	VaultDirs []string
+
+	SessionDir string
+	HistoryDir string
+	TrashDir   string
+	LockDir    string
+}
+
+func (l fileLayout) dir(kind BlobKind) string {
+	switch kind {
+	case VaultBlob:
+		return l.VaultDirs[0]
+	case SessionBlob:
+		return l.SessionDir
+	case HistoryBlob:
+		return l.HistoryDir
+	case TrashBlob:
+		return l.TrashDir
+	}
+
+	panic(fmt.Sprintf("unknown blob kind: %d", kind))
+}
+
+type fileBackend struct {
+	// layout is evaluated for each operation so that changes to the XDG
+	// environment are respected
+	layout func() fileLayout
+}
+
+// NewXDGBackend creates a backend that stores vaults according to the XDG
+// Base Directory Specification. Vaults are read from $XDG_DATA_HOME/vaulted
+// and $XDG_DATA_DIRS/vaulted, and session caches are kept in
+// $XDG_CACHE_HOME/vaulted.
+func NewXDGBackend() Backend {
+	return &fileBackend{
+		layout: func() fileLayout {
+			dataHome := xdg.DATA_HOME.Join("vaulted")
+			return fileLayout{
+				VaultDirs:  xdg.DATA.Join("vaulted"),
+				SessionDir: xdg.CACHE_HOME.Join("vaulted"),
+				HistoryDir: filepath.Join(dataHome, ".history"),
+				TrashDir:   filepath.Join(dataHome, ".trash"),
+				LockDir:    filepath.Join(dataHome, ".locks"),
+			}
+		},
+	}
+}
+
+// NewDirBackend creates a backend that stores vaults (and everything related
+// to them, including session caches) in a single directory.
+func NewDirBackend(dir string) Backend {
+	return &fileBackend{
+		layout: func() fileLayout {
+			return fileLayout{
+				VaultDirs:  []string{dir},
+				SessionDir: filepath.Join(dir, ".sessions"),
+				HistoryDir: filepath.Join(dir, ".history"),
+				TrashDir:   filepath.Join(dir, ".trash"),
+				LockDir:    filepath.Join(dir, ".locks"),
+			}
+		},
+	}
+}
+
+func (b *fileBackend) find(kind BlobKind, name string) (string, error) {
+	layout := b.layout()
+
+	dirs := []string{layout.dir(kind)}
+	if kind == VaultBlob {
+		dirs = layout.VaultDirs
+	}
+
+	for _, dir := range dirs {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		info, err := os.Stat(filename)
+		if err == nil && info.Mode().IsRegular() {
+			return filename, nil
+		}
+	}
+
+	return "", os.ErrNotExist
+}
+
+func (b *fileBackend) Read(kind BlobKind, name string) ([]byte, error) {
+	filename, err := b.find(kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, os.ErrNotExist
+	}
+	return content, err
+}
+
+func (b *fileBackend) Write(kind BlobKind, name string, content []byte) error {
+	filename := filepath.Join(b.layout().dir(kind), filepath.FromSlash(name))
+	err := os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+
+	return WriteFileAtomic(filename, content, 0600)
+}
+
+func (b *fileBackend) Remove(kind BlobKind, name string) error {
+	filename, err := b.find(kind, name)
+	if err != nil {
+		return err
+	}
+
+	dir := b.layout().dir(kind)
+	if filename != filepath.Join(dir, filepath.FromSlash(name)) {
+		return fmt.Errorf("Because %s is outside the vaulted managed directory (%s), it must be removed manually", filename, dir)
+	}
+
+	err = os.Remove(filename)
+	if os.IsNotExist(err) {
+		return os.ErrNotExist
+	}
+	return err
+}
+
+func (b *fileBackend) List(kind BlobKind) ([]BlobInfo, error) {
+	layout := b.layout()
+
+	dirs := []string{layout.dir(kind)}
+	if kind == VaultBlob {
+		dirs = layout.VaultDirs
+	}
+
+	var found []BlobInfo
+	emitted := map[string]bool{}
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			// hidden files are in-progress writes (and hidden directories
+			// hold other kinds of blobs)
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			// only history is nested (by vault name)
+			if info.IsDir() && path != dir && kind != HistoryBlob {
+				return filepath.SkipDir
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+
+			name, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			name = filepath.ToSlash(name)
+
+			if !emitted[name] {
+				emitted[name] = true
+				found = append(found, BlobInfo{
+					Name:     name,
+					Modified: info.ModTime(),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return found, nil
+}
+
+func (b *fileBackend) Lock(name string) (func(), error) {
+	pathname := b.layout().LockDir
+	err := os.MkdirAll(pathname, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filepath.Join(pathname, name), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			break
+		}
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
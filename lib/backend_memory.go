@@ -0,0 +1,99 @@
+package vaulted
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+type memoryBlob struct {
+	content  []byte
+	modified time.Time
+}
+
+type memoryBackend struct {
+	mutex sync.Mutex
+	blobs map[BlobKind]map[string]memoryBlob
+	locks map[string]*sync.Mutex
+}
+
+// NewMemoryBackend creates a backend that keeps everything in memory. It is
+// primarily intended for tests of tools that embed vaulted.
+func NewMemoryBackend() Backend {
+	return &memoryBackend{
+		blobs: make(map[BlobKind]map[string]memoryBlob),
+		locks: make(map[string]*sync.Mutex),
+	}
+}
+
+func (b *memoryBackend) Read(kind BlobKind, name string) ([]byte, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	blob, exists := b.blobs[kind][name]
+	if !exists {
+		return nil, os.ErrNotExist
+	}
+
+	return append([]byte(nil), blob.content...), nil
+}
+
+func (b *memoryBackend) Write(kind BlobKind, name string, content []byte) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.blobs[kind] == nil {
+		b.blobs[kind] = make(map[string]memoryBlob)
+	}
+	b.blobs[kind][name] = memoryBlob{
+		content:  append([]byte(nil), content...),
+		modified: time.Now(),
+	}
+
+	return nil
+}
+
+func (b *memoryBackend) Remove(kind BlobKind, name string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, exists := b.blobs[kind][name]; !exists {
+		return os.ErrNotExist
+	}
+	delete(b.blobs[kind], name)
+
+	return nil
+}
+
+func (b *memoryBackend) List(kind BlobKind) ([]BlobInfo, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var found []BlobInfo
+	for name, blob := range b.blobs[kind] {
+		found = append(found, BlobInfo{
+			Name:     name,
+			Modified: blob.modified,
+		})
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Name < found[j].Name
+	})
+
+	return found, nil
+}
+
+func (b *memoryBackend) Lock(name string) (func(), error) {
+	b.mutex.Lock()
+	lock := b.locks[name]
+	if lock == nil {
+		lock = &sync.Mutex{}
+		b.locks[name] = lock
+	}
+	b.mutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock, nil
+}
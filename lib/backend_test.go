@@ -0,0 +1,134 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/miquella/xdg"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestMemoryBackend(t *testing.T) {
+	store := vaulted.NewWithBackend(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	testBackendStore(t, store)
+}
+
+func TestDirBackend(t *testing.T) {
+	setupXDG(t)
+	defer teardownXDG(t)
+
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := vaulted.NewWithBackend(vaulted.NewStaticSteward("password"), vaulted.NewDirBackend(dir))
+	testBackendStore(t, store)
+
+	if _, err := os.Stat(filepath.Join(dir, "bbb")); err != nil {
+		t.Errorf("expected 'bbb' to be stored in the directory: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(string(xdg.DATA_HOME), "vaulted")); !os.IsNotExist(err) {
+		t.Error("expected nothing to be written to XDG_DATA_HOME")
+	}
+}
+
+func TestXDGBackendRemoveUnmanagedVault(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	backend := vaulted.NewXDGBackend()
+
+	err := backend.Remove(vaulted.VaultBlob, "ccc")
+	if err == nil || os.IsNotExist(err) {
+		t.Fatalf("expected an error removing an unmanaged vault, got %v", err)
+	}
+
+	err = backend.Remove(vaulted.VaultBlob, "ddd")
+	if err != os.ErrNotExist {
+		t.Fatalf("expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func testBackendStore(t *testing.T, store vaulted.Store) {
+	for _, name := range []string{"aaa", "bbb"} {
+		vault := &vaulted.Vault{
+			Vars: map[string]string{"TEST": name},
+		}
+		err := store.SealVault(vault, name)
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	vaults, err := store.ListVaults()
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+	sort.Strings(vaults)
+	expected := []string{"aaa", "bbb"}
+	if !reflect.DeepEqual(expected, vaults) {
+		t.Fatalf("expected %#v, got %#v", expected, vaults)
+	}
+
+	vault, _, err := store.OpenVault("bbb")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if vault.Vars["TEST"] != "bbb" {
+		t.Fatalf("expected: bbb, got %s", vault.Vars["TEST"])
+	}
+
+	// history
+	vault.Vars["TEST"] = "BBB"
+	err = store.SealVault(vault, "bbb")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	versions, err := store.ListVaultVersions("bbb")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 1 || versions[0].Version != 1 {
+		t.Fatalf("expected a single version, got %#v", versions)
+	}
+
+	// trash
+	err = store.TrashVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to trash vault: %v", err)
+	}
+	if store.VaultExists("aaa") {
+		t.Fatal("'aaa' should have been moved to the trash and wasn't")
+	}
+
+	err = store.RestoreTrashedVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to restore vault: %v", err)
+	}
+	if !store.VaultExists("aaa") {
+		t.Fatal("'aaa' should have been restored and wasn't")
+	}
+
+	// remove
+	err = store.RemoveVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to remove vault: %v", err)
+	}
+	if store.VaultExists("aaa") {
+		t.Fatal("'aaa' should have been removed and wasn't")
+	}
+
+	err = store.RemoveVault("aaa")
+	if err != os.ErrNotExist {
+		t.Fatalf("expected os.ErrNotExist, got %v", err)
+	}
+}
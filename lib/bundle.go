@@ -0,0 +1,143 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidBundle = errors.New("File is not a Vaulted bundle")
+)
+
+// BundleFileVersion is the current version of the bundle file format.
+const BundleFileVersion = 1
+
+// Bundle holds the content of several vaults, so they can be moved between
+// machines (or backed up) as a single file.
+type Bundle struct {
+	Vaults []*BundledVault `json:"vaults"`
+}
+
+type BundledVault struct {
+	Name     string         `json:"name"`
+	Metadata *VaultMetadata `json:"metadata,omitempty"`
+	Vault    *Vault         `json:"vault"`
+}
+
+// BundleFile is the encrypted form of a bundle. Like a vault file, the key is
+// derived from a password, but bundles never require a keyfile and have no
+// recipients, so they can be opened anywhere.
+type BundleFile struct {
+	Version int `json:"version"`
+
+	Key *VaultKey `json:"key"`
+
+	Method     string  `json:"method"`
+	Details    Details `json:"details,omitempty"`
+	Ciphertext []byte  `json:"ciphertext"`
+}
+
+// additionalData returns the data authenticated alongside the bundle content.
+func (bf *BundleFile) additionalData() []byte {
+	return []byte(fmt.Sprintf("vaulted\x00bundle\x00%s\x00v%d", bf.Method, bf.Version))
+}
+
+// SealBundleWithPassword encrypts the bundle with a key derived from
+// password, returning the content of the bundle file.
+func SealBundleWithPassword(bundle *Bundle, password string) ([]byte, error) {
+	bf := &BundleFile{
+		Version: BundleFileVersion,
+		Method:  DefaultMethod,
+		Details: make(Details),
+	}
+
+	var err error
+	bf.Key, err = newVaultKey(nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := bf.Key.key(password, nil, encryptionKeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	bf.Ciphertext, err = seal(bf.Method, key, content, bf.additionalData(), bf.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(bf)
+}
+
+// OpenBundleWithPassword decrypts the content of a bundle file.
+// ErrIncorrectPassword is returned if the password is incorrect.
+func OpenBundleWithPassword(content []byte, password string) (*Bundle, error) {
+	bf := BundleFile{}
+	err := json.Unmarshal(content, &bf)
+	if err != nil || bf.Key == nil || len(bf.Ciphertext) == 0 {
+		return nil, ErrInvalidBundle
+	}
+
+	if bf.Version < 1 || bf.Version > BundleFileVersion {
+		return nil, fmt.Errorf("Unsupported bundle version: %d", bf.Version)
+	}
+
+	if bf.Key.requiresKeyfile() {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	key, err := bf.Key.key(password, nil, encryptionKeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := open(bf.Method, key, bf.Ciphertext, bf.additionalData(), bf.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := Bundle{}
+	err = json.Unmarshal(plaintext, &bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+// SealBundle encrypts the bundle with a password requested from the steward
+// (as the password for name).
+func (s *store) SealBundle(bundle *Bundle, name string) ([]byte, error) {
+	password, err := s.steward.GetPassword(SealOperation, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return SealBundleWithPassword(bundle, password)
+}
+
+// OpenBundle decrypts the content of a bundle file with a password requested
+// from the steward (as the password for name). The password is returned
+// along with the bundle.
+func (s *store) OpenBundle(content []byte, name string) (*Bundle, string, error) {
+	var bundle *Bundle
+	var password string
+	err := s.tryPasswords(name, func(p string) error {
+		var err error
+		bundle, err = OpenBundleWithPassword(content, p)
+		password = p
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return bundle, password, nil
+}
@@ -0,0 +1,97 @@
+package vaulted_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestBundle(t *testing.T) {
+	bundle := &vaulted.Bundle{
+		Vaults: []*vaulted.BundledVault{
+			{
+				Name: "one",
+				Metadata: &vaulted.VaultMetadata{
+					Description: "The first vault",
+					Tags:        []string{"prod"},
+				},
+				Vault: &vaulted.Vault{
+					Vars: map[string]string{
+						"ONE": "1",
+					},
+				},
+			},
+			{
+				Name: "dir/two",
+				Vault: &vaulted.Vault{
+					Vars: map[string]string{
+						"TWO": "2",
+					},
+				},
+			},
+		},
+	}
+
+	content, err := vaulted.SealBundleWithPassword(bundle, "bundle password")
+	if err != nil {
+		t.Fatalf("failed to seal bundle: %v", err)
+	}
+
+	opened, err := vaulted.OpenBundleWithPassword(content, "bundle password")
+	if err != nil {
+		t.Fatalf("failed to open bundle: %v", err)
+	}
+	if !reflect.DeepEqual(bundle, opened) {
+		t.Fatalf("expected: %#v, got: %#v", bundle, opened)
+	}
+
+	_, err = vaulted.OpenBundleWithPassword(content, "wrong password")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected ErrIncorrectPassword, got: %v", err)
+	}
+
+	bf := vaulted.BundleFile{}
+	json.Unmarshal(content, &bf)
+	bf.Ciphertext[0] ^= 0xff
+	tampered, _ := json.Marshal(bf)
+	_, err = vaulted.OpenBundleWithPassword(tampered, "bundle password")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected a tampered bundle to fail to open, got: %v", err)
+	}
+
+	_, err = vaulted.OpenBundleWithPassword([]byte(`{"name":"not a bundle"}`), "bundle password")
+	if err != vaulted.ErrInvalidBundle {
+		t.Fatalf("expected ErrInvalidBundle, got: %v", err)
+	}
+}
+
+func TestStoreBundle(t *testing.T) {
+	store := vaulted.NewWithBackend(vaulted.NewStaticSteward("bundle password"), vaulted.NewMemoryBackend())
+
+	bundle := &vaulted.Bundle{
+		Vaults: []*vaulted.BundledVault{
+			{
+				Name:  "one",
+				Vault: &vaulted.Vault{},
+			},
+		},
+	}
+
+	content, err := store.SealBundle(bundle, "backup")
+	if err != nil {
+		t.Fatalf("failed to seal bundle: %v", err)
+	}
+
+	opened, password, err := store.OpenBundle(content, "backup")
+	if err != nil {
+		t.Fatalf("failed to open bundle: %v", err)
+	}
+	if password != "bundle password" {
+		t.Fatalf("expected the bundle password to be returned, got: %s", password)
+	}
+	if !reflect.DeepEqual(bundle, opened) {
+		t.Fatalf("expected: %#v, got: %#v", bundle, opened)
+	}
+}
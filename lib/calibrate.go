@@ -0,0 +1,232 @@
+package vaulted
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/miquella/xdg"
+)
+
+// DefaultCalibrationTarget is how long deriving a key should take with
+// calibrated key derivation parameters, if no target is specified.
+const DefaultCalibrationTarget = 500 * time.Millisecond
+
+// CalibrationFloor is the fraction of the calibrated cost below which
+// VerifyVault warns about a vault's key derivation parameters. The margin
+// keeps keys calibrated earlier on the same machine (whose benchmarks vary a
+// little) from being reported.
+const CalibrationFloor = 0.5
+
+// CalibrationPath is the location of the file recording the results of
+// calibrating the key derivation methods on this machine. If blank, the
+// results are recorded in $XDG_CACHE_HOME/vaulted/.calibration (hidden, so it
+// isn't mistaken for a session cache).
+var CalibrationPath string
+
+// Calibration records the key derivation parameters that took the target
+// time to derive a key on this machine.
+type Calibration struct {
+	Target     time.Duration `json:"target"`
+	Details    Details       `json:"details"`
+	Calibrated time.Time     `json:"calibrated"`
+}
+
+// calibrationKey identifies a calibration remembered by a store.
+type calibrationKey struct {
+	method string
+	target time.Duration
+}
+
+func calibrationPath() string {
+	if CalibrationPath != "" {
+		return CalibrationPath
+	}
+	return xdg.CACHE_HOME.Join("vaulted", ".calibration")
+}
+
+// CalibrateKeyDetails benchmarks the key derivation method on this machine,
+// returning parameters that take about target to derive a key. The parameters
+// are never weaker than the method's defaults, so a slow machine (or a short
+// target) may take longer than target.
+func CalibrateKeyDetails(method string, target time.Duration) (Details, error) {
+	if target <= 0 {
+		return nil, fmt.Errorf("Invalid calibration target: %s", target)
+	}
+
+	vk := &VaultKey{Method: method}
+	var err error
+	vk.Details, err = defaultKeyDetails(method)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 32)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	vk.Details.SetBytes("salt", salt)
+
+	switch method {
+	case KeyMethodPBKDF2SHA512:
+		// the cost of PBKDF2 is linear in the number of iterations, so
+		// a short sample (long enough to be measured reliably) is scaled
+		// up to the target
+		iterations := 1 << 12
+		var elapsed time.Duration
+		for {
+			vk.Details.SetInt("iterations", iterations)
+			elapsed, err = timeKeyDerivation(vk)
+			if err != nil {
+				return nil, err
+			}
+			if elapsed >= target/8 || iterations >= 1<<30 {
+				break
+			}
+			iterations *= 2
+		}
+
+		iterations = int(float64(iterations) * float64(target) / float64(elapsed))
+		if iterations < BaseIterations {
+			iterations = BaseIterations
+		}
+		vk.Details.SetInt("iterations", iterations)
+
+	case KeyMethodArgon2id:
+		// the memory and parallelism are kept at the defaults (they
+		// depend on the resources of the machines the vault is opened on,
+		// rather than its speed), and the number of passes is calibrated
+		vk.Details.SetInt("time", 1)
+		elapsed, err := timeKeyDerivation(vk)
+		if err != nil {
+			return nil, err
+		}
+
+		passes := int(float64(target)/float64(elapsed) + 0.5)
+		if passes < Argon2idTime {
+			passes = Argon2idTime
+		}
+		vk.Details.SetInt("time", passes)
+	}
+
+	delete(vk.Details, "salt")
+	return vk.Details, nil
+}
+
+func timeKeyDerivation(vk *VaultKey) (time.Duration, error) {
+	start := time.Now()
+	_, err := vk.key("calibration", nil, 32)
+	elapsed := time.Since(start)
+	if elapsed <= 0 {
+		elapsed = time.Nanosecond
+	}
+	return elapsed, err
+}
+
+// keyCost returns the relative cost of deriving a key with the parameters,
+// for comparing parameters of the same method.
+func keyCost(method string, details Details) float64 {
+	switch method {
+	case KeyMethodPBKDF2SHA512:
+		return float64(details.Int("iterations"))
+	case KeyMethodArgon2id:
+		return float64(details.Int("time")) * float64(details.Int("memory"))
+	}
+	return 0
+}
+
+// LoadCalibrations reads the results of calibrating the key derivation
+// methods on this machine, by method. If nothing has been calibrated, no
+// calibrations (and no error) are returned.
+func LoadCalibrations() (map[string]*Calibration, error) {
+	content, err := ioutil.ReadFile(calibrationPath())
+	if os.IsNotExist(err) {
+		return map[string]*Calibration{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	calibrations := make(map[string]*Calibration)
+	err = json.Unmarshal(content, &calibrations)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid calibration file %s: %v", calibrationPath(), err)
+	}
+	return calibrations, nil
+}
+
+// recordCalibration records the result of calibrating the method, replacing
+// any earlier result for the method. A damaged calibration file is replaced.
+func recordCalibration(method string, calibration *Calibration) error {
+	calibrations, err := LoadCalibrations()
+	if err != nil {
+		calibrations = make(map[string]*Calibration)
+	}
+	calibrations[method] = calibration
+
+	content, err := json.MarshalIndent(calibrations, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	filename := calibrationPath()
+	err = os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(filename, content, 0600)
+}
+
+// calibratedKeyDetails returns the calibrated parameters of the method,
+// calibrating the method the first time they are needed (so sealing several
+// vaults only benchmarks each method once).
+func (s *store) calibratedKeyDetails(method string, target time.Duration) (Details, error) {
+	s.calibrationsMutex.Lock()
+	defer s.calibrationsMutex.Unlock()
+
+	key := calibrationKey{method, target}
+	if details, exists := s.calibrations[key]; exists {
+		return details, nil
+	}
+
+	details, err := CalibrateKeyDetails(method, target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = recordCalibration(method, &Calibration{
+		Target:     target,
+		Details:    details,
+		Calibrated: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.calibrations[key] = details
+	return details, nil
+}
+
+// applyCalibration replaces the key derivation parameters of a newly
+// generated key with calibrated parameters, if options.CalibrationTarget is
+// set. The key's salt (and keyfile requirement) is kept.
+func (s *store) applyCalibration(vk *VaultKey, options *SealOptions) error {
+	if options.CalibrationTarget <= 0 {
+		return nil
+	}
+
+	details, err := s.calibratedKeyDetails(vk.Method, options.CalibrationTarget)
+	if err != nil {
+		return err
+	}
+
+	for name, value := range details {
+		vk.Details[name] = value
+	}
+	return nil
+}
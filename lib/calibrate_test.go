@@ -0,0 +1,121 @@
+package vaulted_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miquella/xdg"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestCalibrateKeyDetails(t *testing.T) {
+	details, err := vaulted.CalibrateKeyDetails(vaulted.KeyMethodPBKDF2SHA512, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to calibrate: %v", err)
+	}
+	if details.Int("iterations") < vaulted.BaseIterations {
+		t.Errorf("expected at least %d iterations, got %d", vaulted.BaseIterations, details.Int("iterations"))
+	}
+	if details.String("salt") != "" {
+		t.Error("expected the calibrated parameters not to include a salt")
+	}
+
+	details, err = vaulted.CalibrateKeyDetails(vaulted.KeyMethodArgon2id, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to calibrate: %v", err)
+	}
+	if details.Int("time") < vaulted.Argon2idTime || details.Int("memory") != vaulted.Argon2idMemory || details.Int("threads") != vaulted.Argon2idThreads {
+		t.Errorf("unexpected argon2id parameters: %#v", details)
+	}
+
+	_, err = vaulted.CalibrateKeyDetails("bogus", 50*time.Millisecond)
+	if err == nil {
+		t.Error("expected an unknown method to fail")
+	}
+	_, err = vaulted.CalibrateKeyDetails(vaulted.KeyMethodPBKDF2SHA512, 0)
+	if err == nil {
+		t.Error("expected a zero target to fail")
+	}
+}
+
+func TestSealVaultCalibrated(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"FOO": "bar"},
+	}
+	options := &vaulted.SealOptions{
+		KeyMethod:         vaulted.KeyMethodArgon2id,
+		CalibrationTarget: 50 * time.Millisecond,
+	}
+	err := store.SealVaultWithOptions(vault, "calibrated", "password", options)
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	calibrations, err := vaulted.LoadCalibrations()
+	if err != nil {
+		t.Fatalf("failed to load calibrations: %v", err)
+	}
+	calibration := calibrations[vaulted.KeyMethodArgon2id]
+	if calibration == nil || calibration.Target != 50*time.Millisecond {
+		t.Fatalf("expected the calibration to be recorded, got %#v", calibrations)
+	}
+
+	vf := readTestVaultFile(t, "calibrated")
+	if vf.Key.Details.Int("time") != calibration.Details.Int("time") || len(vf.Key.Details.Bytes("salt")) == 0 {
+		t.Errorf("expected the calibrated parameters (and a salt), got %#v", vf.Key.Details)
+	}
+
+	_, _, err = store.OpenVault("calibrated")
+	if err != nil {
+		t.Fatalf("failed to open calibrated vault: %v", err)
+	}
+
+	report, err := store.VerifyVault("calibrated", nil)
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if !report.OK() || len(report.Warnings) != 0 {
+		t.Fatalf("expected no problems, got %#v", report)
+	}
+
+	// a vault whose parameters are well below the calibration (e.g. after
+	// moving to a faster machine) is reported
+	calibration.Details.SetInt("time", 4*vf.Key.Details.Int("time"))
+	content, err := json.Marshal(calibrations)
+	if err != nil {
+		t.Fatalf("failed to marshal calibrations: %v", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(string(xdg.CACHE_HOME), "vaulted", ".calibration"), content, 0600)
+	if err != nil {
+		t.Fatalf("failed to write calibrations: %v", err)
+	}
+
+	report, err = store.VerifyVault("calibrated", nil)
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if len(report.Warnings) != 1 || !strings.Contains(report.Warnings[0], "calibrated") {
+		t.Fatalf("expected a calibration warning, got %#v", report)
+	}
+
+	// the calibration isn't a session cache
+	caches, err := store.ListSessionCaches()
+	if err != nil {
+		t.Fatalf("failed to list session caches: %v", err)
+	}
+	for _, name := range caches {
+		if strings.Contains(name, "calibration") {
+			t.Errorf("expected the calibration not to be listed as a session cache, got %v", caches)
+		}
+	}
+}
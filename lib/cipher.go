@@ -0,0 +1,171 @@
+package vaulted
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const (
+	MethodSecretbox         = "secretbox"
+	MethodXChaCha20Poly1305 = "xchacha20-poly1305"
+	MethodAES256GCM         = "aes-256-gcm"
+
+	DefaultMethod = MethodSecretbox
+)
+
+var (
+	// Methods lists the encryption methods that may be selected when sealing
+	// a vault.
+	Methods = []string{
+		MethodSecretbox,
+		MethodXChaCha20Poly1305,
+		MethodAES256GCM,
+	}
+)
+
+// ValidMethod reports whether method is a supported encryption method.
+func ValidMethod(method string) bool {
+	for _, m := range Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// encryptionKeyLength is the length of the key required by each of the
+// supported encryption methods.
+const encryptionKeyLength = 32
+
+// deriveSubkey derives an independent key for a different purpose (described
+// by info) from an encryption key.
+func deriveSubkey(key []byte, info string) ([]byte, error) {
+	subkey := make([]byte, encryptionKeyLength)
+	_, err := io.ReadFull(hkdf.New(sha256.New, key, nil, []byte(info)), subkey)
+	if err != nil {
+		return nil, err
+	}
+	return subkey, nil
+}
+
+// seal encrypts plaintext with the specified method, storing the generated
+// nonce in details.
+//
+// additionalData is authenticated (but not encrypted). secretbox has no
+// native support for associated data, so a digest of it is prepended to the
+// plaintext instead.
+func seal(method string, key, plaintext, additionalData []byte, details Details) ([]byte, error) {
+	switch method {
+	case MethodSecretbox:
+		nonce := [24]byte{}
+		_, err := rand.Read(nonce[:])
+		if err != nil {
+			return nil, err
+		}
+		details.SetBytes("nonce", nonce[:])
+
+		boxKey := [32]byte{}
+		copy(boxKey[:], key)
+
+		if additionalData != nil {
+			digest := sha512.Sum512_256(additionalData)
+			plaintext = append(digest[:], plaintext...)
+		}
+
+		return secretbox.Seal(nil, plaintext, &nonce, &boxKey), nil
+
+	case MethodXChaCha20Poly1305, MethodAES256GCM:
+		aead, err := newAEAD(method, key)
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := make([]byte, aead.NonceSize())
+		_, err = rand.Read(nonce)
+		if err != nil {
+			return nil, err
+		}
+		details.SetBytes("nonce", nonce)
+
+		return aead.Seal(nil, nonce, plaintext, additionalData), nil
+	}
+
+	return nil, fmt.Errorf("Invalid encryption method: %s", method)
+}
+
+// open decrypts ciphertext with the specified method, using the nonce stored
+// in details. ErrIncorrectPassword is returned if the ciphertext cannot be
+// authenticated.
+func open(method string, key, ciphertext, additionalData []byte, details Details) ([]byte, error) {
+	nonce := details.Bytes("nonce")
+
+	switch method {
+	case MethodSecretbox:
+		if len(nonce) == 0 {
+			return nil, ErrInvalidEncryptionConfig
+		}
+		boxNonce := [24]byte{}
+		copy(boxNonce[:], nonce)
+
+		boxKey := [32]byte{}
+		copy(boxKey[:], key)
+
+		plaintext, ok := secretbox.Open(nil, ciphertext, &boxNonce, &boxKey)
+		if !ok {
+			return nil, ErrIncorrectPassword
+		}
+
+		if additionalData != nil {
+			digest := sha512.Sum512_256(additionalData)
+			if len(plaintext) < len(digest) || subtle.ConstantTimeCompare(plaintext[:len(digest)], digest[:]) != 1 {
+				return nil, ErrIncorrectPassword
+			}
+			plaintext = plaintext[len(digest):]
+		}
+		return plaintext, nil
+
+	case MethodXChaCha20Poly1305, MethodAES256GCM:
+		aead, err := newAEAD(method, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(nonce) != aead.NonceSize() {
+			return nil, ErrInvalidEncryptionConfig
+		}
+
+		plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+		if err != nil {
+			return nil, ErrIncorrectPassword
+		}
+		return plaintext, nil
+	}
+
+	return nil, fmt.Errorf("Invalid encryption method: %s", method)
+}
+
+func newAEAD(method string, key []byte) (cipher.AEAD, error) {
+	switch method {
+	case MethodXChaCha20Poly1305:
+		return chacha20poly1305.NewX(key)
+
+	case MethodAES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	}
+
+	return nil, fmt.Errorf("Invalid encryption method: %s", method)
+}
@@ -0,0 +1,137 @@
+package vaulted
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultCommandVarTimeout is how long the command of a command var may run
+// if the var doesn't specify a timeout.
+const DefaultCommandVarTimeout = 10 * time.Second
+
+// CommandVar is a var whose value is the output of a command, run when a
+// session is built. This keeps values that shouldn't be copied into a vault
+// (e.g. short-lived tokens, or secrets kept in another password manager) out
+// of the vault.
+type CommandVar struct {
+	// Command is run with sh -c; its output (with surrounding whitespace
+	// trimmed) is the value of the var.
+	Command string        `json:"command"`
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// Cache caches the value with the session (until the session expires),
+	// rather than running the command every time the session is used.
+	Cache bool `json:"cache,omitempty"`
+}
+
+// CommandVarError is returned when the command of a command var fails.
+type CommandVarError struct {
+	Name    string
+	Command string
+	Err     error
+}
+
+func (e *CommandVarError) Error() string {
+	return fmt.Sprintf("Could not get the value of %s from command '%s': %v", e.Name, e.Command, e.Err)
+}
+
+// EffectiveTimeout returns how long the command may run.
+func (c *CommandVar) EffectiveTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return DefaultCommandVarTimeout
+	}
+	return c.Timeout
+}
+
+// Run runs the command, returning its trimmed output. The command's stderr
+// (and stdin) is connected to vaulted's, so it can prompt the user.
+func (c *CommandVar) Run() (string, error) {
+	timeout := c.EffectiveTimeout()
+
+	// the output is read from a pipe (rather than a buffer that exec copies
+	// into), so waiting for the command doesn't wait for processes it
+	// started that still hold its output open
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	cmd := exec.Command("sh", "-c", c.Command)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = w
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Start()
+	w.Close()
+	if err != nil {
+		return "", err
+	}
+
+	output := make(chan []byte, 1)
+	go func() {
+		content, _ := ioutil.ReadAll(r)
+		output <- content
+	}()
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case err = <-exited:
+	case <-timer.C:
+		cmd.Process.Kill()
+		<-exited
+		return "", fmt.Errorf("timed out after %s", timeout)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// don't wait indefinitely for processes started by the command that
+	// still hold its output open
+	var stdout []byte
+	select {
+	case stdout = <-output:
+	case <-time.After(time.Second):
+		r.Close()
+		stdout = <-output
+	}
+
+	return strings.TrimSpace(string(stdout)), nil
+}
+
+// resolveCommandVars runs the commands of the vars (in a stable order),
+// returning their values. No values are returned if any of the commands fail.
+func resolveCommandVars(vars map[string]*CommandVar) (map[string]string, error) {
+	var names []string
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make(map[string]string)
+	for _, name := range names {
+		value, err := vars[name].Run()
+		if err != nil {
+			return nil, &CommandVarError{
+				Name:    name,
+				Command: vars[name].Command,
+				Err:     err,
+			}
+		}
+		values[name] = value
+	}
+
+	return values, nil
+}
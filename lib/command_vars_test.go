@@ -0,0 +1,155 @@
+package vaulted
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCommandVarRun(t *testing.T) {
+	c := &CommandVar{Command: "echo '  token  '"}
+	value, err := c.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "token" {
+		t.Errorf("Expected the trimmed output, got: %q", value)
+	}
+
+	c = &CommandVar{Command: "sleep 5", Timeout: 100 * time.Millisecond}
+	start := time.Now()
+	_, err = c.Run()
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Expected a timeout, got: %v", err)
+	}
+	if time.Since(start) > 3*time.Second {
+		t.Errorf("Expected the command to be stopped after its timeout, took: %s", time.Since(start))
+	}
+
+	// processes started by the command that hold its output open aren't
+	// waited for
+	c = &CommandVar{Command: "echo token; sleep 5 &"}
+	start = time.Now()
+	value, err = c.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "token" {
+		t.Errorf("Expected the output of the command, got: %q", value)
+	}
+	if time.Since(start) > 3*time.Second {
+		t.Errorf("Expected the command's output to be returned once it exits, took: %s", time.Since(start))
+	}
+}
+
+func TestResolveCommandVars(t *testing.T) {
+	values, err := resolveCommandVars(map[string]*CommandVar{
+		"ONE": {Command: "echo one"},
+		"TWO": {Command: "printf two"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values["ONE"] != "one" || values["TWO"] != "two" {
+		t.Errorf("Unexpected values: %#v", values)
+	}
+
+	values, err = resolveCommandVars(map[string]*CommandVar{
+		"OK":     {Command: "echo ok"},
+		"BROKEN": {Command: "exit 3"},
+	})
+	commandErr, ok := err.(*CommandVarError)
+	if !ok {
+		t.Fatalf("Expected a CommandVarError, got: %v", err)
+	}
+	if commandErr.Name != "BROKEN" || commandErr.Command != "exit 3" {
+		t.Errorf("Expected the error to name the var and command, got: %v", commandErr)
+	}
+	if values != nil {
+		t.Errorf("Expected no values, got: %#v", values)
+	}
+}
+
+func TestSessionCommandVars(t *testing.T) {
+	v := &Vault{
+		Vars: map[string]string{
+			"STATIC":     "static",
+			"OVERRIDDEN": "static",
+			"DERIVED":    "${UNCACHED}-${CACHED}",
+		},
+		CommandVars: map[string]*CommandVar{
+			"CACHED":     {Command: "echo cached", Cache: true},
+			"UNCACHED":   {Command: "echo uncached"},
+			"OVERRIDDEN": {Command: "echo '${STATIC}'"},
+			"LITERAL":    {Command: "echo '${STATIC} $${'", Cache: true},
+		},
+	}
+
+	s, err := v.NewSession("vault")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// only cached values are stored with the session
+	if s.CommandValues["CACHED"] != "cached" {
+		t.Errorf("Expected the cached value to be stored with the session, got: %#v", s.CommandValues)
+	}
+	if _, exists := s.Vars["CACHED"]; exists {
+		t.Errorf("Expected the cached value not to be stored with the vault's vars, got: %#v", s.Vars)
+	}
+	if _, exists := s.CommandValues["UNCACHED"]; exists {
+		t.Errorf("Expected the uncached value not to be stored with the session, got: %#v", s.CommandValues)
+	}
+	if _, exists := s.CommandVars["UNCACHED"]; !exists {
+		t.Errorf("Expected the uncached command var to be kept with the session, got: %#v", s.CommandVars)
+	}
+
+	vars, err := s.Variables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"STATIC":     "static",
+		"CACHED":     "cached",
+		"UNCACHED":   "uncached",
+		"OVERRIDDEN": "${STATIC}",
+		"LITERAL":    "${STATIC} $${",
+		"DERIVED":    "uncached-cached",
+	}
+	for key, value := range expected {
+		if vars.Set[key] != value {
+			t.Errorf("Expected %s to be %q, got %q", key, value, vars.Set[key])
+		}
+	}
+
+	// sessions for assumed roles run the uncached commands as well
+	expiration := time.Now().Add(time.Hour)
+	roleSession := s.roleSession("arn:aws:iam::123456789012:role/role", &AWSCredentials{
+		ID:         "id",
+		Secret:     "secret",
+		Token:      "token",
+		Expiration: &expiration,
+	})
+	roleVars, err := roleSession.Variables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range expected {
+		if roleVars.Set[key] != value {
+			t.Errorf("Expected %s to be %q for the role session, got %q", key, value, roleVars.Set[key])
+		}
+	}
+
+	s.CommandVars["UNCACHED"].Command = "false"
+	_, err = s.Variables()
+	if _, ok := err.(*CommandVarError); !ok {
+		t.Errorf("Expected a CommandVarError, got: %v", err)
+	}
+
+	v.CommandVars["CACHED"].Command = "false"
+	_, err = v.NewSession("vault")
+	if _, ok := err.(*CommandVarError); !ok {
+		t.Errorf("Expected a CommandVarError, got: %v", err)
+	}
+}
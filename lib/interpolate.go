@@ -0,0 +1,142 @@
+package vaulted
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// VariableCycleError is returned when a variable references itself (directly
+// or through other variables).
+type VariableCycleError struct {
+	// Chain lists the variables that make up the cycle, starting and ending
+	// with the same variable.
+	Chain []string
+}
+
+func (e *VariableCycleError) Error() string {
+	return fmt.Sprintf("Variable references itself: %s", strings.Join(e.Chain, " -> "))
+}
+
+// interpolateVars resolves references to other variables (${NAME}) in the
+// values of vars. References to variables that aren't in vars are resolved
+// with lookup, and are blank if lookup doesn't find them either.
+//
+// $${ is an escaped (literal) ${. References that aren't terminated or don't
+// contain a valid variable name are left as is.
+func interpolateVars(vars map[string]string, lookup func(name string) (string, bool)) (map[string]string, error) {
+	r := &varResolver{
+		vars:      vars,
+		lookup:    lookup,
+		resolved:  make(map[string]string),
+		resolving: make(map[string]bool),
+	}
+
+	// resolve in a stable order, so the same cycle is always reported
+	var names []string
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, err := r.resolve(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r.resolved, nil
+}
+
+type varResolver struct {
+	vars   map[string]string
+	lookup func(name string) (string, bool)
+
+	resolved  map[string]string
+	resolving map[string]bool
+	chain     []string
+}
+
+func (r *varResolver) resolve(name string) (string, error) {
+	if value, ok := r.resolved[name]; ok {
+		return value, nil
+	}
+
+	if r.resolving[name] {
+		for i, n := range r.chain {
+			if n == name {
+				cycle := append(append([]string{}, r.chain[i:]...), name)
+				return "", &VariableCycleError{Chain: cycle}
+			}
+		}
+	}
+
+	r.resolving[name] = true
+	r.chain = append(r.chain, name)
+	value, err := r.expand(r.vars[name])
+	r.chain = r.chain[:len(r.chain)-1]
+	delete(r.resolving, name)
+	if err != nil {
+		return "", err
+	}
+
+	r.resolved[name] = value
+	return value, nil
+}
+
+func (r *varResolver) expand(value string) (string, error) {
+	var expanded strings.Builder
+	for i := 0; i < len(value); {
+		if strings.HasPrefix(value[i:], "$${") {
+			expanded.WriteString("${")
+			i += 3
+			continue
+		}
+
+		if strings.HasPrefix(value[i:], "${") {
+			end := strings.IndexByte(value[i+2:], '}')
+			if end >= 0 && validVarName(value[i+2:i+2+end]) {
+				name := value[i+2 : i+2+end]
+
+				var resolved string
+				if _, ok := r.vars[name]; ok {
+					var err error
+					resolved, err = r.resolve(name)
+					if err != nil {
+						return "", err
+					}
+				} else if r.lookup != nil {
+					resolved, _ = r.lookup(name)
+				}
+
+				expanded.WriteString(resolved)
+				i += 2 + end + 1
+				continue
+			}
+		}
+
+		expanded.WriteByte(value[i])
+		i++
+	}
+
+	return expanded.String(), nil
+}
+
+// validVarName reports whether name can be referenced by ${name} (it must be
+// a valid shell variable name).
+func validVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, c := range name {
+		switch {
+		case c == '_', c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
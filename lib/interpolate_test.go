@@ -0,0 +1,116 @@
+package vaulted
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestInterpolateVars(t *testing.T) {
+	vars := map[string]string{
+		"BUCKET":    "logs-${ACCOUNT}-${REGION}",
+		"ACCOUNT":   "${ACCOUNT_ID}",
+		"ESCAPED":   "$${ACCOUNT}",
+		"LITERAL":   "${not valid} ${UNTERMINATED",
+		"MISSING":   "[${NOT_FOUND}]",
+		"NO_REFS":   "$HOME",
+		"FROM_HOST": "${HOST_VAR}",
+	}
+	lookup := func(name string) (string, bool) {
+		switch name {
+		case "ACCOUNT_ID":
+			return "123456789012", true
+		case "REGION":
+			return "us-west-2", true
+		case "HOST_VAR":
+			return "host", true
+		}
+		return "", false
+	}
+
+	expected := map[string]string{
+		"BUCKET":    "logs-123456789012-us-west-2",
+		"ACCOUNT":   "123456789012",
+		"ESCAPED":   "${ACCOUNT}",
+		"LITERAL":   "${not valid} ${UNTERMINATED",
+		"MISSING":   "[]",
+		"NO_REFS":   "$HOME",
+		"FROM_HOST": "host",
+	}
+
+	interpolated, err := interpolateVars(vars, lookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, interpolated) {
+		t.Errorf("Expected: %#v\nGot: %#v", expected, interpolated)
+	}
+}
+
+func TestInterpolateVarsCycle(t *testing.T) {
+	vars := map[string]string{
+		"A": "${B}",
+		"B": "x${C}",
+		"C": "${A}",
+		"D": "${D}",
+	}
+
+	_, err := interpolateVars(vars, nil)
+	cycleErr, ok := err.(*VariableCycleError)
+	if !ok {
+		t.Fatalf("Expected a VariableCycleError, got: %v", err)
+	}
+
+	expected := []string{"A", "B", "C", "A"}
+	if !reflect.DeepEqual(expected, cycleErr.Chain) {
+		t.Errorf("Expected cycle: %v, got: %v", expected, cycleErr.Chain)
+	}
+}
+
+func TestSessionVariablesInterpolation(t *testing.T) {
+	os.Setenv("VAULTED_TEST_PARENT", "parent")
+	defer os.Unsetenv("VAULTED_TEST_PARENT")
+
+	region := "us-east-1"
+	s := Session{
+		Name:       "vault",
+		Expiration: time.Now(),
+		ActiveRole: "arn:aws:iam::123456789012:role/admin",
+
+		AWSCreds: &AWSCredentials{
+			ID:     "an-id",
+			Secret: "the-super-sekrit",
+			Region: &region,
+		},
+		Vars: map[string]string{
+			"BUCKET":            "${VAULTED_ENV}-${VAULTED_ENV_ROLE_ACCOUNT_ID}-${AWS_REGION}",
+			"TOKEN":             "[${AWS_SESSION_TOKEN}]",
+			"PARENT":            "${VAULTED_TEST_PARENT}",
+			"AWS_ACCESS_KEY_ID": "overridden by the session",
+		},
+	}
+
+	vars, err := s.Variables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"BUCKET":            "vault-123456789012-us-east-1",
+		"TOKEN":             "[]",
+		"PARENT":            "parent",
+		"AWS_ACCESS_KEY_ID": "an-id",
+	}
+	for key, value := range expected {
+		if vars.Set[key] != value {
+			t.Errorf("Expected %s to be %q, got %q", key, value, vars.Set[key])
+		}
+	}
+
+	s.Vars["LOOP"] = "${LOOP}"
+	_, err = s.Variables()
+	if _, ok := err.(*VariableCycleError); !ok {
+		t.Errorf("Expected a VariableCycleError, got: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package vaulted
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+var (
+	ErrKeyfileRequired  = errors.New("A keyfile is required to open this vault")
+	ErrIncorrectKeyfile = errors.New("Incorrect keyfile")
+)
+
+// readKeyfile reads the keyfile at path, returning the secret derived from
+// its content. Keyfiles may be of any size (and content), so only a digest of
+// the content is retained.
+func readKeyfile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha512.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// keyfileFingerprint identifies the keyfile a vault key requires. The
+// fingerprint is stored alongside the vault, so it is derived from (but
+// doesn't reveal) the keyfile secret.
+func keyfileFingerprint(secret []byte) string {
+	h := sha256.New()
+	h.Write([]byte("vaulted\x00keyfile-fingerprint\x00"))
+	h.Write(secret)
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// requiresKeyfile reports whether a keyfile is required (in addition to the
+// password) to derive the key.
+func (vk *VaultKey) requiresKeyfile() bool {
+	return vk.Details.String("keyfile") != ""
+}
+
+func (vk *VaultKey) setKeyfile(secret []byte) {
+	vk.Details.SetString("keyfile", keyfileFingerprint(secret))
+}
+
+func (vk *VaultKey) removeKeyfile() {
+	delete(vk.Details, "keyfile")
+}
+
+func (vk *VaultKey) matchesKeyfile(secret []byte) bool {
+	fingerprint := keyfileFingerprint(secret)
+	return subtle.ConstantTimeCompare([]byte(fingerprint), []byte(vk.Details.String("keyfile"))) == 1
+}
+
+// mixKeyfile combines the key derived from the password with the keyfile
+// secret, so that both are needed to produce the vault key.
+func (vk *VaultKey) mixKeyfile(passwordKey, secret []byte, keyLength int) ([]byte, error) {
+	ikm := append(append([]byte{}, passwordKey...), secret...)
+	key := make([]byte, keyLength)
+	_, err := io.ReadFull(hkdf.New(sha256.New, ikm, vk.Details.Bytes("salt"), []byte("vaulted\x00keyfile")), key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// keyfile returns the keyfile secret required by the vault key, requesting
+// the location of the keyfile from the steward if it hasn't been read yet.
+func (s *store) keyfile(name string, vk *VaultKey) ([]byte, error) {
+	s.keyfilesMutex.Lock()
+	secret := s.keyfiles[name]
+	s.keyfilesMutex.Unlock()
+
+	if secret != nil && vk.matchesKeyfile(secret) {
+		return secret, nil
+	}
+
+	steward, ok := s.steward.(StewardKeyfile)
+	if !ok {
+		return nil, ErrKeyfileRequired
+	}
+
+	path, err := steward.GetKeyfile(name)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, ErrKeyfileRequired
+	}
+
+	secret, err = readKeyfile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !vk.matchesKeyfile(secret) {
+		return nil, ErrIncorrectKeyfile
+	}
+
+	s.rememberKeyfile(name, secret)
+	return secret, nil
+}
+
+func (s *store) rememberKeyfile(name string, secret []byte) {
+	s.keyfilesMutex.Lock()
+	defer s.keyfilesMutex.Unlock()
+
+	s.keyfiles[name] = secret
+}
+
+// passwordKey derives the key from the password (and the keyfile, if the
+// vault key requires one).
+func (s *store) passwordKey(vk *VaultKey, name, password string) ([]byte, error) {
+	var secret []byte
+	if vk.requiresKeyfile() {
+		var err error
+		secret, err = s.keyfile(name, vk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vk.key(password, secret, encryptionKeyLength)
+}
+
+// applyKeyfileOptions adds (or removes) the keyfile requirement of a newly
+// generated vault key. Without options, the requirement of the previous key
+// is retained.
+func (s *store) applyKeyfileOptions(vk, previous *VaultKey, name string, options *SealOptions) error {
+	switch {
+	case options.RemoveKeyfile:
+		vk.removeKeyfile()
+
+	case options.Keyfile != "":
+		secret, err := readKeyfile(options.Keyfile)
+		if err != nil {
+			return err
+		}
+		vk.setKeyfile(secret)
+		s.rememberKeyfile(name, secret)
+
+	case previous != nil && previous.requiresKeyfile():
+		vk.Details.SetString("keyfile", previous.Details.String("keyfile"))
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVaultKeyfile(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	keyfileDir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(keyfileDir)
+
+	keyfile := filepath.Join(keyfileDir, "keyfile")
+	err = ioutil.WriteFile(keyfile, []byte("keyfile content"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write keyfile: %v", err)
+	}
+
+	otherKeyfile := filepath.Join(keyfileDir, "other")
+	err = ioutil.WriteFile(otherKeyfile, []byte("other content"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write keyfile: %v", err)
+	}
+
+	keyfileStore := func(keyfile string) vaulted.Store {
+		steward := vaulted.NewStaticSteward("password")
+		steward.Keyfile = keyfile
+		return vaulted.New(steward)
+	}
+
+	// require a keyfile
+	vault, _, err := testStore().OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	err = testStore().SealVaultWithOptions(vault, "aaa", "password", &vaulted.SealOptions{
+		Keyfile: keyfile,
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vf := readTestVaultFile(t, "aaa")
+	if vf.Key.Details.String("keyfile") == "" {
+		t.Fatal("expected the keyfile fingerprint to be recorded")
+	}
+
+	// the keyfile is required
+	_, _, err = testStore().OpenVault("aaa")
+	if err != vaulted.ErrKeyfileRequired {
+		t.Fatalf("expected ErrKeyfileRequired, got %v", err)
+	}
+
+	_, _, err = keyfileStore(otherKeyfile).OpenVault("aaa")
+	if err != vaulted.ErrIncorrectKeyfile {
+		t.Fatalf("expected ErrIncorrectKeyfile, got %v", err)
+	}
+
+	// the password is still required
+	invalidStore := vaulted.New(&vaulted.StaticSteward{Password: "invalid password", Keyfile: keyfile})
+	_, _, err = invalidStore.OpenVault("aaa")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected ErrIncorrectPassword, got %v", err)
+	}
+
+	store := keyfileStore(keyfile)
+	vault, _, err = store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if vault.Vars["TEST"] != "AAA" {
+		t.Fatalf("expected: AAA, got %s", vault.Vars["TEST"])
+	}
+
+	// the session cache is encrypted with the same key
+	s1, err := store.CreateSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	s2, err := store.GetSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to get session: %v", err)
+	}
+	if !s1.Expiration.Equal(s2.Expiration) {
+		t.Fatal("expected cached session to be reused")
+	}
+
+	// the requirement is retained when the vault is sealed again (even when
+	// migrating to a different key derivation method)
+	err = store.SealVaultWithOptions(vault, "aaa", "password", &vaulted.SealOptions{
+		KeyMethod: vaulted.KeyMethodArgon2id,
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, _, err = testStore().OpenVault("aaa")
+	if err != vaulted.ErrKeyfileRequired {
+		t.Fatalf("expected ErrKeyfileRequired, got %v", err)
+	}
+
+	// remove the requirement
+	err = store.SealVaultWithOptions(vault, "aaa", "password", &vaulted.SealOptions{
+		RemoveKeyfile: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vault, _, err = testStore().OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault without keyfile: %v", err)
+	}
+	if vault.Vars["TEST"] != "AAA" {
+		t.Fatalf("expected: AAA, got %s", vault.Vars["TEST"])
+	}
+}
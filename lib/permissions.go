@@ -0,0 +1,213 @@
+package vaulted
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// AllowInsecurePermissions allows vaults and session caches to be opened even
+// if their files (or directories) have insecure permissions.
+var AllowInsecurePermissions = false
+
+// InsecurePermissionsError occurs when opening a vault (or session cache)
+// whose files have insecure permissions (see AllowInsecurePermissions).
+type InsecurePermissionsError struct {
+	Problems []PermissionProblem
+}
+
+func (e *InsecurePermissionsError) Error() string {
+	var problems []string
+	for _, p := range e.Problems {
+		problems = append(problems, p.String())
+	}
+	return "Refusing to use files with insecure permissions: " + strings.Join(problems, ", ")
+}
+
+// PermissionProblem describes a file (or directory) whose ownership or
+// permissions would allow other users to replace vaults or read sessions.
+type PermissionProblem struct {
+	Path    string
+	Problem string
+
+	// fix holds the permission bits that must be removed to fix the problem
+	// (if it can be fixed by changing the permissions).
+	fix os.FileMode
+}
+
+func (p PermissionProblem) String() string {
+	return fmt.Sprintf("%s %s", p.Path, p.Problem)
+}
+
+// Fixable reports whether the problem can be fixed by changing permissions.
+func (p PermissionProblem) Fixable() bool {
+	return p.fix != 0
+}
+
+func (p PermissionProblem) fixPermissions() error {
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return err
+	}
+
+	return os.Chmod(p.Path, info.Mode().Perm()&^p.fix)
+}
+
+// permissionChecker is implemented by backends that keep blobs in files.
+type permissionChecker interface {
+	checkPermissions(kind BlobKind, name string) ([]PermissionProblem, error)
+}
+
+// checkPermissions reports the permission problems of a blob (and the
+// directories it is kept in). Backends that don't keep blobs in files have no
+// permission problems.
+func checkPermissions(backend Backend, kind BlobKind, name string) ([]PermissionProblem, error) {
+	checker, ok := backend.(permissionChecker)
+	if !ok {
+		return nil, nil
+	}
+
+	return checker.checkPermissions(kind, name)
+}
+
+// requireSecurePermissions returns an InsecurePermissionsError if the blob
+// has permission problems (unless AllowInsecurePermissions is set).
+func requireSecurePermissions(backend Backend, kind BlobKind, name string) error {
+	if AllowInsecurePermissions {
+		return nil
+	}
+
+	problems, err := checkPermissions(backend, kind, name)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return &InsecurePermissionsError{Problems: problems}
+	}
+
+	return nil
+}
+
+func isInsecurePermissions(err error) bool {
+	_, ok := err.(*InsecurePermissionsError)
+	return ok
+}
+
+func (b *fileBackend) checkPermissions(kind BlobKind, name string) ([]PermissionProblem, error) {
+	filename, err := b.find(kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// session caches hold unencrypted credentials once a session is spawned,
+	// so they shouldn't be readable by other users either
+	problems := checkPathPermissions(filename, kind == SessionBlob)
+
+	// check each directory from the blob up to (and including) the directory
+	// the blob kind is kept in
+	dir := filepath.Dir(filename)
+	base := filepath.Clean(strings.TrimSuffix(filename, filepath.FromSlash(name)))
+	for {
+		problems = append(problems, checkPathPermissions(dir, false)...)
+		if dir == base || len(dir) <= len(base) {
+			break
+		}
+		dir = filepath.Dir(dir)
+	}
+
+	return problems, nil
+}
+
+func checkPathPermissions(path string, private bool) []PermissionProblem {
+	info, err := os.Stat(path)
+	if err != nil {
+		return []PermissionProblem{{Path: path, Problem: err.Error()}}
+	}
+
+	return checkInfoPermissions(path, info, private)
+}
+
+func checkInfoPermissions(path string, info os.FileInfo, private bool) []PermissionProblem {
+	var problems []PermissionProblem
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		// directories owned by root (e.g. /usr/share) can't be replaced by
+		// other users either
+		owner := int(stat.Uid)
+		if owner != os.Getuid() && !(info.IsDir() && owner == 0) {
+			problems = append(problems, PermissionProblem{Path: path, Problem: "is not owned by the current user"})
+		}
+	}
+
+	mode := info.Mode().Perm()
+	if mode&0022 != 0 {
+		problems = append(problems, PermissionProblem{Path: path, Problem: "is writable by other users", fix: 0022})
+	}
+	if private && mode&0044 != 0 {
+		problems = append(problems, PermissionProblem{Path: path, Problem: "is readable by other users", fix: 0044})
+	}
+
+	return problems
+}
+
+// FixPermissions fixes the permissions of the files (and directories) of every
+// vault and session cache. The problems that were fixed are returned, along
+// with the problems that couldn't be fixed (e.g. files owned by other users).
+func (s *store) FixPermissions() ([]PermissionProblem, []PermissionProblem, error) {
+	type blob struct {
+		kind BlobKind
+		name string
+	}
+	var blobs []blob
+
+	vaults, err := s.ListVaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range vaults {
+		blobs = append(blobs, blob{VaultBlob, name})
+	}
+
+	sessionCaches, err := s.ListSessionCaches()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range sessionCaches {
+		blobs = append(blobs, blob{SessionBlob, name})
+	}
+
+	var fixed, remaining []PermissionProblem
+	seen := make(map[PermissionProblem]bool)
+	for _, b := range blobs {
+		problems, err := checkPermissions(s.backend, b.kind, b.name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, p := range problems {
+			// directories are shared by many blobs
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+
+			if !p.Fixable() {
+				remaining = append(remaining, p)
+				continue
+			}
+
+			err = p.fixPermissions()
+			if err != nil {
+				remaining = append(remaining, PermissionProblem{
+					Path:    p.Path,
+					Problem: fmt.Sprintf("%s (%v)", p.Problem, err),
+				})
+				continue
+			}
+			fixed = append(fixed, p)
+		}
+	}
+
+	return fixed, remaining, nil
+}
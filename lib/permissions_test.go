@@ -0,0 +1,77 @@
+package vaulted_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/xdg"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestInsecurePermissions(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	vault, _, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	_, err = store.CreateSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	vaultDir := filepath.Join(string(xdg.DATA_HOME), "vaulted")
+	sessionFile := filepath.Join(string(xdg.CACHE_HOME), "vaulted", "aaa")
+	err = os.Chmod(vaultDir, 0777)
+	if err != nil {
+		t.Fatalf("failed to change permissions: %v", err)
+	}
+	err = os.Chmod(sessionFile, 0644)
+	if err != nil {
+		t.Fatalf("failed to change permissions: %v", err)
+	}
+
+	_, _, err = testStore().OpenVault("aaa")
+	if perr, ok := err.(*vaulted.InsecurePermissionsError); !ok || len(perr.Problems) != 1 || perr.Problems[0].Path != vaultDir {
+		t.Fatalf("expected an InsecurePermissionsError for %s, got: %v", vaultDir, err)
+	}
+
+	err = store.SealVaultWithPassword(vault, "aaa", "password")
+	if _, ok := err.(*vaulted.InsecurePermissionsError); !ok {
+		t.Fatalf("expected an InsecurePermissionsError, got: %v", err)
+	}
+
+	// the override allows the vault to be opened anyway
+	vaulted.AllowInsecurePermissions = true
+	_, _, err = testStore().OpenVault("aaa")
+	vaulted.AllowInsecurePermissions = false
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	fixed, remaining, err := store.FixPermissions()
+	if err != nil {
+		t.Fatalf("failed to fix permissions: %v", err)
+	}
+	if len(fixed) != 2 || len(remaining) != 0 {
+		t.Fatalf("expected 2 fixed problems, got fixed: %v, remaining: %v", fixed, remaining)
+	}
+
+	info, err := os.Stat(sessionFile)
+	if err != nil {
+		t.Fatalf("failed to stat session file: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("expected the session file mode to be 0600, got %04o", info.Mode().Perm())
+	}
+
+	_, err = store.GetSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to get session: %v", err)
+	}
+}
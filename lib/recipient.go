@@ -0,0 +1,322 @@
+package vaulted
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/miquella/xdg"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+var (
+	ErrInvalidRecipient  = errors.New("Invalid recipient (recipients are base64 encoded X25519 public keys)")
+	ErrInvalidIdentity   = errors.New("Invalid identity file")
+	ErrRecipientNotFound = errors.New("Recipient not found")
+)
+
+// IdentityPath is the location of the user's identity file. If blank, the
+// identity is stored in $XDG_CONFIG_HOME/vaulted/identity.
+var IdentityPath string
+
+const dataKeyLength = 32
+
+// VaultRecipient holds a vault's data key wrapped for an X25519 public key.
+//
+// The data key is wrapped using a key derived (via HKDF-SHA256) from the
+// X25519 shared secret of an ephemeral key pair and the recipient's key.
+type VaultRecipient struct {
+	PublicKey  string `json:"public_key"`
+	Ephemeral  []byte `json:"ephemeral"`
+	WrappedKey []byte `json:"wrapped_key"`
+}
+
+// Identity is an X25519 key pair used to open vaults it is a recipient of.
+type Identity struct {
+	privateKey []byte
+	publicKey  []byte
+}
+
+// PublicKey returns the identity's public key, in the form used to add it as
+// a recipient of a vault.
+func (id *Identity) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(id.publicKey)
+}
+
+func identityPath() string {
+	if IdentityPath != "" {
+		return IdentityPath
+	}
+	return xdg.CONFIG_HOME.Join(filepath.Join("vaulted", "identity"))
+}
+
+// LoadIdentity reads the user's identity file. If it doesn't exist,
+// os.ErrNotExist is returned.
+func LoadIdentity() (*Identity, error) {
+	f, err := os.Open(identityPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		privateKey, err := base64.StdEncoding.DecodeString(line)
+		if err != nil || len(privateKey) != curve25519.ScalarSize {
+			return nil, ErrInvalidIdentity
+		}
+
+		return newIdentity(privateKey)
+	}
+	if scanner.Err() != nil {
+		return nil, scanner.Err()
+	}
+
+	return nil, ErrInvalidIdentity
+}
+
+// GenerateIdentity creates a new identity and writes it to the user's
+// identity file. An existing identity file is never replaced.
+func GenerateIdentity() (*Identity, error) {
+	privateKey := make([]byte, curve25519.ScalarSize)
+	_, err := rand.Read(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newIdentity(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := identityPath()
+	err = os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(f, "# vaulted identity\n# public key: %s\n%s\n", id.PublicKey(), base64.StdEncoding.EncodeToString(privateKey))
+	if err != nil {
+		f.Close()
+		os.Remove(filename)
+		return nil, err
+	}
+
+	return id, f.Close()
+}
+
+func newIdentity(privateKey []byte) (*Identity, error) {
+	publicKey, err := curve25519.X25519(privateKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		privateKey: privateKey,
+		publicKey:  publicKey,
+	}, nil
+}
+
+// ParseRecipient validates a recipient public key, returning it in its
+// canonical form.
+func ParseRecipient(recipient string) (string, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(recipient))
+	if err != nil || len(publicKey) != curve25519.PointSize {
+		return "", ErrInvalidRecipient
+	}
+
+	return base64.StdEncoding.EncodeToString(publicKey), nil
+}
+
+func newDataKey() ([]byte, error) {
+	dataKey := make([]byte, dataKeyLength)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return dataKey, nil
+}
+
+// wrapForRecipient wraps a data key for the recipient's public key.
+func wrapForRecipient(recipient string, dataKey, additionalData []byte) (*VaultRecipient, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(recipient)
+	if err != nil || len(publicKey) != curve25519.PointSize {
+		return nil, ErrInvalidRecipient
+	}
+
+	ephemeralKey := make([]byte, curve25519.ScalarSize)
+	_, err = rand.Read(ephemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeral, err := curve25519.X25519(ephemeralKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedSecret, err := curve25519.X25519(ephemeralKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappingKey, err := recipientWrappingKey(sharedSecret, ephemeral, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := wrapKey(wrappingKey, dataKey, additionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VaultRecipient{
+		PublicKey:  recipient,
+		Ephemeral:  ephemeral,
+		WrappedKey: wrappedKey,
+	}, nil
+}
+
+// unwrap unwraps a data key that was wrapped for the identity.
+func (id *Identity) unwrap(recipient *VaultRecipient, additionalData []byte) ([]byte, error) {
+	sharedSecret, err := curve25519.X25519(id.privateKey, recipient.Ephemeral)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappingKey, err := recipientWrappingKey(sharedSecret, recipient.Ephemeral, id.publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return unwrapKey(wrappingKey, recipient.WrappedKey, additionalData)
+}
+
+func recipientWrappingKey(sharedSecret, ephemeral, publicKey []byte) ([]byte, error) {
+	salt := append(append([]byte{}, ephemeral...), publicKey...)
+	wrappingKey := make([]byte, chacha20poly1305.KeySize)
+	_, err := io.ReadFull(hkdf.New(sha256.New, sharedSecret, salt, []byte("vaulted x25519")), wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+	return wrappingKey, nil
+}
+
+// wrapKey encrypts a data key with a wrapping key. The (random) nonce is
+// prepended to the wrapped key.
+func wrapKey(wrappingKey, dataKey, additionalData []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, dataKey, additionalData), nil
+}
+
+func unwrapKey(wrappingKey, wrappedKey, additionalData []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, ErrIncorrectPassword
+	}
+
+	dataKey, err := aead.Open(nil, wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, ErrIncorrectPassword
+	}
+
+	return dataKey, nil
+}
+
+// hasRecipient reports whether the public key is a recipient of the vault.
+func (vf *VaultFile) hasRecipient(publicKey string) bool {
+	for _, r := range vf.Recipients {
+		if r.PublicKey == publicKey {
+			return true
+		}
+	}
+	return false
+}
+
+// usesDataKey reports whether the vault's content is encrypted with a random
+// data key (wrapped for the password and each recipient), rather than
+// directly with the key derived from the password.
+func (vf *VaultFile) usesDataKey() bool {
+	return len(vf.WrappedKey) > 0
+}
+
+// keyAdditionalData returns the data authenticated alongside the vault's
+// wrapped data keys.
+func (vf *VaultFile) keyAdditionalData() []byte {
+	return []byte(fmt.Sprintf("vaulted\x00data-key\x00v%d\x00%s", vf.Version, vf.Name))
+}
+
+// unwrapWithIdentity unwraps the vault's data key using the identity.
+func (vf *VaultFile) unwrapWithIdentity(id *Identity) ([]byte, error) {
+	for _, r := range vf.Recipients {
+		if r.PublicKey == id.PublicKey() {
+			return id.unwrap(r, vf.keyAdditionalData())
+		}
+	}
+	return nil, ErrRecipientNotFound
+}
+
+// wrapDataKey wraps the data key for the password (using the key derived from
+// it) and for each of the recipients.
+func (vf *VaultFile) wrapDataKey(passwordKey, dataKey []byte, recipients []string) error {
+	var err error
+	vf.WrappedKey, err = wrapKey(passwordKey, dataKey, vf.keyAdditionalData())
+	if err != nil {
+		return err
+	}
+
+	vf.Recipients = nil
+	for _, recipient := range recipients {
+		r, err := wrapForRecipient(recipient, dataKey, vf.keyAdditionalData())
+		if err != nil {
+			return err
+		}
+		vf.Recipients = append(vf.Recipients, r)
+	}
+
+	return nil
+}
+
+func (vf *VaultFile) recipients() []string {
+	var recipients []string
+	for _, r := range vf.Recipients {
+		recipients = append(recipients, r.PublicKey)
+	}
+	return recipients
+}
@@ -0,0 +1,206 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVaultRecipients(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	identityDir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(identityDir)
+	defer func(path string) {
+		vaulted.IdentityPath = path
+	}(vaulted.IdentityPath)
+
+	store := testStore()
+	invalidStore := testStoreWithPassword("invalid password")
+
+	// identities are generated once
+	vaulted.IdentityPath = filepath.Join(identityDir, "bob")
+	bob, err := store.Identity()
+	if err != nil {
+		t.Fatalf("failed to generate identity: %v", err)
+	}
+
+	vaulted.IdentityPath = filepath.Join(identityDir, "alice")
+	alice, err := store.Identity()
+	if err != nil {
+		t.Fatalf("failed to generate identity: %v", err)
+	}
+
+	again, err := store.Identity()
+	if err != nil {
+		t.Fatalf("failed to load identity: %v", err)
+	}
+	if again != alice {
+		t.Fatalf("expected identity %s to be reused, got %s", alice, again)
+	}
+
+	// adding a recipient to a password vault requires the password
+	err = invalidStore.AddRecipients("aaa", []string{alice})
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected ErrIncorrectPassword, got %v", err)
+	}
+
+	err = store.AddRecipients("aaa", []string{alice})
+	if err != nil {
+		t.Fatalf("failed to add recipient: %v", err)
+	}
+
+	recipients, err := store.ListRecipients("aaa")
+	if err != nil {
+		t.Fatalf("failed to list recipients: %v", err)
+	}
+	if !reflect.DeepEqual([]string{alice}, recipients) {
+		t.Fatalf("expected recipients %#v, got %#v", []string{alice}, recipients)
+	}
+
+	// the identity opens the vault (without the password)
+	vault, password, err := invalidStore.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault with identity: %v", err)
+	}
+	if password != "" {
+		t.Fatalf("expected no password, got %s", password)
+	}
+	if vault.Vars["TEST"] != "AAA" {
+		t.Fatalf("expected: AAA, got %s", vault.Vars["TEST"])
+	}
+
+	// and the vault can be sealed again without the password
+	vault.Vars["TEST"] = "ALICE"
+	err = invalidStore.SealVaultWithPassword(vault, "aaa", password)
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vault, _, err = testStore().OpenVaultWithPassword("aaa", "password")
+	if err != nil {
+		t.Fatalf("failed to open vault with password: %v", err)
+	}
+	if vault.Vars["TEST"] != "ALICE" {
+		t.Fatalf("expected: ALICE, got %s", vault.Vars["TEST"])
+	}
+
+	// recipients can be added without the password
+	err = invalidStore.AddRecipients("aaa", []string{bob})
+	if err != nil {
+		t.Fatalf("failed to add recipient: %v", err)
+	}
+
+	// removing recipients requires the password
+	err = invalidStore.RemoveRecipients("aaa", []string{alice})
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected ErrIncorrectPassword, got %v", err)
+	}
+
+	err = store.RemoveRecipients("aaa", []string{alice})
+	if err != nil {
+		t.Fatalf("failed to remove recipient: %v", err)
+	}
+
+	_, _, err = testStoreWithPassword("invalid password").OpenVault("aaa")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected removed recipient to be unable to open the vault, got %v", err)
+	}
+
+	vaulted.IdentityPath = filepath.Join(identityDir, "bob")
+	vault, _, err = testStoreWithPassword("invalid password").OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault with identity: %v", err)
+	}
+	if vault.Vars["TEST"] != "ALICE" {
+		t.Fatalf("expected: ALICE, got %s", vault.Vars["TEST"])
+	}
+
+	// changing the password keeps the recipients
+	err = store.SealVaultWithPassword(vault, "aaa", "another password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, _, err = testStoreWithPassword("another password").OpenVaultWithPassword("aaa", "another password")
+	if err != nil {
+		t.Fatalf("failed to open vault with new password: %v", err)
+	}
+
+	_, _, err = testStoreWithPassword("invalid password").OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault with identity: %v", err)
+	}
+
+	// invalid recipients
+	err = store.AddRecipients("aaa", []string{"bogus"})
+	if err != vaulted.ErrInvalidRecipient {
+		t.Fatalf("expected ErrInvalidRecipient, got %v", err)
+	}
+
+	err = store.RemoveRecipients("aaa", []string{alice})
+	if err != vaulted.ErrRecipientNotFound {
+		t.Fatalf("expected ErrRecipientNotFound, got %v", err)
+	}
+}
+
+func TestVaultRecipientsSession(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	identityDir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(identityDir)
+	defer func(path string) {
+		vaulted.IdentityPath = path
+	}(vaulted.IdentityPath)
+	vaulted.IdentityPath = filepath.Join(identityDir, "identity")
+
+	store := testStore()
+
+	identity, err := store.Identity()
+	if err != nil {
+		t.Fatalf("failed to generate identity: %v", err)
+	}
+
+	err = store.AddRecipients("aaa", []string{identity})
+	if err != nil {
+		t.Fatalf("failed to add recipient: %v", err)
+	}
+
+	// sessions of vaults opened with an identity are cached
+	identityStore := testStoreWithPassword("invalid password")
+	vault, _, err := identityStore.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault with identity: %v", err)
+	}
+
+	s1, err := identityStore.CreateSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	// and reused when the vault is opened with the password
+	_, _, err = store.OpenVaultWithPassword("aaa", "password")
+	if err != nil {
+		t.Fatalf("failed to open vault with password: %v", err)
+	}
+
+	s2, err := store.GetSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to get session: %v", err)
+	}
+	if !s1.Expiration.Equal(s2.Expiration) {
+		t.Fatal("expected cached session to be reused")
+	}
+}
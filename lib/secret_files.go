@@ -0,0 +1,132 @@
+package vaulted
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultSecretFileMode is the mode of secret files that don't specify one.
+const DefaultSecretFileMode os.FileMode = 0600
+
+// SecretFile is a secret that is written to a file for the lifetime of a
+// spawned session (see Session.Spawn), for tools that read secrets from a
+// file rather than a variable.
+type SecretFile struct {
+	Content string      `json:"content"`
+	Mode    os.FileMode `json:"mode,omitempty"`
+
+	// EnvVar is the name of the variable that is set to the path of the file
+	// (if any).
+	EnvVar string `json:"env_var,omitempty"`
+}
+
+// FileMode returns the permissions the file is written with.
+func (f *SecretFile) FileMode() os.FileMode {
+	if f.Mode == 0 {
+		return DefaultSecretFileMode
+	}
+	return f.Mode.Perm()
+}
+
+// ValidSecretFileName reports whether name can be used as the name of a
+// secret file (it must be a plain file name, without any directories).
+func ValidSecretFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, "/\\\x00")
+}
+
+// secretFilesBaseDir returns the directory the secret files of a session are
+// written in. $XDG_RUNTIME_DIR is preferred, as it is usually a tmpfs that
+// only the user can access.
+func secretFilesBaseDir() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+	}
+	return os.TempDir()
+}
+
+// writeSecretFiles writes the files to a new private directory, returning the
+// directory and the variables that point at the files. The directory must be
+// removed with removeSecretFiles.
+func writeSecretFiles(files map[string]*SecretFile) (string, map[string]string, error) {
+	dir, err := ioutil.TempDir(secretFilesBaseDir(), "vaulted-")
+	if err != nil {
+		return "", nil, fmt.Errorf("Failed to create the secret files directory: %v", err)
+	}
+
+	// the directory is created with 0700, but make sure of it
+	err = os.Chmod(dir, 0700)
+	if err != nil {
+		_ = removeSecretFiles(dir)
+		return "", nil, err
+	}
+
+	vars := make(map[string]string)
+	for name, file := range files {
+		if !ValidSecretFileName(name) {
+			_ = removeSecretFiles(dir)
+			return "", nil, fmt.Errorf("Invalid secret file name: %s", name)
+		}
+
+		filename := filepath.Join(dir, name)
+		err = ioutil.WriteFile(filename, []byte(file.Content), file.FileMode())
+		if err == nil {
+			// the umask may have masked the mode
+			err = os.Chmod(filename, file.FileMode())
+		}
+		if err != nil {
+			_ = removeSecretFiles(dir)
+			return "", nil, fmt.Errorf("Failed to write secret file %s: %v", name, err)
+		}
+
+		if file.EnvVar != "" {
+			vars[file.EnvVar] = filename
+		}
+	}
+
+	return dir, vars, nil
+}
+
+// removeSecretFiles overwrites the content of the files in the directory (as
+// the spawned process may have added files too) before removing it.
+func removeSecretFiles(dir string) error {
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+		overwriteFile(path, info.Size())
+		return nil
+	})
+
+	return os.RemoveAll(dir)
+}
+
+func overwriteFile(filename string, size int64) {
+	// the file may be read-only, but the directory is still ours
+	_ = os.Chmod(filename, 0600)
+
+	f, err := os.OpenFile(filename, os.O_WRONLY, 0)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	zeros := bytes.Repeat([]byte{0}, 4096)
+	for written := int64(0); written < size; {
+		n := int64(len(zeros))
+		if size-written < n {
+			n = size - written
+		}
+		_, err = f.Write(zeros[:n])
+		if err != nil {
+			return
+		}
+		written += n
+	}
+	_ = f.Sync()
+}
@@ -0,0 +1,151 @@
+package vaulted
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteSecretFiles(t *testing.T) {
+	runtimeDir, err := ioutil.TempDir("", "vaulted-runtime-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(runtimeDir)
+
+	os.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+	defer os.Unsetenv("XDG_RUNTIME_DIR")
+
+	files := map[string]*SecretFile{
+		"kubeconfig": {
+			Content: "apiVersion: v1",
+			EnvVar:  "KUBECONFIG",
+		},
+		"client.pem": {
+			Content: "-----BEGIN CERTIFICATE-----",
+			Mode:    0400,
+		},
+	}
+
+	dir, vars, err := writeSecretFiles(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filepath.Dir(dir) != runtimeDir {
+		t.Errorf("Expected the files to be written in %s, got: %s", runtimeDir, dir)
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0700 {
+		t.Errorf("Expected the directory to have mode 0700, got: %o", info.Mode().Perm())
+	}
+
+	for name, file := range files {
+		filename := filepath.Join(dir, name)
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != file.Content {
+			t.Errorf("Expected %s to contain %q, got: %q", name, file.Content, content)
+		}
+
+		info, err := os.Stat(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != file.FileMode() {
+			t.Errorf("Expected %s to have mode %o, got: %o", name, file.FileMode(), info.Mode().Perm())
+		}
+	}
+
+	if len(vars) != 1 || vars["KUBECONFIG"] != filepath.Join(dir, "kubeconfig") {
+		t.Errorf("Unexpected variables: %#v", vars)
+	}
+
+	err = removeSecretFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("Expected the directory to be removed, got: %v", err)
+	}
+
+	_, _, err = writeSecretFiles(map[string]*SecretFile{
+		"../escape": {Content: "nope"},
+	})
+	if err == nil {
+		t.Error("Expected an error for an invalid file name")
+	}
+	entries, _ := ioutil.ReadDir(runtimeDir)
+	if len(entries) != 0 {
+		t.Errorf("Expected the directory to be removed after a failure, found: %d entries", len(entries))
+	}
+}
+
+func TestSpawnSecretFiles(t *testing.T) {
+	s := &Session{
+		Name:       "vault",
+		Expiration: time.Now().Add(time.Hour),
+		SSHOptions: &SSHOptions{DisableProxy: true},
+		Files: map[string]*SecretFile{
+			"secret.json": {
+				Content: `{"secret": true}`,
+				EnvVar:  "SECRET_FILE",
+			},
+		},
+		Vars: make(map[string]string),
+	}
+	spawnSecretFiles(t, s)
+
+	// sessions for assumed roles write the files as well
+	expiration := time.Now().Add(time.Hour)
+	roleSession := s.roleSession("arn:aws:iam::123456789012:role/role", &AWSCredentials{
+		ID:         "id",
+		Secret:     "secret",
+		Token:      "token",
+		Expiration: &expiration,
+	})
+	spawnSecretFiles(t, roleSession)
+}
+
+func spawnSecretFiles(t *testing.T, s *Session) {
+	outDir, err := ioutil.TempDir("", "vaulted-spawn-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	s.Vars["OUT"] = outDir
+
+	status, err := s.Spawn([]string{"sh", "-c", `cp "$SECRET_FILE" "$OUT/copy" && echo "$SECRET_FILE" > "$OUT/path"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *status != 0 {
+		t.Fatalf("Expected the command to succeed, got status: %d", *status)
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(outDir, "copy"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != s.Files["secret.json"].Content {
+		t.Errorf("Expected the file to contain %q, got: %q", s.Files["secret.json"].Content, content)
+	}
+
+	path, err := ioutil.ReadFile(filepath.Join(outDir, "path"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(strings.TrimSpace(string(path))); !os.IsNotExist(err) {
+		t.Errorf("Expected the file to be removed after the command exits, got: %v", err)
+	}
+}
@@ -32,6 +32,13 @@ type Session struct {
 	Vars            map[string]string `json:"vars,omitempty"`
 	SSHKeys         map[string]string `json:"ssh_keys,omitempty"`
 	SSHOptions      *SSHOptions       `json:"ssh_options,omitempty"`
+
+	// CommandVars holds the command vars that aren't cached with the session
+	// (see Variables), while CommandValues holds the output of the cached
+	// ones.
+	CommandVars   map[string]*CommandVar `json:"command_vars,omitempty"`
+	CommandValues map[string]string      `json:"command_values,omitempty"`
+	Files         map[string]*SecretFile `json:"files,omitempty"`
 }
 
 func (s *Session) Clone() *Session {
@@ -55,6 +62,29 @@ func (s *Session) Clone() *Session {
 		}
 	}
 
+	if s.CommandVars != nil {
+		session.CommandVars = make(map[string]*CommandVar)
+		for key, commandVar := range s.CommandVars {
+			c := *commandVar
+			session.CommandVars[key] = &c
+		}
+	}
+
+	if s.CommandValues != nil {
+		session.CommandValues = make(map[string]string)
+		for key, value := range s.CommandValues {
+			session.CommandValues[key] = value
+		}
+	}
+
+	if s.Files != nil {
+		session.Files = make(map[string]*SecretFile)
+		for name, file := range s.Files {
+			f := *file
+			session.Files[name] = &f
+		}
+	}
+
 	if s.SSHOptions != nil {
 		sshOptions := *s.SSHOptions
 		session.SSHOptions = &sshOptions
@@ -86,7 +116,7 @@ func (s *Session) AssumeSessionRole() (*Session, error) {
 
 func (s *Session) AssumeRole(roleArn string) (*Session, error) {
 	expiration := s.Expiration
-	maxExpiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	maxExpiration := time.Now().Add(MaxRoleSessionDuration).Truncate(time.Second)
 	if expiration.After(maxExpiration) {
 		expiration = maxExpiration
 	}
@@ -124,11 +154,17 @@ func (s *Session) AssumeRole(roleArn string) (*Session, error) {
 		}
 	}
 
+	return s.roleSession(selectedRoleArn, creds), nil
+}
+
+// roleSession returns a session for the assumed role, with the role's
+// credentials and the rest of the session's content.
+func (s *Session) roleSession(roleArn string, creds *AWSCredentials) *Session {
 	session := &Session{
 		Name:       s.Name,
 		Expiration: *creds.Expiration,
 
-		ActiveRole: selectedRoleArn,
+		ActiveRole: roleArn,
 
 		AWSCreds:   creds,
 		Vars:       make(map[string]string),
@@ -141,11 +177,31 @@ func (s *Session) AssumeRole(roleArn string) (*Session, error) {
 	for key, value := range s.SSHKeys {
 		session.SSHKeys[key] = value
 	}
+	if s.CommandVars != nil {
+		session.CommandVars = make(map[string]*CommandVar)
+		for key, commandVar := range s.CommandVars {
+			c := *commandVar
+			session.CommandVars[key] = &c
+		}
+	}
+	if s.CommandValues != nil {
+		session.CommandValues = make(map[string]string)
+		for key, value := range s.CommandValues {
+			session.CommandValues[key] = value
+		}
+	}
+	if s.Files != nil {
+		session.Files = make(map[string]*SecretFile)
+		for name, file := range s.Files {
+			f := *file
+			session.Files[name] = &f
+		}
+	}
 	if s.SSHOptions != nil {
 		session.SSHOptions = s.SSHOptions
 	}
 
-	return session, nil
+	return session
 }
 
 func (s *Session) Spawn(cmd []string) (*int, error) {
@@ -159,7 +215,46 @@ func (s *Session) Spawn(cmd []string) (*int, error) {
 		return nil, fmt.Errorf("Cannot find executable %s: %v", cmd[0], err)
 	}
 
+	// resolve the variables first (so any problems are reported before the
+	// agent is started)
+	variables, err := s.Variables()
+	if err != nil {
+		return nil, err
+	}
+
+	// trap signals (before any secret files are written, so they are removed
+	// even if a signal is received before the process is started)
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(
+		sigs,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGQUIT,
+		syscall.SIGTERM,
+		syscall.SIGUSR1,
+		syscall.SIGUSR2,
+		syscall.SIGWINCH,
+	)
+	defer func() {
+		signal.Stop(sigs)
+		close(sigs)
+	}()
+
 	vars := make(map[string]string)
+	if len(s.Files) > 0 {
+		dir, fileVars, err := writeSecretFiles(s.Files)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = removeSecretFiles(dir)
+		}()
+
+		for key, value := range fileVars {
+			vars[key] = value
+		}
+	}
+
 	sshAgent, err := proxyagent.SetupAgent(proxyagent.AgentConfig{
 		DisableProxy:    s.SSHOptions.DisableProxy,
 		ExposeUnsigned:  true,
@@ -188,22 +283,9 @@ func (s *Session) Spawn(cmd []string) (*int, error) {
 		vars[v] = k
 	}
 
-	// trap signals
-	sigs := make(chan os.Signal)
-	signal.Notify(
-		sigs,
-		syscall.SIGHUP,
-		syscall.SIGINT,
-		syscall.SIGQUIT,
-		syscall.SIGTERM,
-		syscall.SIGUSR1,
-		syscall.SIGUSR2,
-		syscall.SIGWINCH,
-	)
-
 	// start the process
 	var attr os.ProcAttr
-	attr.Env = s.buildEnviron(vars)
+	attr.Env = buildEnviron(variables, vars)
 	attr.Files = []*os.File{os.Stdin, os.Stdout, os.Stderr}
 
 	proc, err := os.StartProcess(cmdpath, cmd, &attr)
@@ -218,11 +300,6 @@ func (s *Session) Spawn(cmd []string) (*int, error) {
 		}
 	}()
 
-	defer func() {
-		signal.Stop(sigs)
-		close(sigs)
-	}()
-
 	// wait for the process to exit
 	state, _ := proc.Wait()
 
@@ -276,13 +353,22 @@ func (s *Session) loadKeyIntoAgent(sshAgent agent.Agent, key string, comment str
 	return sshAgent.Add(addedKey)
 }
 
-func (s *Session) Variables() *Variables {
-	vars := Variables{
-		Set: make(map[string]string),
+// Variables returns the variables set (and unset) by the session. The
+// commands of the session's command vars are run first, so their values are
+// current. References to other variables in the vault's vars (see
+// interpolateVars) are then resolved using the vault's vars, the variables set
+// by the session, and the parent environment (in that order).
+func (s *Session) Variables() (*Variables, error) {
+	commandValues, err := resolveCommandVars(s.CommandVars)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range s.CommandValues {
+		commandValues[key] = value
 	}
 
-	for key, value := range s.Vars {
-		vars.Set[key] = value
+	vars := Variables{
+		Set: make(map[string]string),
 	}
 
 	vars.Set["VAULTED_ENV"] = s.Name
@@ -322,21 +408,55 @@ func (s *Session) Variables() *Variables {
 		}
 	}
 
-	return &vars
+	// the variables set by the session take precedence over the vault's vars
+	vaultVars := make(map[string]string)
+	for key, value := range s.Vars {
+		if _, exists := vars.Set[key]; !exists {
+			vaultVars[key] = value
+		}
+	}
+
+	// the output of commands is used as is (it isn't interpolated)
+	for key, value := range commandValues {
+		if _, exists := vars.Set[key]; !exists {
+			vars.Set[key] = value
+			delete(vaultVars, key)
+		}
+	}
+
+	interpolated, err := interpolateVars(vaultVars, func(name string) (string, bool) {
+		if value, exists := vars.Set[name]; exists {
+			return value, true
+		}
+		for _, unset := range vars.Unset {
+			if unset == name {
+				return "", true
+			}
+		}
+		return os.LookupEnv(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range interpolated {
+		vars.Set[key] = value
+	}
+
+	return &vars, nil
 }
 
-func (s *Session) buildEnviron(extraVars map[string]string) []string {
+func buildEnviron(variables *Variables, extraVars map[string]string) []string {
 	vars := make(map[string]string)
 	for _, v := range os.Environ() {
 		parts := strings.SplitN(v, "=", 2)
 		vars[parts[0]] = parts[1]
 	}
 
-	v := s.Variables()
-	for _, key := range v.Unset {
+	for _, key := range variables.Unset {
 		delete(vars, key)
 	}
-	for key, value := range v.Set {
+	for key, value := range variables.Set {
 		vars[key] = value
 	}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 )
 
 var (
@@ -13,9 +14,13 @@ var (
 	//
 	// Any cache loaded that does not match this version is ignored. This
 	// causes all caches written for previous versions to be invalidated.
-	SessionCacheVersion = "3"
+	SessionCacheVersion = "4"
 )
 
+// SessionTolerance is how long before a cached session expires that it stops
+// being reused (and a new session is created instead).
+var SessionTolerance = 15 * time.Minute
+
 var (
 	// ErrVaultSessionNotFound occurs when attempting to locate a vault session
 	// in a SessionCache that isn't present.
@@ -23,12 +28,14 @@ var (
 )
 
 // SessionCache stores sessions keyed based on the contents of the vault that
-// spawned the session.
+// spawned the session. Sessions for roles assumed with a vault's session are
+// additionally keyed by the role.
 //
 // See VaultSessionCacheKey for details on how the key is generated.
 type SessionCache struct {
 	SessionCacheVersion string              `json:"version"`
 	Sessions            map[string]*Session `json:"sessions"`
+	RoleSessions        map[string]*Session `json:"role_sessions,omitempty"`
 }
 
 // GetVaultSession retrieves a copy of a session in the cache.
@@ -53,6 +60,100 @@ func (sc *SessionCache) PutVaultSession(vault *Vault, session *Session) {
 
 	sessionKey := VaultSessionCacheKey(vault)
 	sc.Sessions[sessionKey] = session.Clone()
+
+	// roles assumed with the previous session are assumed again
+	for key := range sc.RoleSessions {
+		if strings.HasPrefix(key, sessionKey+"\n") {
+			delete(sc.RoleSessions, key)
+		}
+	}
+}
+
+// GetRoleSession retrieves a copy of a session for a role assumed with
+// the session of the provided vault.
+//
+// The retrieved session is keyed using the contents of the provided vault, the
+// role of the session the role was assumed with, and the assumed role.
+func (sc *SessionCache) GetRoleSession(vault *Vault, source *Session, role string) (*Session, error) {
+	if session, exists := sc.RoleSessions[roleSessionCacheKey(vault, source, role)]; exists {
+		return session.Clone(), nil
+	}
+
+	return nil, ErrVaultSessionNotFound
+}
+
+// PutRoleSession stores a copy of a session for a role assumed with the
+// session of the provided vault.
+func (sc *SessionCache) PutRoleSession(vault *Vault, source *Session, role string, session *Session) {
+	if sc.RoleSessions == nil {
+		sc.RoleSessions = make(map[string]*Session)
+	}
+
+	sc.RoleSessions[roleSessionCacheKey(vault, source, role)] = session.Clone()
+}
+
+// CachedSession describes a session in a session cache.
+type CachedSession struct {
+	// Role is the role that is active in the session (blank if the session
+	// uses the vault's own credentials).
+	Role       string
+	Expiration time.Time
+
+	// Current indicates the session was created from the current contents of
+	// the vault. Sessions created before the vault was changed aren't reused.
+	Current bool
+
+	// Reusable indicates the session would be reused, rather than a new
+	// session being created.
+	Reusable bool
+}
+
+// ListSessions describes the sessions in the cache, as they relate to the
+// provided vault. The vault's own sessions are listed before sessions for
+// assumed roles.
+func (sc *SessionCache) ListSessions(vault *Vault, tolerance time.Duration) []CachedSession {
+	sessionKey := VaultSessionCacheKey(vault)
+
+	var sessions, roleSessions []CachedSession
+	vaultSessionReusable := false
+	for key, session := range sc.Sessions {
+		current := key == sessionKey
+		reusable := current && !session.Expired(tolerance)
+		if reusable {
+			vaultSessionReusable = true
+		}
+
+		sessions = append(sessions, CachedSession{
+			Role:       session.ActiveRole,
+			Expiration: session.Expiration,
+			Current:    current,
+			Reusable:   reusable,
+		})
+	}
+
+	// role sessions are only reused along with the vault's session
+	for key, session := range sc.RoleSessions {
+		current := strings.HasPrefix(key, sessionKey+"\n")
+		roleSessions = append(roleSessions, CachedSession{
+			Role:       session.ActiveRole,
+			Expiration: session.Expiration,
+			Current:    current,
+			Reusable:   current && vaultSessionReusable && !session.Expired(tolerance),
+		})
+	}
+
+	sortCachedSessions(sessions)
+	sortCachedSessions(roleSessions)
+	return append(sessions, roleSessions...)
+}
+
+func sortCachedSessions(sessions []CachedSession) {
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].Role != sessions[j].Role {
+			return sessions[i].Role < sessions[j].Role
+		}
+		return sessions[i].Expiration.After(sessions[j].Expiration)
+	})
 }
 
 // RemoveExpiredSessions removes sessions from the cache that have expired.
@@ -62,6 +163,16 @@ func (sc *SessionCache) RemoveExpiredSessions() {
 			delete(sc.Sessions, key)
 		}
 	}
+
+	for key, session := range sc.RoleSessions {
+		if session.Expired(NoTolerance) {
+			delete(sc.RoleSessions, key)
+		}
+	}
+}
+
+func roleSessionCacheKey(vault *Vault, source *Session, role string) string {
+	return VaultSessionCacheKey(vault) + "\n" + source.ActiveRole + "\n" + role
 }
 
 // VaultSessionCacheKey computes a stable key based on the contents of a vault.
@@ -92,10 +203,18 @@ func VaultSessionCacheKey(vault *Vault) string {
 		keyAttributes["vars_"+key] = value
 	}
 
+	for key, commandVar := range vault.CommandVars {
+		keyAttributes["command_var_"+key] = fmt.Sprintf("%s\x00%s\x00%t", commandVar.Command, commandVar.Timeout, commandVar.Cache)
+	}
+
 	for key, value := range vault.SSHKeys {
 		keyAttributes["ssh_key_"+key] = value
 	}
 
+	for name, file := range vault.Files {
+		keyAttributes["file_"+name] = fmt.Sprintf("%s\x00%o\x00%s", file.Content, file.FileMode(), file.EnvVar)
+	}
+
 	if vault.SSHOptions != nil {
 		if vault.SSHOptions.DisableProxy {
 			keyAttributes["ssh_disable_proxy"] = "true"
@@ -115,6 +234,13 @@ func VaultSessionCacheKey(vault *Vault) string {
 		}
 	}
 
+	// included vaults are merged into the session, so their content is part
+	// of the key as well
+	keyAttributes["includes"] = strings.Join(vault.Includes, "\n")
+	for i, included := range vault.Included {
+		keyAttributes[fmt.Sprintf("include_%d", i)] = VaultSessionCacheKey(included)
+	}
+
 	// get a sorted list of the keys (that do not have blank values)
 	var keys []string
 	for key, value := range keyAttributes {
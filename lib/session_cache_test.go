@@ -2,6 +2,7 @@ package vaulted_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/miquella/vaulted/lib"
 )
@@ -103,3 +104,122 @@ func TestVaultSessionCacheKey(t *testing.T) {
 		t.Error("Failed to generate unique key for altered SSH key")
 	}
 }
+
+func TestSessionCacheRoleSessions(t *testing.T) {
+	sc := vaulted.SessionCache{}
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{
+			"TESTING": "testing",
+		},
+	}
+	session := &vaulted.Session{
+		Name:       "testing",
+		Expiration: time.Now().Add(time.Hour),
+	}
+	roleSession := &vaulted.Session{
+		Name:       "testing",
+		Expiration: time.Now().Add(time.Hour),
+		ActiveRole: "arn:aws:iam::123456789012:role/first",
+	}
+	chainedSession := &vaulted.Session{
+		Name:       "testing",
+		Expiration: time.Now().Add(time.Hour),
+		ActiveRole: "arn:aws:iam::123456789012:role/second",
+	}
+
+	sc.PutVaultSession(vault, session)
+	sc.PutRoleSession(vault, session, "first", roleSession)
+	sc.PutRoleSession(vault, roleSession, "second", chainedSession)
+
+	cached, err := sc.GetRoleSession(vault, session, "first")
+	if err != nil {
+		t.Fatalf("failed to get role session: %v", err)
+	}
+	if cached.ActiveRole != roleSession.ActiveRole {
+		t.Errorf("expected role %s, got %s", roleSession.ActiveRole, cached.ActiveRole)
+	}
+
+	// roles are keyed by the session they were assumed with
+	cached, err = sc.GetRoleSession(vault, roleSession, "second")
+	if err != nil {
+		t.Fatalf("failed to get chained role session: %v", err)
+	}
+	if cached.ActiveRole != chainedSession.ActiveRole {
+		t.Errorf("expected role %s, got %s", chainedSession.ActiveRole, cached.ActiveRole)
+	}
+
+	_, err = sc.GetRoleSession(vault, session, "second")
+	if err != vaulted.ErrVaultSessionNotFound {
+		t.Errorf("expected ErrVaultSessionNotFound, got %v", err)
+	}
+
+	// and by the vault
+	otherVault := &vaulted.Vault{}
+	_, err = sc.GetRoleSession(otherVault, session, "first")
+	if err != vaulted.ErrVaultSessionNotFound {
+		t.Errorf("expected ErrVaultSessionNotFound, got %v", err)
+	}
+
+	// expired role sessions are removed
+	roleSession.Expiration = time.Now().Add(-time.Minute)
+	sc.PutRoleSession(vault, session, "first", roleSession)
+	sc.RemoveExpiredSessions()
+	_, err = sc.GetRoleSession(vault, session, "first")
+	if err != vaulted.ErrVaultSessionNotFound {
+		t.Errorf("expected expired role session to be removed, got %v", err)
+	}
+
+	// replacing the vault's session invalidates its role sessions
+	sc.PutVaultSession(vault, session)
+	_, err = sc.GetRoleSession(vault, roleSession, "second")
+	if err != vaulted.ErrVaultSessionNotFound {
+		t.Errorf("expected role sessions to be invalidated, got %v", err)
+	}
+}
+
+func TestSessionCacheListSessions(t *testing.T) {
+	sc := vaulted.SessionCache{}
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{
+			"TESTING": "testing",
+		},
+	}
+	session := &vaulted.Session{
+		Name:       "testing",
+		Expiration: time.Now().Add(time.Hour),
+	}
+	roleSession := &vaulted.Session{
+		Name:       "testing",
+		Expiration: time.Now().Add(10 * time.Minute),
+		ActiveRole: "arn:aws:iam::123456789012:role/first",
+	}
+
+	sc.PutVaultSession(vault, session)
+	sc.PutRoleSession(vault, session, "first", roleSession)
+
+	sessions := sc.ListSessions(vault, 15*time.Minute)
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+	if sessions[0].Role != "" || !sessions[0].Current || !sessions[0].Reusable {
+		t.Errorf("expected the vault's session to be reused, got %#v", sessions[0])
+	}
+	if sessions[1].Role != roleSession.ActiveRole || !sessions[1].Current || sessions[1].Reusable {
+		t.Errorf("expected the role session to be expiring, got %#v", sessions[1])
+	}
+
+	sessions = sc.ListSessions(vault, 5*time.Minute)
+	if !sessions[1].Reusable {
+		t.Errorf("expected the role session to be reused with a shorter tolerance, got %#v", sessions[1])
+	}
+
+	// sessions of a vault that has changed are never reused
+	changedVault := &vaulted.Vault{}
+	for _, s := range sc.ListSessions(changedVault, 5*time.Minute) {
+		if s.Current || s.Reusable {
+			t.Errorf("expected the session to be stale, got %#v", s)
+		}
+	}
+}
@@ -2,68 +2,70 @@ package vaulted
 
 import (
 	"encoding/json"
-	"os"
-	"path/filepath"
-
-	"github.com/miquella/xdg"
+	"fmt"
 )
 
+// SessionFileVersion is the current version of the session file format.
+//
+// Files without a version (implicitly version 1) predate binding the vault
+// name to the ciphertext. Version 2 files are encrypted with the vault key
+// itself, rather than a subkey derived from it.
+const SessionFileVersion = 3
+
 type SessionFile struct {
+	Version int `json:"version,omitempty"`
+
 	Method     string  `json:"method"`
 	Details    Details `json:"details,omitempty"`
 	Ciphertext []byte  `json:"ciphertext"`
 }
 
-func readSessionFile(name string) (*SessionFile, error) {
-	existing := xdg.CACHE_HOME.Find(filepath.Join("vaulted", name))
-	if existing == "" {
-		return nil, os.ErrNotExist
+// additionalData returns the data authenticated alongside the session cache
+// for the named vault.
+func (sf *SessionFile) additionalData(name string) []byte {
+	if sf.Version < 2 {
+		if sf.Method == MethodSecretbox {
+			return nil
+		}
+		return []byte("vaulted\x00session\x00" + sf.Method)
 	}
 
-	f, err := os.Open(existing)
+	return []byte(fmt.Sprintf("vaulted\x00session\x00%s\x00v%d\x00%s", sf.Method, sf.Version, name))
+}
+
+func readSessionFile(backend Backend, name string) (*SessionFile, error) {
+	err := requireSecurePermissions(backend, SessionBlob, name)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	d := json.NewDecoder(f)
-	ef := SessionFile{}
-	err = d.Decode(&ef)
+	content, err := backend.Read(SessionBlob, name)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ef, nil
+	return parseSessionFile(content)
 }
 
-func writeSessionFile(name string, sessionFile *SessionFile) error {
-	pathname := xdg.CACHE_HOME.Join("vaulted")
-	err := os.MkdirAll(pathname, 0700)
+func parseSessionFile(content []byte) (*SessionFile, error) {
+	sf := SessionFile{}
+	err := json.Unmarshal(content, &sf)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	filename := xdg.CACHE_HOME.Join(filepath.Join("vaulted", name))
-	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	return &sf, nil
+}
 
-	e := json.NewEncoder(f)
-	err = e.Encode(sessionFile)
+func writeSessionFile(backend Backend, name string, sessionFile *SessionFile) error {
+	content, err := json.Marshal(sessionFile)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return backend.Write(SessionBlob, name, content)
 }
 
-func removeSessionCache(name string) error {
-	existing := xdg.CACHE_HOME.Find(filepath.Join("vaulted", name))
-	if existing == "" {
-		return os.ErrNotExist
-	}
-
-	return os.Remove(existing)
+func removeSessionCache(backend Backend, name string) error {
+	return backend.Remove(SessionBlob, name)
 }
@@ -37,7 +37,10 @@ func TestSessionVariables(t *testing.T) {
 	}
 	var expectedUnset []string
 
-	vars := s.Variables()
+	vars, err := s.Variables()
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	if !reflect.DeepEqual(expectedSet, vars.Set) {
 		t.Errorf("Expected: %#v\nGot: %#v\n", expectedSet, vars.Set)
@@ -75,7 +78,10 @@ func TestSessionVariablesWithPermCreds(t *testing.T) {
 		"AWS_SESSION_TOKEN",
 	}
 
-	vars := s.Variables()
+	vars, err := s.Variables()
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	if !reflect.DeepEqual(expectedSet, vars.Set) {
 		t.Errorf("Expected: %#v\nGot: %#v\n", expectedSet, vars.Set)
@@ -114,7 +120,10 @@ func TestSessionVariablesWithTempCreds(t *testing.T) {
 	}
 	var expectedUnset []string
 
-	vars := s.Variables()
+	vars, err := s.Variables()
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	if !reflect.DeepEqual(expectedSet, vars.Set) {
 		t.Errorf("Expected: %#v\nGot: %#v\n", expectedSet, vars.Set)
@@ -20,9 +20,22 @@ type StewardMaxTries interface {
 	GetMaxOpenTries() int
 }
 
+// StewardVaultMaxTries is implemented by stewards whose maximum number of
+// tries depends on the vault. It takes precedence over StewardMaxTries.
+type StewardVaultMaxTries interface {
+	GetMaxOpenTriesForVault(name string) int
+}
+
+// StewardKeyfile is implemented by stewards that can locate the keyfile
+// required by a vault.
+type StewardKeyfile interface {
+	GetKeyfile(name string) (string, error)
+}
+
 type StaticSteward struct {
 	Password string
 	MFAToken *string
+	Keyfile  string
 }
 
 func NewStaticSteward(password string) *StaticSteward {
@@ -49,3 +62,10 @@ func (s *StaticSteward) GetMFAToken(name string) (string, error) {
 		return *s.MFAToken, nil
 	}
 }
+
+func (s *StaticSteward) GetKeyfile(name string) (string, error) {
+	if s.Keyfile == "" {
+		return "", ErrKeyfileRequired
+	}
+	return s.Keyfile, nil
+}
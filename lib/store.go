@@ -1,23 +1,25 @@
 package vaulted
 
 import (
-	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/miquella/ssh-proxy-agent/lib/proxyagent"
-	"github.com/miquella/xdg"
-	"golang.org/x/crypto/nacl/secretbox"
 )
 
 var (
 	ErrIncorrectPassword       = errors.New("Incorrect password")
 	ErrInvalidKeyConfig        = errors.New("Invalid key configuration")
 	ErrInvalidEncryptionConfig = errors.New("Invalid encryption configuration")
+	ErrVaultNameMismatch       = errors.New("Vault file was sealed for a different vault (it may have been renamed, copied, or tampered with)")
+	ErrVaultTampered           = errors.New("Vault file has been tampered with (the key is correct, but the vault's name, metadata, or content could not be authenticated)")
+	ErrVaultNotOpened          = errors.New("The vault must be opened before its session can be cached")
 )
 
 type Store interface {
@@ -26,23 +28,117 @@ type Store interface {
 	ListVaults() ([]string, error)
 
 	VaultExists(name string) bool
+	GetVaultMetadata(name string) (*VaultMetadata, error)
 	OpenVault(name string) (*Vault, string, error)
 	OpenVaultWithPassword(name, password string) (*Vault, string, error)
+	ResolveIncludes(vault *Vault, name string) error
 	SealVault(vault *Vault, name string) error
 	SealVaultWithPassword(vault *Vault, name, password string) error
+	SealVaultWithOptions(vault *Vault, name, password string, options *SealOptions) error
 	RemoveVault(name string) error
+	TrashVault(name string) error
 
-	CreateSession(vault *Vault, name, password string) (*Session, error)
-	GetSession(vault *Vault, name, password string) (*Session, error)
+	ListTrash() ([]TrashedVault, error)
+	RestoreTrashedVault(name string) error
+	PurgeTrash(before time.Time) error
+
+	Identity() (string, error)
+	ListRecipients(name string) ([]string, error)
+	AddRecipients(name string, recipients []string) error
+	RemoveRecipients(name string, recipients []string) error
+
+	ListVaultVersions(name string) ([]VaultVersion, error)
+	RestoreVaultVersion(name string, version int) error
+
+	SyncVaults(options *SyncOptions) (*SyncResult, error)
+
+	VerifyVault(name string, options *VerifyOptions) (*VaultReport, error)
+	FixPermissions() ([]PermissionProblem, []PermissionProblem, error)
+
+	SealBundle(bundle *Bundle, name string) ([]byte, error)
+	OpenBundle(content []byte, name string) (*Bundle, string, error)
+
+	CreateSession(vault *Vault, name string) (*Session, error)
+	GetSession(vault *Vault, name string) (*Session, error)
+	AssumeRole(vault *Vault, name string, session *Session, role string) (*Session, error)
+	ListSessionCaches() ([]string, error)
+	ListSessions(vault *Vault, name string) ([]CachedSession, error)
+	RemoveSessionCache(name string) error
+}
+
+// SealOptions customizes how a vault is sealed. Blank options retain the
+// settings of the existing vault (or use the defaults for a new vault).
+type SealOptions struct {
+	// KeyMethod selects the key derivation method (see KeyMethods).
+	KeyMethod string
+
+	// CalibrationTarget calibrates the key derivation parameters to take
+	// this long on this machine (see CalibrateKeyDetails), rather than
+	// using the defaults.
+	CalibrationTarget time.Duration
+
+	// Method selects the encryption method (see Methods).
+	Method string
+
+	// Keyfile requires the keyfile at this path (in addition to the
+	// password) to open the vault.
+	Keyfile string
+
+	// RemoveKeyfile removes the keyfile requirement of the vault.
+	RemoveKeyfile bool
+
+	// KeepSessionCache re-encrypts the vault's session cache with the new
+	// key, rather than removing it. Cached sessions are keyed by the vault's
+	// content, so this is only useful if the content is unchanged (e.g. when
+	// only the password is changed). The vault must have been opened first.
+	KeepSessionCache bool
 }
 
 type store struct {
 	steward Steward
+	backend Backend
+
+	// keys remembers the keys of the vaults that have been opened, so that
+	// vaults opened with an identity (or the agent) can be sealed (and their
+	// sessions cached) without the password
+	keysMutex sync.Mutex
+	keys      map[string]rememberedKey
+
+	// keyfiles remembers the keyfile secrets of the vaults that have been
+	// opened, so the keyfile is only located once
+	keyfilesMutex sync.Mutex
+	keyfiles      map[string][]byte
+
+	// calibrations remembers the calibrated key derivation parameters, so
+	// each method is only benchmarked once
+	calibrationsMutex sync.Mutex
+	calibrations      map[calibrationKey]Details
+
+	agent *agentClient
+}
+
+// rememberedKey is the key a vault was opened with, along with the ID of the
+// key configuration it belongs to (see vaultKeyID).
+type rememberedKey struct {
+	id  string
+	key []byte
 }
 
+// New creates a store that keeps vaults in the XDG data directories.
 func New(steward Steward) Store {
+	return NewWithBackend(steward, NewXDGBackend())
+}
+
+// NewWithBackend creates a store that keeps vaults in the specified backend.
+func NewWithBackend(steward Steward, backend Backend) Store {
 	return &store{
-		steward: steward,
+		steward:  steward,
+		backend:  backend,
+		keys:     make(map[string]rememberedKey),
+		keyfiles: make(map[string][]byte),
+		agent:    &agentClient{},
+
+		calibrations: make(map[calibrationKey]Details),
 	}
 }
 
@@ -51,57 +147,90 @@ func (s *store) Steward() Steward {
 }
 
 func (s *store) ListVaults() ([]string, error) {
-	vaults, err := xdg.DATA.Glob(filepath.Join("vaulted", "*"))
+	vaults, err := s.backend.List(VaultBlob)
 	if err != nil {
 		return nil, err
 	}
 
 	var found []string
-	emitted := map[string]bool{}
 	for _, vault := range vaults {
-		info, err := os.Stat(vault)
-		if err != nil {
-			return nil, err
-		}
-		if !info.Mode().IsRegular() {
-			continue
-		}
-
-		if !emitted[info.Name()] {
-			emitted[info.Name()] = true
-			found = append(found, info.Name())
-		}
+		found = append(found, vault.Name)
 	}
 
 	return found, nil
 }
 
 func (s *store) VaultExists(name string) bool {
-	existing := xdg.DATA.Find(filepath.Join("vaulted", name))
-	return len(existing) != 0
+	_, err := s.backend.Read(VaultBlob, name)
+	return err == nil
+}
+
+// GetVaultMetadata returns the metadata of the vault, without opening it. The
+// metadata is only verified when the vault is opened.
+func (s *store) GetVaultMetadata(name string) (*VaultMetadata, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if vf.Metadata == nil {
+		return &VaultMetadata{}, nil
+	}
+	return vf.Metadata, nil
 }
 
+// OpenVault opens a vault with a key held by the agent (if it is running) or
+// the user's identity (if it is a recipient of the vault), otherwise the
+// password is requested from the steward. If the vault is opened without the
+// password, the returned password is blank.
 func (s *store) OpenVault(name string) (*Vault, string, error) {
 	if !s.VaultExists(name) {
 		return nil, "", os.ErrNotExist
 	}
 
+	v, err := s.openVaultWithAgent(name)
+	if err == nil {
+		return v, "", nil
+	}
+
+	v, err = s.openVaultWithIdentity(name)
+	if err != ErrRecipientNotFound && err != ErrIncorrectPassword {
+		return v, "", err
+	}
+
+	var password string
+	err = s.tryPasswords(name, func(p string) error {
+		v, password, err = s.OpenVaultWithPassword(name, p)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return v, password, nil
+}
+
+// tryPasswords requests the vault's password from the steward (up to the
+// steward's maximum number of tries) until try accepts it.
+func (s *store) tryPasswords(name string, try func(password string) error) error {
 	maxTries := 1
-	if getMax, ok := s.steward.(StewardMaxTries); ok {
+	if getMax, ok := s.steward.(StewardVaultMaxTries); ok {
+		maxTries = getMax.GetMaxOpenTriesForVault(name)
+	} else if getMax, ok := s.steward.(StewardMaxTries); ok {
 		maxTries = getMax.GetMaxOpenTries()
 	}
 	for i := 0; i < maxTries; i++ {
 		password, err := s.steward.GetPassword(OpenOperation, name)
 		if err != nil {
-			return nil, "", err
+			return err
 		}
 
-		if v, p, err := s.OpenVaultWithPassword(name, password); err != ErrIncorrectPassword {
-			return v, p, err
+		if err := try(password); err != ErrIncorrectPassword {
+			return err
 		}
 	}
 
-	return nil, "", ErrIncorrectPassword
+	return ErrIncorrectPassword
 }
 
 func (s *store) OpenVaultWithPassword(name, password string) (*Vault, string, error) {
@@ -109,48 +238,221 @@ func (s *store) OpenVaultWithPassword(name, password string) (*Vault, string, er
 		return nil, "", os.ErrNotExist
 	}
 
-	vf, err := readVaultFile(name)
+	vf, err := readVaultFile(s.backend, name)
 	if err != nil {
 		return nil, "", err
 	}
 
-	v := Vault{}
+	key, err := s.vaultKey(vf, name, password)
+	if err != nil {
+		return nil, "", err
+	}
 
-	switch vf.Method {
-	case "secretbox":
-		if vf.Key == nil {
-			return nil, "", ErrInvalidKeyConfig
-		}
+	v, err := openVaultContent(vf, name, key)
+	if err != nil {
+		return nil, "", err
+	}
 
-		nonce := vf.Details.Bytes("nonce")
-		if len(nonce) == 0 {
-			return nil, "", ErrInvalidEncryptionConfig
-		}
-		boxNonce := [24]byte{}
-		copy(boxNonce[:], nonce)
+	// the key is only known to be correct once the vault has been opened
+	s.rememberKey(name, vf, key)
+	s.agent.addKey(name, vaultKeyID(vf), key)
 
-		boxKey := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(boxKey))
-		if err != nil {
-			return nil, "", err
+	return v, password, nil
+}
+
+// openVaultWithAgent opens a vault using a key held by the agent. If the
+// agent isn't running (or doesn't hold the key), ErrAgentKeyNotFound is
+// returned.
+func (s *store) openVaultWithAgent(name string) (*Vault, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+
+	key := s.agent.key(name, vaultKeyID(vf))
+	if key == nil {
+		return nil, ErrAgentKeyNotFound
+	}
+
+	v, err := openVaultContent(vf, name, key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rememberKey(name, vf, key)
+	return v, nil
+}
+
+// openVaultWithIdentity opens a vault using the user's identity. If there is
+// no identity (or it isn't a recipient of the vault), ErrRecipientNotFound is
+// returned.
+func (s *store) openVaultWithIdentity(name string) (*Vault, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := s.unwrapWithIdentity(name, vf)
+	if err != nil {
+		return nil, err
+	}
+
+	return openVaultContent(vf, name, dataKey)
+}
+
+func (s *store) unwrapWithIdentity(name string, vf *VaultFile) ([]byte, error) {
+	dataKey, err := identityDataKey(vf)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rememberKey(name, vf, dataKey)
+	return dataKey, nil
+}
+
+// identityDataKey unwraps the vault's data key using the user's identity.
+func identityDataKey(vf *VaultFile) ([]byte, error) {
+	if len(vf.Recipients) == 0 {
+		return nil, ErrRecipientNotFound
+	}
+
+	id, err := LoadIdentity()
+	if err == os.ErrNotExist {
+		return nil, ErrRecipientNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return vf.unwrapWithIdentity(id)
+}
+
+// vaultKey returns the key the vault's content (and session cache) is
+// encrypted with.
+func (s *store) vaultKey(vf *VaultFile, name, password string) ([]byte, error) {
+	if vf.Key == nil {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	// the vault may have been opened with an identity (or the agent)
+	if password == "" {
+		if key := s.rememberedKey(name, vf); key != nil {
+			return key, nil
 		}
-		copy(boxKey[:], derivedKey[:])
+	}
+
+	passwordKey, err := s.passwordKey(vf.Key, name, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if !vf.usesDataKey() {
+		return passwordKey, nil
+	}
 
-		plaintext, ok := secretbox.Open(nil, vf.Ciphertext, &boxNonce, &boxKey)
-		if !ok {
-			return nil, "", ErrIncorrectPassword
+	dataKey, err := unwrapDataKey(vf, name, passwordKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rememberKey(name, vf, dataKey)
+	return dataKey, nil
+}
+
+// unwrapDataKey unwraps the vault's data key with the key derived from the
+// password. If the data key can only be unwrapped with the vault's original
+// name, the name recorded in the file was altered.
+func unwrapDataKey(vf *VaultFile, name string, passwordKey []byte) ([]byte, error) {
+	dataKey, err := unwrapKey(passwordKey, vf.WrappedKey, vf.keyAdditionalData())
+	if err == ErrIncorrectPassword && vf.Name != name {
+		if _, err := unwrapKey(passwordKey, vf.WrappedKey, vf.boundTo(name).keyAdditionalData()); err == nil {
+			return nil, ErrVaultTampered
 		}
+	}
+	return dataKey, err
+}
 
-		err = json.Unmarshal(plaintext, &v)
-		if err != nil {
-			return nil, "", err
+// vaultKeyID identifies the key configuration of a vault file. Keys are only
+// remembered for the key configuration they were derived from, so a vault
+// that is re-keyed (e.g. by another process) isn't sealed with a stale key.
+func vaultKeyID(vf *VaultFile) string {
+	h := sha256.New()
+	json.NewEncoder(h).Encode(vf.Key)
+	h.Write(vf.WrappedKey)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (s *store) rememberKey(name string, vf *VaultFile, key []byte) {
+	s.keysMutex.Lock()
+	defer s.keysMutex.Unlock()
+
+	s.keys[name] = rememberedKey{
+		id:  vaultKeyID(vf),
+		key: key,
+	}
+}
+
+func (s *store) rememberedKey(name string, vf *VaultFile) []byte {
+	s.keysMutex.Lock()
+	defer s.keysMutex.Unlock()
+
+	remembered, ok := s.keys[name]
+	if !ok || remembered.id != vaultKeyID(vf) {
+		return nil
+	}
+	return remembered.key
+}
+
+func (s *store) forgetKey(name string) {
+	s.keysMutex.Lock()
+	defer s.keysMutex.Unlock()
+
+	delete(s.keys, name)
+}
+
+// openVaultPlaintext decrypts the vault's content. A data key has already
+// been authenticated when it was unwrapped, so content that can't be
+// authenticated with it (or with the vault's original name) was altered,
+// rather than opened with an incorrect password.
+func openVaultPlaintext(vf *VaultFile, name string, key []byte) ([]byte, error) {
+	plaintext, err := open(vf.Method, key, vf.Ciphertext, vf.additionalData(), vf.Details)
+	if err == ErrIncorrectPassword {
+		switch {
+		case vf.usesDataKey():
+			return nil, ErrVaultTampered
+		case vf.Version >= 2 && vf.Name != name:
+			bound := vf.boundTo(name)
+			if _, err := open(vf.Method, key, vf.Ciphertext, bound.additionalData(), vf.Details); err == nil {
+				return nil, ErrVaultTampered
+			}
 		}
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	default:
-		return nil, "", fmt.Errorf("Invalid encryption method: %s", vf.Method)
+	// the name is authenticated, so a mismatch means the file was moved
+	if vf.Version >= 2 && vf.Name != name {
+		return nil, ErrVaultNameMismatch
 	}
 
-	return &v, password, nil
+	return plaintext, nil
+}
+
+func openVaultContent(vf *VaultFile, name string, key []byte) (*Vault, error) {
+	plaintext, err := openVaultPlaintext(vf, name, key)
+	if err != nil {
+		return nil, err
+	}
+
+	v := Vault{}
+	err = json.Unmarshal(plaintext, &v)
+	if err != nil {
+		return nil, err
+	}
+	v.Metadata = vf.Metadata.Clone()
+
+	return &v, nil
 }
 
 func (s *store) SealVault(vault *Vault, name string) error {
@@ -163,19 +465,92 @@ func (s *store) SealVault(vault *Vault, name string) error {
 }
 
 func (s *store) SealVaultWithPassword(vault *Vault, name, password string) error {
+	return s.SealVaultWithOptions(vault, name, password, nil)
+}
+
+func (s *store) SealVaultWithOptions(vault *Vault, name, password string, options *SealOptions) error {
+	if options == nil {
+		options = &SealOptions{}
+	}
+
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	vf := &VaultFile{
-		Method:  "secretbox",
+		Version: VaultFileVersion,
+		Name:    name,
 		Details: make(Details),
 	}
 
 	// generate a new key (while trying to keeping the existing key derivation and encryption methods)
-	existingVaultFile, err := readVaultFile(name)
+	existingVaultFile, err := readVaultFile(s.backend, name)
 	if err == nil {
 		vf.Method = existingVaultFile.Method
 		vf.Key = existingVaultFile.Key
+	} else if isInsecurePermissions(err) {
+		return err
+	} else {
+		existingVaultFile = nil
+	}
+
+	// a vault opened without the password (with an identity or the agent)
+	// keeps its existing keys
+	var key []byte
+	keepKeys := options.KeyMethod == "" && options.CalibrationTarget == 0 && options.Keyfile == "" && !options.RemoveKeyfile
+	if existingVaultFile != nil && password == "" && keepKeys {
+		key = s.rememberedKey(name, existingVaultFile)
+	}
+
+	switch {
+	case key != nil:
+		vf.WrappedKey = existingVaultFile.WrappedKey
+		vf.Recipients = existingVaultFile.Recipients
+
+	default:
+		previousKey := vf.Key
+		vf.Key, err = newVaultKey(previousKey, options.KeyMethod)
+		if err != nil {
+			return err
+		}
+
+		err = s.applyCalibration(vf.Key, options)
+		if err != nil {
+			return err
+		}
+
+		err = s.applyKeyfileOptions(vf.Key, previousKey, name, options)
+		if err != nil {
+			return err
+		}
+
+		passwordKey, err := s.passwordKey(vf.Key, name, password)
+		if err != nil {
+			return err
+		}
+
+		// the content is encrypted with a random data key (wrapped for the
+		// password and each recipient), so the password is confirmed
+		// before the content is opened and altered content isn't mistaken
+		// for an incorrect password
+		key, err = newDataKey()
+		if err != nil {
+			return err
+		}
+
+		var recipients []string
+		if existingVaultFile != nil {
+			recipients = existingVaultFile.recipients()
+		}
+		err = vf.wrapDataKey(passwordKey, key, recipients)
+		if err != nil {
+			return err
+		}
 	}
 
-	vf.Key = newVaultKey(vf.Key)
+	vf.Metadata = sealedMetadata(vault, existingVaultFile)
 
 	// marshal the vault content
 	content, err := json.Marshal(vault)
@@ -184,67 +559,499 @@ func (s *store) SealVaultWithPassword(vault *Vault, name, password string) error
 	}
 
 	// encrypt the vault
+	if options.Method != "" {
+		vf.Method = options.Method
+	}
 	if vf.Method == "" {
-		vf.Method = "secretbox"
+		vf.Method = DefaultMethod
+	}
+
+	vf.Ciphertext, err = seal(vf.Method, key, content, vf.additionalData(), vf.Details)
+	if err != nil {
+		return err
+	}
+
+	// the session cache can only be read with the previous key
+	var sessionCache *SessionCache
+	if options.KeepSessionCache && existingVaultFile != nil {
+		sessionCache, _ = s.openSessionCache(name)
+	}
+
+	err = writeVaultFile(s.backend, name, vf)
+	if err != nil {
+		return err
+	}
+
+	s.rememberKey(name, vf, key)
+
+	// we ignore errors because the session cache is only an optimization
+	if sessionCache != nil {
+		s.sealSessionCache(sessionCache, name)
+	}
+	commitVault(s.backend, name, fmt.Sprintf("Seal vault '%s'", name))
+
+	return nil
+}
+
+func (s *store) RemoveVault(name string) error {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = s.backend.Remove(VaultBlob, name)
+	if err != nil {
+		return err
+	}
+
+	removeSessionCache(s.backend, name)
+	err = removeVaultHistory(s.backend, name)
+	commitVault(s.backend, name, fmt.Sprintf("Remove vault '%s'", name))
+	if err != nil {
+		return err
 	}
 
-	switch vf.Method {
-	case "secretbox":
-		nonce := [24]byte{}
-		_, err = rand.Read(nonce[:])
+	return nil
+}
+
+func (s *store) TrashVault(name string) error {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = trashVaultFile(s.backend, name)
+	if err != nil {
+		return err
+	}
+
+	removeSessionCache(s.backend, name)
+	commitVault(s.backend, name, fmt.Sprintf("Move vault '%s' to the trash", name))
+
+	return nil
+}
+
+func (s *store) ListTrash() ([]TrashedVault, error) {
+	return listTrash(s.backend)
+}
+
+func (s *store) RestoreTrashedVault(name string) error {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = restoreTrashedVault(s.backend, name)
+	if err != nil {
+		return err
+	}
+
+	commitVault(s.backend, name, fmt.Sprintf("Restore vault '%s' from the trash", name))
+
+	return nil
+}
+
+func (s *store) PurgeTrash(before time.Time) error {
+	return purgeTrash(s.backend, before)
+}
+
+func (s *store) ListVaultVersions(name string) ([]VaultVersion, error) {
+	return listVaultVersions(s.backend, name)
+}
+
+func (s *store) RestoreVaultVersion(name string, version int) error {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	versions, err := listVaultVersions(s.backend, name)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range versions {
+		if v.Version == version {
+			vf, err := readVaultBlob(s.backend, HistoryBlob, v.blob)
+			if err != nil {
+				return err
+			}
+
+			err = writeVaultFile(s.backend, name, vf)
+			if err != nil {
+				return err
+			}
+
+			commitVault(s.backend, name, fmt.Sprintf("Restore vault '%s' to version %d", name, version))
+
+			return nil
+		}
+	}
+
+	return ErrVaultVersionNotFound
+}
+
+// Identity returns the public key of the user's identity, generating the
+// identity if it doesn't exist yet.
+func (s *store) Identity() (string, error) {
+	id, err := LoadIdentity()
+	if err == os.ErrNotExist {
+		id, err = GenerateIdentity()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return id.PublicKey(), nil
+}
+
+func (s *store) ListRecipients(name string) ([]string, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return vf.recipients(), nil
+}
+
+// AddRecipients wraps the vault's data key for additional recipients. The
+// vault content is only re-encrypted when the vault doesn't have a data key
+// yet (and was only protected by its password).
+func (s *store) AddRecipients(name string, recipients []string) error {
+	recipients, err := parseRecipients(recipients)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return err
+	}
+
+	if vf.usesDataKey() {
+		dataKey, err := s.unwrapWithIdentity(name, vf)
+		if err == ErrRecipientNotFound {
+			err = s.tryPasswords(name, func(password string) error {
+				dataKey, err = s.vaultKey(vf, name, password)
+				return err
+			})
+		}
 		if err != nil {
 			return err
 		}
-		vf.Details.SetBytes("nonce", nonce[:])
 
-		key := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(key))
+		for _, recipient := range recipients {
+			if vf.hasRecipient(recipient) {
+				continue
+			}
+
+			r, err := wrapForRecipient(recipient, dataKey, vf.keyAdditionalData())
+			if err != nil {
+				return err
+			}
+			vf.Recipients = append(vf.Recipients, r)
+		}
+	} else {
+		passwordKey, plaintext, err := s.unlockWithPassword(name, vf)
 		if err != nil {
 			return err
 		}
-		copy(key[:], derivedKey[:])
 
-		vf.Ciphertext = secretbox.Seal(nil, content, &nonce, &key)
+		err = rekeyVaultFile(vf, name, passwordKey, plaintext, recipients)
+		if err != nil {
+			return err
+		}
+	}
 
-	default:
-		return fmt.Errorf("Invalid encryption method: %s", vf.Method)
+	err = writeVaultFile(s.backend, name, vf)
+	if err != nil {
+		return err
 	}
 
-	return writeVaultFile(name, vf)
+	commitVault(s.backend, name, fmt.Sprintf("Add recipients to vault '%s'", name))
+
+	return nil
 }
 
-func (s *store) RemoveVault(name string) error {
-	existing := xdg.DATA_HOME.Find(filepath.Join("vaulted", name))
-	if existing == "" {
-		untouchable := xdg.DATA_DIRS.Find(filepath.Join("vaulted", name))
-		if len(untouchable) == 0 {
-			return os.ErrNotExist
+// RemoveRecipients removes recipients from the vault. The vault is
+// re-encrypted with a new data key (so removed recipients can't open future
+// versions of the vault), which requires the vault's password.
+func (s *store) RemoveRecipients(name string, recipients []string) error {
+	recipients, err := parseRecipients(recipients)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return err
+	}
+
+	remove := map[string]bool{}
+	for _, recipient := range recipients {
+		if !vf.hasRecipient(recipient) {
+			return ErrRecipientNotFound
 		}
+		remove[recipient] = true
+	}
+
+	var remaining []string
+	for _, recipient := range vf.recipients() {
+		if !remove[recipient] {
+			remaining = append(remaining, recipient)
+		}
+	}
 
-		return fmt.Errorf("Because %s is outside the vaulted managed directory (%s), it must be removed manually", untouchable[0], xdg.DATA_HOME.Join("vaulted"))
+	passwordKey, plaintext, err := s.unlockWithPassword(name, vf)
+	if err != nil {
+		return err
+	}
+
+	err = rekeyVaultFile(vf, name, passwordKey, plaintext, remaining)
+	if err != nil {
+		return err
 	}
 
-	removeSessionCache(name)
+	err = writeVaultFile(s.backend, name, vf)
+	if err != nil {
+		return err
+	}
+
+	s.forgetKey(name)
+	commitVault(s.backend, name, fmt.Sprintf("Remove recipients from vault '%s'", name))
+
+	return nil
+}
+
+// unlockWithPassword requests the vault's password, returning the key derived
+// from the password and the decrypted vault content.
+func (s *store) unlockWithPassword(name string, vf *VaultFile) ([]byte, []byte, error) {
+	var passwordKey, plaintext []byte
+	err := s.tryPasswords(name, func(password string) error {
+		var err error
+		if vf.Key == nil {
+			return ErrInvalidKeyConfig
+		}
+
+		passwordKey, err = s.passwordKey(vf.Key, name, password)
+		if err != nil {
+			return err
+		}
+
+		key := passwordKey
+		if vf.usesDataKey() {
+			key, err = unwrapDataKey(vf, name, passwordKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		plaintext, err = openVaultPlaintext(vf, name, key)
+		return err
+	})
 
-	return os.Remove(existing)
+	return passwordKey, plaintext, err
 }
 
-func (s *store) GetSession(v *Vault, name, password string) (*Session, error) {
-	sessionCache, err := s.openSessionCache(name, password)
+// rekeyVaultFile re-encrypts the vault content with a new data key, wrapped
+// for the password and each of the recipients.
+func rekeyVaultFile(vf *VaultFile, name string, passwordKey, plaintext []byte, recipients []string) error {
+	dataKey, err := newDataKey()
 	if err != nil {
-		sessionCache = &SessionCache{}
-		removeSessionCache(name)
-	} else {
-		session, err := sessionCache.GetVaultSession(v)
-		if err == nil && !session.Expired(15*time.Minute) {
-			return session, nil
+		return err
+	}
+
+	vf.Version = VaultFileVersion
+	vf.Name = name
+
+	err = vf.wrapDataKey(passwordKey, dataKey, recipients)
+	if err != nil {
+		return err
+	}
+
+	vf.Details = make(Details)
+	vf.Ciphertext, err = seal(vf.Method, dataKey, plaintext, vf.additionalData(), vf.Details)
+	return err
+}
+
+func parseRecipients(recipients []string) ([]string, error) {
+	var parsed []string
+	for _, recipient := range recipients {
+		r, err := ParseRecipient(recipient)
+		if err != nil {
+			return nil, err
 		}
+		parsed = append(parsed, r)
+	}
+	return parsed, nil
+}
+
+// GetSession returns the cached session of the vault, creating a new session
+// if there isn't one. The session cache is encrypted with the key the vault
+// was opened with, so the vault must have been opened (or sealed) first.
+func (s *store) GetSession(v *Vault, name string) (*Session, error) {
+	session, err := s.getCachedSession(v, name)
+	if err == nil {
+		return session, nil
+	}
+	if isInsecurePermissions(err) {
+		return nil, err
+	}
+
+	return s.CreateSession(v, name)
+}
+
+func (s *store) getCachedSession(v *Vault, name string) (*Session, error) {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	sessionCache, err := s.openSessionCache(name)
+	if isInsecurePermissions(err) {
+		return nil, err
+	}
+	if err != nil {
+		removeSessionCache(s.backend, name)
+		return nil, err
+	}
+
+	session, err := sessionCache.GetVaultSession(v)
+	if err != nil {
+		return nil, err
+	}
+	if session.Expired(SessionTolerance) {
+		return nil, ErrVaultSessionNotFound
+	}
+
+	return session, nil
+}
+
+// AssumeRole assumes a role with a session of the vault. Sessions for assumed
+// roles are cached along with the vault's session, until the vault's session
+// is replaced (e.g. when it is refreshed).
+func (s *store) AssumeRole(v *Vault, name string, session *Session, role string) (*Session, error) {
+	roleSession, err := s.getCachedRoleSession(v, name, session, role)
+	if err == nil {
+		return roleSession, nil
+	}
+
+	roleSession, err = session.AssumeRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	// we ignore errors because the session is viable even if saving the cache fails
+	s.cacheRoleSession(v, name, session, role, roleSession)
+
+	return roleSession, nil
+}
+
+func (s *store) getCachedRoleSession(v *Vault, name string, session *Session, role string) (*Session, error) {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	sessionCache, err := s.openSessionCache(name)
+	if err != nil {
+		return nil, err
+	}
+
+	roleSession, err := sessionCache.GetRoleSession(v, session, role)
+	if err != nil {
+		return nil, err
+	}
+	if roleSession.Expired(SessionTolerance) {
+		return nil, ErrVaultSessionNotFound
+	}
+
+	return roleSession, nil
+}
+
+func (s *store) cacheRoleSession(v *Vault, name string, session *Session, role string, roleSession *Session) error {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// role sessions are only cached alongside the vault's session
+	sessionCache, err := s.openSessionCache(name)
+	if err != nil {
+		return err
+	}
+
+	sessionCache.PutRoleSession(v, session, role, roleSession)
+	return s.sealSessionCache(sessionCache, name)
+}
+
+// ListSessionCaches lists the names of the vaults that have a session cache,
+// including vaults that no longer exist.
+func (s *store) ListSessionCaches() ([]string, error) {
+	blobs, err := s.backend.List(SessionBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, blob := range blobs {
+		names = append(names, blob.Name)
+	}
+	return names, nil
+}
+
+// ListSessions describes the sessions cached for the vault. Like GetSession,
+// the vault must have been opened first.
+func (s *store) ListSessions(v *Vault, name string) ([]CachedSession, error) {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	sessionCache, err := s.openSessionCache(name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionCache.ListSessions(v, SessionTolerance), nil
+}
+
+// RemoveSessionCache removes the session cache of the vault. The vault doesn't
+// need to be opened (or even exist).
+func (s *store) RemoveSessionCache(name string) error {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
 	}
+	defer unlock()
 
-	return s.CreateSession(v, name, password)
+	return removeSessionCache(s.backend, name)
 }
 
-func (s *store) CreateSession(v *Vault, name, password string) (*Session, error) {
+func (s *store) CreateSession(v *Vault, name string) (*Session, error) {
 	var session *Session
 	var err error
 
@@ -277,21 +1084,31 @@ func (s *store) CreateSession(v *Vault, name, password string) (*Session, error)
 	}
 
 	// we ignore errors because the session is viable even if saving the cache fails
-	sessionCache, err := s.openSessionCache(name, password)
+	s.cacheSession(v, session, name)
+
+	return session, nil
+}
+
+func (s *store) cacheSession(v *Vault, session *Session, name string) error {
+	unlock, err := s.backend.Lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	sessionCache, err := s.openSessionCache(name)
 	if err != nil {
 		sessionCache = &SessionCache{}
-		removeSessionCache(name)
+		removeSessionCache(s.backend, name)
 	}
 
 	sessionCache.PutVaultSession(v, session)
-	s.sealSessionCache(sessionCache, name, password)
-
-	return session, nil
+	return s.sealSessionCache(sessionCache, name)
 }
 
-func (s *store) sealSessionCache(sessionCache *SessionCache, name, password string) error {
+func (s *store) sealSessionCache(sessionCache *SessionCache, name string) error {
 	// read the vault file (to get key details)
-	vf, err := readVaultFile(name)
+	vf, err := readVaultFile(s.backend, name)
 	if err != nil {
 		return err
 	}
@@ -306,82 +1123,51 @@ func (s *store) sealSessionCache(sessionCache *SessionCache, name, password stri
 		return err
 	}
 
-	// encrypt the session
+	// encrypt the session (using the same method as the vault)
 	sf := &SessionFile{
-		Method:  "secretbox",
+		Version: SessionFileVersion,
+		Method:  vf.Method,
 		Details: make(Details),
 	}
 
-	switch sf.Method {
-	case "secretbox":
-		nonce := [24]byte{}
-		_, err = rand.Read(nonce[:])
-		if err != nil {
-			return err
-		}
-		sf.Details.SetBytes("nonce", nonce[:])
-
-		key := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(key))
-		if err != nil {
-			return err
-		}
-		copy(key[:], derivedKey[:])
-
-		sf.Ciphertext = secretbox.Seal(nil, content, &nonce, &key)
+	key, err := s.sessionKey(vf, sf, name)
+	if err != nil {
+		return err
+	}
 
-	default:
+	sf.Ciphertext, err = seal(sf.Method, key, content, sf.additionalData(name), sf.Details)
+	if err != nil {
 		return err
 	}
 
-	return writeSessionFile(name, sf)
+	return writeSessionFile(s.backend, name, sf)
 }
 
-func (s *store) openSessionCache(name, password string) (*SessionCache, error) {
-	vf, err := readVaultFile(name)
+func (s *store) openSessionCache(name string) (*SessionCache, error) {
+	vf, err := readVaultFile(s.backend, name)
 	if err != nil {
 		return nil, err
 	}
 
-	sf, err := readSessionFile(name)
+	sf, err := readSessionFile(s.backend, name)
 	if err != nil {
 		return nil, err
 	}
 
-	sessionCache := SessionCache{}
-
-	switch sf.Method {
-	case "secretbox":
-		if vf.Key == nil {
-			return nil, ErrInvalidKeyConfig
-		}
-
-		nonce := sf.Details.Bytes("nonce")
-		if len(nonce) == 0 {
-			return nil, ErrInvalidEncryptionConfig
-		}
-		boxNonce := [24]byte{}
-		copy(boxNonce[:], nonce)
-
-		boxKey := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(boxKey))
-		if err != nil {
-			return nil, err
-		}
-		copy(boxKey[:], derivedKey[:])
-
-		plaintext, ok := secretbox.Open(nil, sf.Ciphertext, &boxNonce, &boxKey)
-		if !ok {
-			return nil, ErrIncorrectPassword
-		}
+	key, err := s.sessionKey(vf, sf, name)
+	if err != nil {
+		return nil, err
+	}
 
-		err = json.Unmarshal(plaintext, &sessionCache)
-		if err != nil {
-			return nil, err
-		}
+	plaintext, err := open(sf.Method, key, sf.Ciphertext, sf.additionalData(name), sf.Details)
+	if err != nil {
+		return nil, err
+	}
 
-	default:
-		return nil, fmt.Errorf("Invalid encryption method: %s", sf.Method)
+	sessionCache := SessionCache{}
+	err = json.Unmarshal(plaintext, &sessionCache)
+	if err != nil {
+		return nil, err
 	}
 
 	if sessionCache.SessionCacheVersion != SessionCacheVersion {
@@ -390,3 +1176,20 @@ func (s *store) openSessionCache(name, password string) (*SessionCache, error) {
 
 	return &sessionCache, nil
 }
+
+// sessionKey returns the key the session cache is encrypted with. It is
+// derived from the key the vault was opened with (so the password isn't
+// needed, and the key isn't derived from the password again).
+func (s *store) sessionKey(vf *VaultFile, sf *SessionFile, name string) ([]byte, error) {
+	key := s.rememberedKey(name, vf)
+	if key == nil {
+		return nil, ErrVaultNotOpened
+	}
+
+	// older session caches are encrypted with the vault key itself
+	if sf.Version < 3 {
+		return key, nil
+	}
+
+	return deriveSubkey(key, "vaulted\x00session-cache")
+}
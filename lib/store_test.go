@@ -1,12 +1,17 @@
 package vaulted_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/miquella/xdg"
 
@@ -103,13 +108,692 @@ func TestSealVault(t *testing.T) {
 	}
 }
 
+func TestSealVaultWithKeyMethod(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	v1 := vaulted.Vault{
+		Vars: map[string]string{
+			"TEST": "TESTING",
+		},
+	}
+	err := store.SealVaultWithOptions(&v1, "testing", "password", &vaulted.SealOptions{
+		KeyMethod: vaulted.KeyMethodArgon2id,
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vf := readTestVaultFile(t, "testing")
+	if vf.Key.Method != vaulted.KeyMethodArgon2id {
+		t.Fatalf("expected key method: %s, got: %s", vaulted.KeyMethodArgon2id, vf.Key.Method)
+	}
+	if vf.Key.Details.Int("memory") == 0 || vf.Key.Details.Int("time") == 0 || vf.Key.Details.Int("threads") == 0 {
+		t.Fatalf("expected argon2id parameters, got: %#v", vf.Key.Details)
+	}
+
+	v2, _, err := store.OpenVault("testing")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if v2.Vars["TEST"] != "TESTING" {
+		t.Fatalf("expected: TESTING, got: %s", v2.Vars["TEST"])
+	}
+
+	// resealing without options retains the key method
+	err = store.SealVault(v2, "testing")
+	if err != nil {
+		t.Fatalf("failed to reseal vault: %v", err)
+	}
+
+	vf = readTestVaultFile(t, "testing")
+	if vf.Key.Method != vaulted.KeyMethodArgon2id {
+		t.Fatalf("expected key method: %s, got: %s", vaulted.KeyMethodArgon2id, vf.Key.Method)
+	}
+
+	// existing pbkdf2 vaults can be migrated
+	v3, _, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	err = store.SealVaultWithOptions(v3, "aaa", "password", &vaulted.SealOptions{
+		KeyMethod: vaulted.KeyMethodArgon2id,
+	})
+	if err != nil {
+		t.Fatalf("failed to migrate vault: %v", err)
+	}
+
+	_, _, err = store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open migrated vault: %v", err)
+	}
+
+	err = store.SealVaultWithOptions(v3, "aaa", "password", &vaulted.SealOptions{
+		KeyMethod: "bogus",
+	})
+	if err == nil {
+		t.Fatal("expected an invalid key method to fail")
+	}
+}
+
+func TestSealVaultWithMethod(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for _, method := range vaulted.Methods {
+		v1 := vaulted.Vault{
+			Vars: map[string]string{
+				"TEST": method,
+			},
+		}
+		err := store.SealVaultWithOptions(&v1, method, "password", &vaulted.SealOptions{
+			Method: method,
+		})
+		if err != nil {
+			t.Fatalf("failed to seal %s vault: %v", method, err)
+		}
+
+		vf := readTestVaultFile(t, method)
+		if vf.Method != method {
+			t.Fatalf("expected method: %s, got: %s", method, vf.Method)
+		}
+
+		v2, _, err := store.OpenVault(method)
+		if err != nil {
+			t.Fatalf("failed to open %s vault: %v", method, err)
+		}
+		if v2.Vars["TEST"] != method {
+			t.Fatalf("expected: %s, got: %s", method, v2.Vars["TEST"])
+		}
+
+		// the session cache is encrypted with the vault's method
+		s1, err := store.CreateSession(v2, method)
+		if err != nil {
+			t.Fatalf("failed to create %s session: %v", method, err)
+		}
+		s2, err := store.GetSession(v2, method)
+		if err != nil {
+			t.Fatalf("failed to get %s session: %v", method, err)
+		}
+		if !s1.Expiration.Equal(s2.Expiration) {
+			t.Fatalf("expected cached %s session to be reused", method)
+		}
+	}
+
+	// existing vaults can be re-encrypted
+	v3, _, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	err = store.SealVaultWithOptions(v3, "aaa", "password", &vaulted.SealOptions{
+		Method: vaulted.MethodAES256GCM,
+	})
+	if err != nil {
+		t.Fatalf("failed to re-encrypt vault: %v", err)
+	}
+
+	vf := readTestVaultFile(t, "aaa")
+	if vf.Method != vaulted.MethodAES256GCM {
+		t.Fatalf("expected method: %s, got: %s", vaulted.MethodAES256GCM, vf.Method)
+	}
+
+	_, _, err = store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open re-encrypted vault: %v", err)
+	}
+}
+
+func TestSessionCacheCannotReplaceVault(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	v1 := vaulted.Vault{}
+	err := store.SealVaultWithOptions(&v1, "testing", "password", &vaulted.SealOptions{
+		Method: vaulted.MethodXChaCha20Poly1305,
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = store.CreateSession(&v1, "testing")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	sessionFile, err := ioutil.ReadFile(filepath.Join(string(xdg.CACHE_HOME), "vaulted", "testing"))
+	if err != nil {
+		t.Fatalf("failed to read session file: %v", err)
+	}
+
+	sf := vaulted.SessionFile{}
+	err = json.Unmarshal(sessionFile, &sf)
+	if err != nil {
+		t.Fatalf("failed to parse session file: %v", err)
+	}
+
+	vf := readTestVaultFile(t, "testing")
+	vf.Details = sf.Details
+	vf.Ciphertext = sf.Ciphertext
+	writeTestVaultFile(t, "testing", vf)
+
+	_, _, err = store.OpenVault("testing")
+	if err != vaulted.ErrVaultTampered {
+		t.Fatalf("expected: %v, got: %v", vaulted.ErrVaultTampered, err)
+	}
+}
+
+func TestSessionCacheWithoutPassword(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	// the vault must be opened before its session can be cached
+	_, err := store.CreateSession(&vaulted.Vault{}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	_, err = os.Stat(filepath.Join(string(xdg.CACHE_HOME), "vaulted", "aaa"))
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected the session not to be cached, got: %v", err)
+	}
+
+	vault, _, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	s1, err := store.CreateSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	sessionFile, err := ioutil.ReadFile(filepath.Join(string(xdg.CACHE_HOME), "vaulted", "aaa"))
+	if err != nil {
+		t.Fatalf("failed to read session file: %v", err)
+	}
+
+	sf := vaulted.SessionFile{}
+	err = json.Unmarshal(sessionFile, &sf)
+	if err != nil {
+		t.Fatalf("failed to parse session file: %v", err)
+	}
+	if sf.Version != vaulted.SessionFileVersion {
+		t.Fatalf("expected session file version %d, got %d", vaulted.SessionFileVersion, sf.Version)
+	}
+
+	// another process reuses the cached session once it has opened the vault
+	otherStore := testStore()
+	vault, _, err = otherStore.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	s2, err := otherStore.GetSession(vault, "aaa")
+	if err != nil {
+		t.Fatalf("failed to get session: %v", err)
+	}
+	if !s1.Expiration.Equal(s2.Expiration) {
+		t.Fatal("expected cached session to be reused")
+	}
+}
+
+func TestRemoveSessionCache(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for _, name := range []string{"aaa", "bbb"} {
+		vault, _, err := store.OpenVault(name)
+		if err != nil {
+			t.Fatalf("failed to open vault: %v", err)
+		}
+
+		_, err = store.CreateSession(vault, name)
+		if err != nil {
+			t.Fatalf("failed to create session: %v", err)
+		}
+
+		sessions, err := store.ListSessions(vault, name)
+		if err != nil {
+			t.Fatalf("failed to list sessions: %v", err)
+		}
+		if len(sessions) != 1 || !sessions[0].Reusable {
+			t.Fatalf("expected a reusable session, got %#v", sessions)
+		}
+	}
+
+	// session caches outlive vaults that are removed by other means
+	err := os.Remove(filepath.Join(string(xdg.DATA_HOME), "vaulted", "aaa"))
+	if err != nil {
+		t.Fatalf("failed to remove vault file: %v", err)
+	}
+
+	names, err := store.ListSessionCaches()
+	if err != nil {
+		t.Fatalf("failed to list session caches: %v", err)
+	}
+	sort.Strings(names)
+	if !reflect.DeepEqual(names, []string{"aaa", "bbb"}) {
+		t.Fatalf("expected session caches for aaa and bbb, got %v", names)
+	}
+
+	err = store.RemoveSessionCache("aaa")
+	if err != nil {
+		t.Fatalf("failed to remove session cache: %v", err)
+	}
+	err = store.RemoveSessionCache("aaa")
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected os.ErrNotExist, got %v", err)
+	}
+
+	names, err = store.ListSessionCaches()
+	if err != nil {
+		t.Fatalf("failed to list session caches: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"bbb"}) {
+		t.Fatalf("expected a session cache for bbb, got %v", names)
+	}
+}
+
+func TestSealVaultKeepSessionCache(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for _, keep := range []bool{true, false} {
+		vault, _, err := store.OpenVault("aaa")
+		if err != nil {
+			t.Fatalf("failed to open vault: %v", err)
+		}
+
+		session, err := store.CreateSession(vault, "aaa")
+		if err != nil {
+			t.Fatalf("failed to create session: %v", err)
+		}
+
+		err = store.SealVaultWithOptions(vault, "aaa", "password", &vaulted.SealOptions{KeepSessionCache: keep})
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+
+		names, err := store.ListSessionCaches()
+		if err != nil {
+			t.Fatalf("failed to list session caches: %v", err)
+		}
+		if keep != (len(names) == 1) {
+			t.Fatalf("expected the session cache to be kept: %v, got %v", keep, names)
+		}
+
+		if keep {
+			// the cache is readable with the new key
+			reopened := testStore()
+			vault, _, err = reopened.OpenVault("aaa")
+			if err != nil {
+				t.Fatalf("failed to open vault: %v", err)
+			}
+
+			cached, err := reopened.GetSession(vault, "aaa")
+			if err != nil {
+				t.Fatalf("failed to get session: %v", err)
+			}
+			if !cached.Expiration.Equal(session.Expiration) {
+				t.Fatalf("expected the cached session to be reused, got %#v", cached)
+			}
+		}
+	}
+}
+
+func TestVaultNameBinding(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for _, method := range vaulted.Methods {
+		v1 := vaulted.Vault{
+			Vars: map[string]string{
+				"TEST": method,
+			},
+		}
+		err := store.SealVaultWithOptions(&v1, "original", "password", &vaulted.SealOptions{
+			Method: method,
+		})
+		if err != nil {
+			t.Fatalf("failed to seal %s vault: %v", method, err)
+		}
+
+		vf := readTestVaultFile(t, "original")
+		if vf.Version != vaulted.VaultFileVersion || vf.Name != "original" {
+			t.Fatalf("expected version %d file named 'original', got version %d named '%s'", vaulted.VaultFileVersion, vf.Version, vf.Name)
+		}
+
+		// a renamed (or swapped) file is detected
+		writeTestVaultFile(t, "renamed", vf)
+		_, _, err = store.OpenVault("renamed")
+		if err != vaulted.ErrVaultNameMismatch {
+			t.Fatalf("%s: expected: %v, got: %v", method, vaulted.ErrVaultNameMismatch, err)
+		}
+
+		// rewriting the recorded name in place is detected as tampering
+		vf.Name = "other"
+		writeTestVaultFile(t, "original", vf)
+		_, _, err = testStore().OpenVault("original")
+		if err != vaulted.ErrVaultTampered {
+			t.Fatalf("%s: expected: %v, got: %v", method, vaulted.ErrVaultTampered, err)
+		}
+		vf.Name = "original"
+		writeTestVaultFile(t, "original", vf)
+
+		// rewriting the recorded name of a moved file fails authentication
+		// (the original name is unknown)
+		vf.Name = "renamed"
+		writeTestVaultFile(t, "renamed", vf)
+		_, _, err = store.OpenVault("renamed")
+		if err != vaulted.ErrIncorrectPassword {
+			t.Fatalf("%s: expected: %v, got: %v", method, vaulted.ErrIncorrectPassword, err)
+		}
+
+		// stripping the version fails rather than opening unbound
+		vf.Version = 0
+		vf.Name = ""
+		writeTestVaultFile(t, "renamed", vf)
+		_, _, err = store.OpenVault("renamed")
+		if err == nil {
+			t.Fatalf("%s: expected an unversioned copy to fail to open", method)
+		}
+
+		// resealing under a new name (i.e. `vaulted cp`) rebinds the vault
+		v2, _, err := store.OpenVault("original")
+		if err != nil {
+			t.Fatalf("failed to open %s vault: %v", method, err)
+		}
+		err = store.SealVault(v2, "copied")
+		if err != nil {
+			t.Fatalf("failed to copy %s vault: %v", method, err)
+		}
+		v3, _, err := store.OpenVault("copied")
+		if err != nil {
+			t.Fatalf("failed to open copied %s vault: %v", method, err)
+		}
+		if v3.Vars["TEST"] != method {
+			t.Fatalf("expected: %s, got: %s", method, v3.Vars["TEST"])
+		}
+
+		for _, name := range []string{"original", "renamed", "copied"} {
+			store.RemoveVault(name)
+		}
+	}
+}
+
+func TestVaultMetadata(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	vault := &vaulted.Vault{
+		Metadata: &vaulted.VaultMetadata{
+			Description: "Production account",
+			Tags:        []string{"prod", "aws"},
+		},
+	}
+	err := store.SealVaultWithPassword(vault, "testing", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// metadata is available without the password
+	metadata, err := testStoreWithPassword("").GetVaultMetadata("testing")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if metadata.Description != "Production account" || !metadata.HasTag("prod") || metadata.HasTag("dev") {
+		t.Fatalf("unexpected metadata: %#v", metadata)
+	}
+	if metadata.Created.IsZero() || !metadata.Created.Equal(metadata.Modified) {
+		t.Fatalf("expected created and modified timestamps, got %#v", metadata)
+	}
+
+	// the creation time is retained, and metadata is retained by vaults
+	// sealed without metadata
+	vf := readTestVaultFile(t, "testing")
+	vf.Metadata.Created = vf.Metadata.Created.Add(-time.Hour)
+	writeTestVaultFile(t, "testing", vf)
+
+	err = store.SealVaultWithPassword(&vaulted.Vault{}, "testing", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vault, _, err = testStore().OpenVault("testing")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if vault.Metadata.Description != "Production account" {
+		t.Fatalf("expected metadata to be retained, got %#v", vault.Metadata)
+	}
+	if !vault.Metadata.Created.Equal(vf.Metadata.Created) {
+		t.Fatalf("expected created %v, got %v", vf.Metadata.Created, vault.Metadata.Created)
+	}
+
+	// altered metadata is detected when the vault is opened
+	vf = readTestVaultFile(t, "testing")
+	vf.Metadata.Tags = []string{"dev"}
+	writeTestVaultFile(t, "testing", vf)
+
+	_, _, err = testStore().OpenVault("testing")
+	if err != vaulted.ErrVaultTampered {
+		t.Fatalf("expected: %v, got: %v", vaulted.ErrVaultTampered, err)
+	}
+
+	// an incorrect password is still reported as such
+	_, _, err = testStoreWithPassword("invalid password").OpenVault("testing")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected: %v, got: %v", vaulted.ErrIncorrectPassword, err)
+	}
+}
+
+func TestSealVaultConcurrently(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v := vaulted.Vault{
+				Vars: map[string]string{
+					"TEST": fmt.Sprintf("TESTING %d", i),
+				},
+			}
+			errs <- store.SealVault(&v, "testing")
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	v, _, err := store.OpenVault("testing")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if !strings.HasPrefix(v.Vars["TEST"], "TESTING ") {
+		t.Fatalf("expected one of the sealed vaults, got: %s", v.Vars["TEST"])
+	}
+
+	// no temporary files should remain
+	leftovers, _ := filepath.Glob(filepath.Join(string(xdg.DATA_HOME), "vaulted", ".testing.tmp*"))
+	if len(leftovers) != 0 {
+		t.Fatalf("expected no temporary files, got: %v", leftovers)
+	}
+}
+
+func TestListVaultsIgnoresTemporaryFiles(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	err := ioutil.WriteFile(filepath.Join(string(xdg.DATA_HOME), "vaulted", ".aaa.tmp123"), []byte{}, 0600)
+	if err != nil {
+		t.Fatalf("failed to write temporary file: %v", err)
+	}
+
+	store := testStore()
+
+	vaults, err := store.ListVaults()
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+
+	sort.Strings(vaults)
+	expected := []string{"aaa", "bbb", "ccc"}
+	if !reflect.DeepEqual(expected, vaults) {
+		t.Fatalf("expected %#v, got %#v", expected, vaults)
+	}
+}
+
+func TestVaultHistory(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	versions, err := store.ListVaultVersions("aaa")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 0 {
+		t.Fatalf("expected no versions, got %d", len(versions))
+	}
+
+	for i := 1; i <= 3; i++ {
+		vault := &vaulted.Vault{
+			Vars: map[string]string{"TEST": fmt.Sprintf("AAA%d", i)},
+		}
+		err = store.SealVaultWithPassword(vault, "aaa", "password")
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	versions, err = store.ListVaultVersions("aaa")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 3 {
+		t.Fatalf("expected 3 versions, got %d", len(versions))
+	}
+	for i, version := range versions {
+		if version.Version != 3-i {
+			t.Errorf("expected version %d, got %d", 3-i, version.Version)
+		}
+	}
+
+	// version 1 is the original vault
+	err = store.RestoreVaultVersion("aaa", 1)
+	if err != nil {
+		t.Fatalf("failed to restore version: %v", err)
+	}
+
+	vault, _, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open restored vault: %v", err)
+	}
+	if vault.Vars["TEST"] != "AAA" {
+		t.Fatalf("expected: AAA, got %s", vault.Vars["TEST"])
+	}
+
+	// restoring preserves the version that was replaced
+	err = store.RestoreVaultVersion("aaa", 4)
+	if err != nil {
+		t.Fatalf("failed to restore version: %v", err)
+	}
+
+	vault, _, err = store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open restored vault: %v", err)
+	}
+	if vault.Vars["TEST"] != "AAA3" {
+		t.Fatalf("expected: AAA3, got %s", vault.Vars["TEST"])
+	}
+
+	err = store.RestoreVaultVersion("aaa", 100)
+	if err != vaulted.ErrVaultVersionNotFound {
+		t.Fatalf("expected ErrVaultVersionNotFound, got %v", err)
+	}
+}
+
+func TestVaultHistoryLimit(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	defer func(limit int) {
+		vaulted.VaultHistoryLimit = limit
+	}(vaulted.VaultHistoryLimit)
+	vaulted.VaultHistoryLimit = 2
+
+	store := testStore()
+
+	for i := 0; i < 4; i++ {
+		err := store.SealVaultWithPassword(&vaulted.Vault{}, "aaa", "password")
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	versions, err := store.ListVaultVersions("aaa")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 4 || versions[1].Version != 3 {
+		t.Fatalf("expected versions 4 and 3, got %#v", versions)
+	}
+
+	vaulted.VaultHistoryLimit = 0
+
+	err = store.SealVaultWithPassword(&vaulted.Vault{}, "aaa", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	versions, err = store.ListVaultVersions("aaa")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("expected history to be left alone, got %d versions", len(versions))
+	}
+}
+
 func TestRemoveVault(t *testing.T) {
 	setupVaults(t)
 	defer teardownVaults(t)
 
 	store := testStore()
 
-	err := store.RemoveVault("aaa")
+	err := store.SealVaultWithPassword(&vaulted.Vault{}, "aaa", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	err = store.RemoveVault("aaa")
 	if err != nil {
 		t.Fatalf("failed to remove vault: %v", err)
 	}
@@ -117,6 +801,172 @@ func TestRemoveVault(t *testing.T) {
 	if _, err := os.Stat(filepath.Join(string(xdg.CACHE_HOME), "vaulted", "aaa")); !os.IsNotExist(err) {
 		t.Error("cache for 'aaa' should have been removed and wasn't")
 	}
+
+	// the history of a permanently removed vault is removed as well
+	versions, err := store.ListVaultVersions("aaa")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 0 {
+		t.Fatalf("expected the history to be removed, got %#v", versions)
+	}
+}
+
+func TestTrashVault(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	err := store.TrashVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to trash vault: %v", err)
+	}
+
+	if store.VaultExists("aaa") {
+		t.Fatal("'aaa' should have been moved to the trash and wasn't")
+	}
+
+	vaults, err := store.ListVaults()
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+	sort.Strings(vaults)
+	expected := []string{"bbb", "ccc"}
+	if !reflect.DeepEqual(expected, vaults) {
+		t.Fatalf("expected %#v, got %#v", expected, vaults)
+	}
+
+	trashed, err := store.ListTrash()
+	if err != nil {
+		t.Fatalf("failed to list trash: %v", err)
+	}
+	if len(trashed) != 1 || trashed[0].Name != "aaa" {
+		t.Fatalf("expected 'aaa' in the trash, got %#v", trashed)
+	}
+
+	err = store.RestoreTrashedVault("bbb")
+	if err != vaulted.ErrVaultExists {
+		t.Fatalf("expected ErrVaultExists, got %v", err)
+	}
+
+	err = store.RestoreTrashedVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to restore vault: %v", err)
+	}
+
+	vault, _, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open restored vault: %v", err)
+	}
+	if vault.Vars["TEST"] != "AAA" {
+		t.Fatalf("expected: AAA, got %s", vault.Vars["TEST"])
+	}
+
+	err = store.RestoreTrashedVault("aaa")
+	if err != vaulted.ErrVaultExists {
+		t.Fatalf("expected ErrVaultExists, got %v", err)
+	}
+
+	err = store.RemoveVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to remove vault: %v", err)
+	}
+
+	err = store.RestoreTrashedVault("aaa")
+	if err != vaulted.ErrVaultNotInTrash {
+		t.Fatalf("expected ErrVaultNotInTrash, got %v", err)
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for _, name := range []string{"aaa", "bbb"} {
+		err := store.SealVaultWithPassword(&vaulted.Vault{}, name, "password")
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	err := store.TrashVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to trash vault: %v", err)
+	}
+
+	err = store.PurgeTrash(time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("failed to purge trash: %v", err)
+	}
+
+	trashed, err := store.ListTrash()
+	if err != nil {
+		t.Fatalf("failed to list trash: %v", err)
+	}
+	if len(trashed) != 1 {
+		t.Fatalf("expected 'aaa' to remain in the trash, got %#v", trashed)
+	}
+
+	err = store.PurgeTrash(time.Now())
+	if err != nil {
+		t.Fatalf("failed to purge trash: %v", err)
+	}
+
+	trashed, err = store.ListTrash()
+	if err != nil {
+		t.Fatalf("failed to list trash: %v", err)
+	}
+	if len(trashed) != 0 {
+		t.Fatalf("expected the trash to be empty, got %#v", trashed)
+	}
+
+	// the history of purged vaults is removed as well (but not the history
+	// of other vaults)
+	versions, err := store.ListVaultVersions("aaa")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 0 {
+		t.Fatalf("expected the history to be removed, got %#v", versions)
+	}
+
+	versions, err = store.ListVaultVersions("bbb")
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	if len(versions) != 1 {
+		t.Fatalf("expected the history of 'bbb' to remain, got %#v", versions)
+	}
+}
+
+func readTestVaultFile(t *testing.T, name string) *vaulted.VaultFile {
+	content, err := ioutil.ReadFile(filepath.Join(string(xdg.DATA_HOME), "vaulted", name))
+	if err != nil {
+		t.Fatalf("failed to read '%s' vault file: %v", name, err)
+	}
+
+	vf := vaulted.VaultFile{}
+	err = json.Unmarshal(content, &vf)
+	if err != nil {
+		t.Fatalf("failed to parse '%s' vault file: %v", name, err)
+	}
+
+	return &vf
+}
+
+func writeTestVaultFile(t *testing.T, name string, vf *vaulted.VaultFile) {
+	content, err := json.Marshal(vf)
+	if err != nil {
+		t.Fatalf("failed to marshal '%s' vault file: %v", name, err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(string(xdg.DATA_HOME), "vaulted", name), content, 0600)
+	if err != nil {
+		t.Fatalf("failed to write '%s' vault file: %v", name, err)
+	}
 }
 
 func setupVaults(t *testing.T) {
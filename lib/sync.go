@@ -0,0 +1,495 @@
+package vaulted
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrSyncUnsupported   = errors.New("Syncing is not supported by this store")
+	ErrSyncNotConfigured = errors.New("Syncing has not been configured (use --remote to specify the repository to sync with)")
+	ErrSyncConflict      = errors.New("Vaults were changed both locally and remotely (use --keep-both to keep both versions)")
+)
+
+// SyncOptions customizes how vaults are synced.
+type SyncOptions struct {
+	// Remote configures the git repository to sync with. The vault directory
+	// is initialized as a git working tree if it isn't one already.
+	Remote string
+
+	// KeepBoth resolves conflicting changes by keeping the local version of
+	// each vault and saving the remote version as a new vault (see
+	// SyncResult.RemoteCopies).
+	KeepBoth bool
+}
+
+// SyncResult describes the vaults affected by a sync.
+type SyncResult struct {
+	// Updated lists the vaults that were updated from the remote.
+	Updated []string
+
+	// Conflicts lists the vaults that were changed both locally and
+	// remotely.
+	Conflicts []string
+
+	// RemoteCopies maps the conflicting vaults to the names their remote
+	// versions were saved as (with KeepBoth). Vaults that were removed
+	// remotely have no copy.
+	RemoteCopies map[string]string
+}
+
+// gitWorkTree is implemented by backends that keep vaults in a directory that
+// can be used as a git working tree.
+type gitWorkTree interface {
+	workTree() string
+}
+
+func (b *fileBackend) workTree() string {
+	return b.layout().VaultDirs[0]
+}
+
+// gitignore keeps everything except vaults (history, trash, locks, session
+// caches, and in-progress writes are all hidden) out of the repository.
+const gitignore = ".*\n!/.gitignore\n"
+
+type gitRepo string
+
+func (r gitRepo) exists() bool {
+	_, err := os.Stat(filepath.Join(string(r), ".git"))
+	return err == nil
+}
+
+func (r gitRepo) output(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", string(r)}, args...)...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, fmt.Errorf("git %s failed: %s", args[0], message)
+	}
+
+	return out, nil
+}
+
+func (r gitRepo) git(args ...string) (string, error) {
+	out, err := r.output(args...)
+	return strings.TrimSpace(string(out)), err
+}
+
+// blob returns the ID of a vault's blob at ref, or "" if it doesn't exist.
+func (r gitRepo) blob(ref, name string) string {
+	id, _ := r.git("rev-parse", "-q", "--verify", ref+":"+name)
+	return id
+}
+
+// changedVaults lists the vaults changed between base and ref. If there is no
+// base (the histories are unrelated), all vaults at ref are listed.
+func (r gitRepo) changedVaults(base, ref string) ([]string, error) {
+	var out string
+	var err error
+	if base == "" {
+		out, err = r.git("ls-tree", "-r", "--name-only", ref)
+	} else {
+		out, err = r.git("diff", "--name-only", base, ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var vaults []string
+	for _, name := range strings.Split(out, "\n") {
+		if name != "" && !strings.HasPrefix(name, ".") && !strings.Contains(name, "/") {
+			vaults = append(vaults, name)
+		}
+	}
+	return vaults, nil
+}
+
+func (r gitRepo) commitAll(message string) error {
+	_, err := r.git("add", "-A")
+	if err != nil {
+		return err
+	}
+
+	status, err := r.git("status", "--porcelain")
+	if err != nil || status == "" {
+		return err
+	}
+
+	_, err = r.git("commit", "-q", "-m", message)
+	return err
+}
+
+func initGitRepo(r gitRepo) error {
+	err := os.MkdirAll(string(r), 0700)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.git("init", "-q")
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(string(r), ".gitignore"), []byte(gitignore), 0600)
+	if err != nil {
+		return err
+	}
+
+	return r.commitAll("Track vaults")
+}
+
+// commitVault records a change to a vault if the backend keeps vaults in a
+// git working tree. Failures are ignored, as uncommitted changes are picked
+// up by the next sync.
+func commitVault(backend Backend, name, message string) {
+	wt, ok := backend.(gitWorkTree)
+	if !ok {
+		return
+	}
+
+	repo := gitRepo(wt.workTree())
+	if !repo.exists() {
+		return
+	}
+
+	_, err := repo.git("add", "-A", "--", name)
+	if err != nil {
+		return
+	}
+
+	repo.git("commit", "-q", "-m", message, "--", name)
+}
+
+func (s *store) SyncVaults(options *SyncOptions) (*SyncResult, error) {
+	if options == nil {
+		options = &SyncOptions{}
+	}
+
+	wt, ok := s.backend.(gitWorkTree)
+	if !ok {
+		return nil, ErrSyncUnsupported
+	}
+
+	repo := gitRepo(wt.workTree())
+	if !repo.exists() {
+		if options.Remote == "" {
+			return nil, ErrSyncNotConfigured
+		}
+
+		err := initGitRepo(repo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if options.Remote != "" {
+		_, err := repo.git("remote", "set-url", "origin", options.Remote)
+		if err != nil {
+			_, err = repo.git("remote", "add", "origin", options.Remote)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// keep the vaults from changing underneath the sync
+	vaults, err := s.ListVaults()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(vaults)
+	for _, name := range vaults {
+		unlock, err := s.backend.Lock(name)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	err = repo.commitAll("Sync vaults")
+	if err != nil {
+		return nil, err
+	}
+
+	branch, err := repo.git("symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repo.git("fetch", "-q", "origin")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{}
+	remoteRef := "refs/remotes/origin/" + branch
+	if _, err := repo.git("rev-parse", "-q", "--verify", remoteRef); err == nil {
+		result, err = s.mergeRemote(repo, remoteRef, options.KeepBoth)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	_, err = repo.git("push", "-q", "-u", "origin", branch)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (s *store) mergeRemote(repo gitRepo, remoteRef string, keepBoth bool) (*SyncResult, error) {
+	// unrelated histories (e.g. two independently initialized vault
+	// directories) don't have a merge base
+	base, _ := repo.git("merge-base", "HEAD", remoteRef)
+
+	localChanges, err := repo.changedVaults(base, "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	remoteChanges, err := repo.changedVaults(base, remoteRef)
+	if err != nil {
+		return nil, err
+	}
+
+	changedLocally := map[string]bool{}
+	for _, name := range localChanges {
+		changedLocally[name] = true
+	}
+
+	result := &SyncResult{}
+	for _, name := range remoteChanges {
+		if repo.blob("HEAD", name) == repo.blob(remoteRef, name) {
+			continue
+		}
+
+		if changedLocally[name] {
+			result.Conflicts = append(result.Conflicts, name)
+		} else {
+			result.Updated = append(result.Updated, name)
+		}
+	}
+
+	if len(result.Conflicts) > 0 && !keepBoth {
+		return result, ErrSyncConflict
+	}
+
+	// save the remote version of conflicting vaults as new vaults (before
+	// merging, so nothing is changed if they can't be opened)
+	for _, name := range result.Conflicts {
+		if repo.blob(remoteRef, name) == "" {
+			continue
+		}
+
+		content, err := repo.output("show", remoteRef+":"+name)
+		if err != nil {
+			return result, err
+		}
+
+		copyName := s.uniqueVaultName(repo, remoteRef, name)
+		err = s.saveRemoteCopy(name, copyName, content)
+		if err != nil {
+			return result, fmt.Errorf("Could not save the remote version of %s: %v", name, err)
+		}
+
+		if result.RemoteCopies == nil {
+			result.RemoteCopies = make(map[string]string)
+		}
+		result.RemoteCopies[name] = copyName
+	}
+
+	_, err = repo.git("merge", "-q", "--no-commit", "--allow-unrelated-histories", remoteRef)
+	if err != nil && len(result.Conflicts) == 0 {
+		repo.git("merge", "--abort")
+		return result, err
+	}
+
+	// resolve conflicts in favor of the local version
+	for _, name := range result.Conflicts {
+		if repo.blob("HEAD", name) != "" {
+			_, err = repo.git("checkout", "HEAD", "--", name)
+		} else {
+			_, err = repo.git("rm", "-q", "-f", "--ignore-unmatch", "--", name)
+		}
+		if err != nil {
+			repo.git("merge", "--abort")
+			return result, err
+		}
+	}
+
+	if _, err := repo.git("rev-parse", "-q", "--verify", "MERGE_HEAD"); err == nil {
+		_, err = repo.git("commit", "-q", "--no-edit")
+		if err != nil {
+			repo.git("merge", "--abort")
+			return result, err
+		}
+	}
+
+	// cached sessions can't be opened with the updated vaults
+	for _, name := range result.Updated {
+		removeSessionCache(s.backend, name)
+	}
+
+	return result, nil
+}
+
+// uniqueVaultName returns a name for the remote copy of a vault that isn't
+// used by a local or remote vault.
+func (s *store) uniqueVaultName(repo gitRepo, remoteRef, name string) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", name, n)
+		if !s.VaultExists(candidate) && repo.blob(remoteRef, candidate) == "" {
+			return candidate
+		}
+	}
+}
+
+// saveRemoteCopy saves the remote version of a vault as a new vault. Vaults
+// are bound to their name, so the remote version is opened (with a key held
+// by the agent, the user's identity, or the vault's password) and encrypted
+// again under the new name. The copy keeps the remote version's data key, which
+// is wrapped again for each of its recipients (so they can still open the
+// copy).
+func (s *store) saveRemoteCopy(name, copyName string, content []byte) error {
+	vf := &VaultFile{}
+	err := json.Unmarshal(content, vf)
+	if err != nil {
+		return err
+	}
+
+	vault, key, passwordKey, err := s.openRemoteVault(name, vf)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.backend.Lock(copyName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	copyVF := &VaultFile{
+		Version:  VaultFileVersion,
+		Name:     copyName,
+		Metadata: sealedMetadata(vault, nil),
+		Key:      vf.Key,
+		Method:   vf.Method,
+		Details:  make(Details),
+	}
+
+	if vf.usesDataKey() {
+		// the key derived from the password is needed to wrap the data key
+		// for the password, so a vault opened without its password needs a
+		// password for the copy
+		if passwordKey == nil {
+			password, err := s.steward.GetPassword(SealOperation, copyName)
+			if err != nil {
+				return err
+			}
+
+			copyVF.Key, err = newVaultKey(vf.Key, "")
+			if err != nil {
+				return err
+			}
+
+			passwordKey, err = s.passwordKey(copyVF.Key, copyName, password)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = copyVF.wrapDataKey(passwordKey, key, vf.recipients())
+		if err != nil {
+			return err
+		}
+	}
+
+	plaintext, err := json.Marshal(vault)
+	if err != nil {
+		return err
+	}
+
+	copyVF.Ciphertext, err = seal(copyVF.Method, key, plaintext, copyVF.additionalData(), copyVF.Details)
+	if err != nil {
+		return err
+	}
+
+	err = writeVaultFile(s.backend, copyName, copyVF)
+	if err != nil {
+		return err
+	}
+
+	s.rememberKey(copyName, copyVF, key)
+	commitVault(s.backend, copyName, fmt.Sprintf("Seal vault '%s'", copyName))
+
+	return nil
+}
+
+// openRemoteVault opens the remote version of a vault, returning its content,
+// the key it is encrypted with and (if it was opened with the password) the key
+// derived from the password. The keys aren't remembered, since they aren't the
+// keys of the local vault.
+func (s *store) openRemoteVault(name string, vf *VaultFile) (*Vault, []byte, []byte, error) {
+	if key := s.agent.key(name, vaultKeyID(vf)); key != nil {
+		vault, err := openVaultContent(vf, name, key)
+		if err == nil {
+			if vf.usesDataKey() {
+				return vault, key, nil, nil
+			}
+			return vault, key, key, nil
+		}
+	}
+
+	if dataKey, err := identityDataKey(vf); err == nil {
+		vault, err := openVaultContent(vf, name, dataKey)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return vault, dataKey, nil, nil
+	}
+
+	if vf.Key == nil {
+		return nil, nil, nil, ErrInvalidKeyConfig
+	}
+
+	var vault *Vault
+	var key, passwordKey []byte
+	err := s.tryPasswords(name, func(password string) error {
+		var err error
+		passwordKey, err = s.passwordKey(vf.Key, name, password)
+		if err != nil {
+			return err
+		}
+
+		key = passwordKey
+		if vf.usesDataKey() {
+			key, err = unwrapDataKey(vf, name, passwordKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		vault, err = openVaultContent(vf, name, key)
+		return err
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return vault, key, passwordKey, nil
+}
@@ -0,0 +1,328 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSyncVaults(t *testing.T) {
+	remote, storeA, storeB, cleanup := setupSync(t)
+	defer cleanup()
+
+	dirA := storeA.dir
+
+	// A starts tracking its existing vaults
+	err := storeA.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "A1"}}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = storeA.SyncVaults(&vaulted.SyncOptions{Remote: remote})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	// seals are committed
+	err = storeA.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "B1"}}, "bbb")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	subject := git(t, dirA, "log", "-1", "--format=%s")
+	if subject != "Seal vault 'bbb'" {
+		t.Errorf("expected commit for sealing 'bbb', got: %s", subject)
+	}
+
+	_, err = storeA.SyncVaults(nil)
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	// B picks up A's vaults
+	result, err := storeB.SyncVaults(&vaulted.SyncOptions{Remote: remote})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	expected := []string{"aaa", "bbb"}
+	if !reflect.DeepEqual(expected, result.Updated) {
+		t.Fatalf("expected %#v to be updated, got %#v", expected, result.Updated)
+	}
+
+	vault, _, err := storeB.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open synced vault: %v", err)
+	}
+	if vault.Vars["TEST"] != "A1" {
+		t.Fatalf("expected: A1, got %s", vault.Vars["TEST"])
+	}
+
+	// removals are synced
+	err = storeB.RemoveVault("bbb")
+	if err != nil {
+		t.Fatalf("failed to remove vault: %v", err)
+	}
+
+	_, err = storeB.SyncVaults(nil)
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	_, err = storeA.SyncVaults(nil)
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	if storeA.VaultExists("bbb") {
+		t.Fatal("expected 'bbb' to be removed by the sync")
+	}
+}
+
+func TestSyncVaultsConflict(t *testing.T) {
+	remote, storeA, storeB, cleanup := setupSync(t)
+	defer cleanup()
+
+	err := storeA.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "A1"}}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = storeA.SyncVaults(&vaulted.SyncOptions{Remote: remote})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	_, err = storeB.SyncVaults(&vaulted.SyncOptions{Remote: remote})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	// both edit the same vault
+	err = storeA.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "A2"}}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = storeA.SyncVaults(nil)
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	err = storeB.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "B2"}}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	result, err := storeB.SyncVaults(nil)
+	if err != vaulted.ErrSyncConflict {
+		t.Fatalf("expected ErrSyncConflict, got %v", err)
+	}
+	if !reflect.DeepEqual([]string{"aaa"}, result.Conflicts) {
+		t.Fatalf("expected 'aaa' to conflict, got %#v", result.Conflicts)
+	}
+
+	assertVaultVar(t, storeB, "aaa", "B2")
+
+	// keeping both keeps the local version, with the remote one saved as a
+	// new vault
+	result, err = storeB.SyncVaults(&vaulted.SyncOptions{KeepBoth: true})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+	if !reflect.DeepEqual([]string{"aaa"}, result.Conflicts) {
+		t.Fatalf("expected 'aaa' to conflict, got %#v", result.Conflicts)
+	}
+	if !reflect.DeepEqual(map[string]string{"aaa": "aaa-2"}, result.RemoteCopies) {
+		t.Fatalf("expected the remote version to be saved as 'aaa-2', got %#v", result.RemoteCopies)
+	}
+
+	assertVaultVar(t, storeB, "aaa", "B2")
+	assertVaultVar(t, storeB, "aaa-2", "A2")
+
+	// A receives both versions
+	result, err = storeA.SyncVaults(nil)
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+	updated := result.Updated
+	sort.Strings(updated)
+	if !reflect.DeepEqual([]string{"aaa", "aaa-2"}, updated) {
+		t.Fatalf("expected 'aaa' and 'aaa-2' to be updated, got %#v", result.Updated)
+	}
+
+	assertVaultVar(t, storeA, "aaa", "B2")
+	assertVaultVar(t, storeA, "aaa-2", "A2")
+}
+
+func TestSyncVaultsKeepBothRecipients(t *testing.T) {
+	remote, storeA, storeB, cleanup := setupSync(t)
+	defer cleanup()
+
+	identityDir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(identityDir)
+	defer func(path string) {
+		vaulted.IdentityPath = path
+	}(vaulted.IdentityPath)
+	vaulted.IdentityPath = filepath.Join(identityDir, "bob")
+
+	bob, err := storeA.Identity()
+	if err != nil {
+		t.Fatalf("failed to generate identity: %v", err)
+	}
+
+	err = storeA.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "A1"}}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	err = storeA.AddRecipients("aaa", []string{bob})
+	if err != nil {
+		t.Fatalf("failed to add recipient: %v", err)
+	}
+
+	_, err = storeA.SyncVaults(&vaulted.SyncOptions{Remote: remote})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+	_, err = storeB.SyncVaults(&vaulted.SyncOptions{Remote: remote})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	err = storeA.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "A2"}}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	_, err = storeA.SyncVaults(nil)
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	err = storeB.SealVault(&vaulted.Vault{Vars: map[string]string{"TEST": "B2"}}, "aaa")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	_, err = storeB.SyncVaults(&vaulted.SyncOptions{KeepBoth: true})
+	if err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	// the remote copy keeps the recipients of the remote version
+	recipients, err := storeB.ListRecipients("aaa-2")
+	if err != nil {
+		t.Fatalf("failed to list recipients: %v", err)
+	}
+	if !reflect.DeepEqual([]string{bob}, recipients) {
+		t.Fatalf("expected the copy to keep its recipients, got %#v", recipients)
+	}
+
+	// the copy can be opened with the identity, and with the password
+	identityStore := vaulted.NewWithBackend(vaulted.NewStaticSteward("invalid password"), vaulted.NewDirBackend(storeB.dir))
+	assertVaultVar(t, identityStore, "aaa-2", "A2")
+
+	vaulted.IdentityPath = filepath.Join(identityDir, "nobody")
+	passwordStore := vaulted.NewWithBackend(vaulted.NewStaticSteward("password"), vaulted.NewDirBackend(storeB.dir))
+	assertVaultVar(t, passwordStore, "aaa-2", "A2")
+	assertVaultVar(t, passwordStore, "aaa", "B2")
+}
+
+func TestSyncVaultsUnsupported(t *testing.T) {
+	store := vaulted.NewWithBackend(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	_, err := store.SyncVaults(nil)
+	if err != vaulted.ErrSyncUnsupported {
+		t.Fatalf("expected ErrSyncUnsupported, got %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store = vaulted.NewWithBackend(vaulted.NewStaticSteward("password"), vaulted.NewDirBackend(dir))
+
+	_, err = store.SyncVaults(nil)
+	if err != vaulted.ErrSyncNotConfigured {
+		t.Fatalf("expected ErrSyncNotConfigured, got %v", err)
+	}
+}
+
+type dirStore struct {
+	vaulted.Store
+	dir string
+}
+
+func setupSync(t *testing.T) (string, dirStore, dirStore, func()) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+
+	env := map[string]string{
+		"GIT_AUTHOR_NAME":     "Vaulted",
+		"GIT_AUTHOR_EMAIL":    "vaulted@example.com",
+		"GIT_COMMITTER_NAME":  "Vaulted",
+		"GIT_COMMITTER_EMAIL": "vaulted@example.com",
+		"GIT_CONFIG_NOSYSTEM": "1",
+		"HOME":                root,
+	}
+	previous := map[string]string{}
+	for key, value := range env {
+		previous[key] = os.Getenv(key)
+		os.Setenv(key, value)
+	}
+
+	remote := filepath.Join(root, "remote.git")
+	git(t, root, "init", "-q", "--bare", remote)
+
+	newStore := func(name string) dirStore {
+		dir := filepath.Join(root, name)
+		return dirStore{
+			Store: vaulted.NewWithBackend(vaulted.NewStaticSteward("password"), vaulted.NewDirBackend(dir)),
+			dir:   dir,
+		}
+	}
+
+	cleanup := func() {
+		for key, value := range previous {
+			os.Setenv(key, value)
+		}
+		os.RemoveAll(root)
+	}
+
+	return remote, newStore("a"), newStore("b"), cleanup
+}
+
+func git(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func assertVaultVar(t *testing.T, store vaulted.Store, name, expected string) {
+	vault, _, err := store.OpenVault(name)
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if vault.Vars["TEST"] != expected {
+		t.Fatalf("expected: %s, got %s", expected, vault.Vars["TEST"])
+	}
+}
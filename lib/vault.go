@@ -13,6 +13,10 @@ const (
 
 var STSDurationDefault = time.Hour
 
+// MaxRoleSessionDuration is the longest a session for an assumed role lasts
+// (AWS limits role sessions assumed with temporary credentials to 1h).
+var MaxRoleSessionDuration = time.Hour
+
 var (
 	ErrInvalidCommand = errors.New("Invalid command")
 	ErrNoTokenEntered = errors.New("Could not get MFA code")
@@ -31,6 +35,25 @@ type Vault struct {
 	Vars       map[string]string `json:"vars,omitempty"`
 	SSHKeys    map[string]string `json:"ssh_keys,omitempty"`
 	SSHOptions *SSHOptions       `json:"ssh_options,omitempty"`
+
+	// CommandVars holds the vars whose values are the output of commands.
+	// They take precedence over Vars with the same name.
+	CommandVars map[string]*CommandVar `json:"command_vars,omitempty"`
+
+	// Files holds the secret files written for spawned sessions, by file
+	// name.
+	Files map[string]*SecretFile `json:"files,omitempty"`
+
+	// Includes lists the names of the vaults whose vars (and command vars),
+	// SSH keys, SSH options, and files are merged into the vault's sessions (see MergedVars).
+	Includes []string `json:"includes,omitempty"`
+
+	// Included holds the opened vaults named by Includes, in the same order
+	// (see Store.ResolveIncludes).
+	Included []*Vault `json:"-"`
+
+	// Metadata is stored unencrypted, outside of the vault's content.
+	Metadata *VaultMetadata `json:"-"`
 }
 
 func (v *Vault) NewSession(name string) (*Session, error) {
@@ -63,25 +86,51 @@ func (v *Vault) newSession(name string, credsFunc func(duration time.Duration) (
 	}
 
 	// copy the vault vars to the session
-	for key, value := range v.Vars {
+	for key, value := range v.MergedVars() {
 		s.Vars[key] = value
 	}
 
-	// copy the vault ssh keys to the session
-	if len(v.SSHKeys) > 0 {
-		s.SSHKeys = make(map[string]string)
-		for key, value := range v.SSHKeys {
-			s.SSHKeys[key] = value
+	// cached command vars are resolved once and stored with the session,
+	// while the others are resolved whenever the session is used
+	cachedCommandVars := make(map[string]*CommandVar)
+	for key, commandVar := range v.MergedCommandVars() {
+		delete(s.Vars, key)
+		if commandVar.Cache {
+			cachedCommandVars[key] = commandVar
+		} else {
+			if s.CommandVars == nil {
+				s.CommandVars = make(map[string]*CommandVar)
+			}
+			s.CommandVars[key] = commandVar
 		}
 	}
 
-	var err error
+	values, err := resolveCommandVars(cachedCommandVars)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 {
+		s.CommandValues = values
+	}
+
+	// copy the vault ssh keys to the session
+	sshKeys := v.MergedSSHKeys()
+	if len(sshKeys) > 0 {
+		s.SSHKeys = sshKeys
+	}
+
+	// copy the vault files to the session
+	files := v.MergedFiles()
+	if len(files) > 0 {
+		s.Files = files
+	}
+
 	// copy the vault ssh options to the session
-	if v.SSHOptions != nil {
-		s.SSHOptions = v.SSHOptions
+	if sshOptions := v.MergedSSHOptions(); sshOptions != nil {
+		s.SSHOptions = sshOptions
 
 		// generate an SSH key for the session if necessary
-		if v.SSHOptions.GenerateRSAKey {
+		if sshOptions.GenerateRSAKey {
 			var keyPair *proxyagent.KeyPair
 			keyPair, err = proxyagent.GenerateRSAKeyPair()
 			if err != nil {
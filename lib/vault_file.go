@@ -7,71 +7,148 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"os"
-	"path/filepath"
 
-	"github.com/miquella/xdg"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/pbkdf2"
 )
 
+const (
+	KeyMethodPBKDF2SHA512 = "pbkdf2-sha512"
+	KeyMethodArgon2id     = "argon2id"
+
+	DefaultKeyMethod = KeyMethodPBKDF2SHA512
+)
+
 const (
 	BaseIterations          = 1 << 17
 	AdditionIterationsRange = 1 << 18
 )
 
+const (
+	Argon2idTime    = 3
+	Argon2idMemory  = 64 * 1024
+	Argon2idThreads = 4
+)
+
+var (
+	// KeyMethods lists the key derivation methods that may be selected when
+	// sealing a vault.
+	KeyMethods = []string{
+		KeyMethodPBKDF2SHA512,
+		KeyMethodArgon2id,
+	}
+)
+
+// VaultFileVersion is the current version of the vault file format.
+//
+// Files without a version (implicitly version 1) predate binding the vault
+// name to the ciphertext. Version 2 files predate authenticating the vault's
+// metadata.
+const VaultFileVersion = 3
+
 type VaultFile struct {
+	Version int    `json:"version,omitempty"`
+	Name    string `json:"name,omitempty"`
+
+	// Metadata is stored unencrypted (see VaultMetadata).
+	Metadata *VaultMetadata `json:"metadata,omitempty"`
+
 	Key *VaultKey `json:"key"`
 
+	// WrappedKey and Recipients hold the vault's data key, wrapped for the
+	// password and for each recipient (see usesDataKey).
+	WrappedKey []byte            `json:"wrapped_key,omitempty"`
+	Recipients []*VaultRecipient `json:"recipients,omitempty"`
+
 	Method     string  `json:"method"`
 	Details    Details `json:"details,omitempty"`
 	Ciphertext []byte  `json:"ciphertext"`
 }
 
-func readVaultFile(name string) (*VaultFile, error) {
-	existing := xdg.DATA.Find(filepath.Join("vaulted", name))
-	if len(existing) == 0 {
-		return nil, os.ErrNotExist
+// additionalData returns the data authenticated alongside the vault content.
+//
+// Binding the file type and method prevents a session cache (which is
+// encrypted with the same key) from being substituted for the vault. Binding
+// the version and name (for versioned files) prevents the file from being
+// renamed or copied over another vault without detection. Binding the
+// metadata (for version 3 files) prevents it from being altered.
+func (vf *VaultFile) additionalData() []byte {
+	if vf.Version < 2 {
+		if vf.Method == MethodSecretbox {
+			return nil
+		}
+		return []byte("vaulted\x00vault\x00" + vf.Method)
+	}
+
+	ad := fmt.Sprintf("vaulted\x00vault\x00%s\x00v%d\x00%s", vf.Method, vf.Version, vf.Name)
+	if vf.Version >= 3 {
+		metadata, _ := json.Marshal(vf.Metadata)
+		ad += "\x00" + string(metadata)
+	}
+	return []byte(ad)
+}
+
+// boundTo returns a copy of the vault file bound to name instead, to check
+// whether the name recorded in the file was altered.
+func (vf *VaultFile) boundTo(name string) *VaultFile {
+	bound := *vf
+	bound.Name = name
+	return &bound
+}
+
+func readVaultFile(backend Backend, name string) (*VaultFile, error) {
+	err := requireSecurePermissions(backend, VaultBlob, name)
+	if err != nil {
+		return nil, err
 	}
 
-	f, err := os.Open(existing[0])
+	return readVaultBlob(backend, VaultBlob, name)
+}
+
+func readVaultBlob(backend Backend, kind BlobKind, name string) (*VaultFile, error) {
+	content, err := backend.Read(kind, name)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	d := json.NewDecoder(f)
 	vf := VaultFile{}
-	err = d.Decode(&vf)
+	err = json.Unmarshal(content, &vf)
 	if err != nil {
 		return nil, err
 	}
 
+	// metadata is only authenticated in version 3 files
+	if vf.Version < 3 {
+		vf.Metadata = nil
+	}
+
 	return &vf, nil
 }
 
-func writeVaultFile(name string, vaultFile *VaultFile) error {
-	pathname := xdg.DATA_HOME.Join("vaulted")
-	err := os.MkdirAll(pathname, 0700)
+func writeVaultFile(backend Backend, name string, vaultFile *VaultFile) error {
+	// keep the previous version around (in case this one is a mistake)
+	err := backupVaultFile(backend, name)
 	if err != nil {
 		return err
 	}
 
-	filename := xdg.DATA_HOME.Join(filepath.Join("vaulted", name))
-	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	err = writeVaultBlob(backend, VaultBlob, name, vaultFile)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
 
-	e := json.NewEncoder(f)
-	err = e.Encode(vaultFile)
+	removeSessionCache(backend, name)
+
+	return nil
+}
+
+func writeVaultBlob(backend Backend, kind BlobKind, name string, vaultFile *VaultFile) error {
+	content, err := json.Marshal(vaultFile)
 	if err != nil {
 		return err
 	}
 
-	removeSessionCache(name)
-
-	return nil
+	return backend.Write(kind, name, content)
 }
 
 type VaultKey struct {
@@ -79,34 +156,46 @@ type VaultKey struct {
 	Details Details `json:"details"`
 }
 
-func newVaultKey(previous *VaultKey) *VaultKey {
-	var method string
+// ValidKeyMethod reports whether method is a supported key derivation method.
+func ValidKeyMethod(method string) bool {
+	for _, m := range KeyMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// newVaultKey generates a new vault key using the specified key derivation
+// method. If method is blank, the method of the previous key is retained
+// (along with its parameters). Otherwise, a fresh set of parameters is
+// generated for the method.
+func newVaultKey(previous *VaultKey, method string) (*VaultKey, error) {
 	var details Details
 
 	// Copy previous key details, if present
-	if previous != nil {
+	if method == "" && previous != nil {
 		method = previous.Method
 		details = previous.Details.Clone()
 	} else {
-		method = "pbkdf2-sha512"
-		details = make(Details)
-
-		iterations := BaseIterations
-		r, err := rand.Int(rand.Reader, big.NewInt(AdditionIterationsRange))
-		if err == nil {
-			iterations += int(r.Int64())
+		if method == "" {
+			method = DefaultKeyMethod
 		}
 
-		details.SetInt("iterations", iterations)
+		var err error
+		details, err = defaultKeyDetails(method)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Generate new salt
 	switch method {
-	case "pbkdf2-sha512":
+	case KeyMethodPBKDF2SHA512, KeyMethodArgon2id:
 		salt := make([]byte, 32)
 		_, err := rand.Read(salt)
 		if err != nil {
-			return nil
+			return nil, err
 		}
 		details.SetBytes("salt", salt)
 	}
@@ -114,21 +203,74 @@ func newVaultKey(previous *VaultKey) *VaultKey {
 	return &VaultKey{
 		Method:  method,
 		Details: details,
+	}, nil
+}
+
+func defaultKeyDetails(method string) (Details, error) {
+	details := make(Details)
+
+	switch method {
+	case KeyMethodPBKDF2SHA512:
+		iterations := BaseIterations
+		r, err := rand.Int(rand.Reader, big.NewInt(AdditionIterationsRange))
+		if err == nil {
+			iterations += int(r.Int64())
+		}
+
+		details.SetInt("iterations", iterations)
+
+	case KeyMethodArgon2id:
+		details.SetInt("time", Argon2idTime)
+		details.SetInt("memory", Argon2idMemory)
+		details.SetInt("threads", Argon2idThreads)
+
+	default:
+		return nil, fmt.Errorf("Invalid key derivation method: %s", method)
 	}
+
+	return details, nil
 }
 
-func (vk *VaultKey) key(password string, keyLength int) ([]byte, error) {
+// key derives the key from the password. If the key requires a keyfile, the
+// keyfile secret (see readKeyfile) is combined with the derived key.
+func (vk *VaultKey) key(password string, keyfile []byte, keyLength int) ([]byte, error) {
+	if vk.requiresKeyfile() {
+		if keyfile == nil {
+			return nil, ErrKeyfileRequired
+		}
+		if !vk.matchesKeyfile(keyfile) {
+			return nil, ErrIncorrectKeyfile
+		}
+	}
+
+	var key []byte
 	switch vk.Method {
-	case "pbkdf2-sha512":
+	case KeyMethodPBKDF2SHA512:
 		iterations := vk.Details.Int("iterations")
 		salt := vk.Details.Bytes("salt")
 		if iterations == 0 || len(salt) == 0 {
 			return nil, ErrInvalidKeyConfig
 		}
-		return pbkdf2.Key([]byte(password), salt, iterations, keyLength, sha512.New), nil
+		key = pbkdf2.Key([]byte(password), salt, iterations, keyLength, sha512.New)
+
+	case KeyMethodArgon2id:
+		time := vk.Details.Int("time")
+		memory := vk.Details.Int("memory")
+		threads := vk.Details.Int("threads")
+		salt := vk.Details.Bytes("salt")
+		if time <= 0 || memory <= 0 || threads <= 0 || threads > 255 || len(salt) == 0 {
+			return nil, ErrInvalidKeyConfig
+		}
+		key = argon2.IDKey([]byte(password), salt, uint32(time), uint32(memory), uint8(threads), uint32(keyLength))
+
+	default:
+		return nil, fmt.Errorf("Invalid key derivation method: %s", vk.Method)
 	}
 
-	return nil, fmt.Errorf("Invalid key derivation method: %s", vk.Method)
+	if vk.requiresKeyfile() {
+		return vk.mixKeyfile(key, keyfile, keyLength)
+	}
+	return key, nil
 }
 
 type Details map[string]interface{}
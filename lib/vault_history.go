@@ -0,0 +1,145 @@
+package vaulted
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// VaultHistoryLimit is the number of previously sealed versions retained for
+// each vault. A limit of zero (or less) disables keeping history.
+var VaultHistoryLimit = 10
+
+var (
+	// ErrVaultVersionNotFound occurs when attempting to restore a version of
+	// a vault that isn't present in its history.
+	ErrVaultVersionNotFound = errors.New("Vault version not found")
+)
+
+// VaultVersion describes a previously sealed version of a vault.
+type VaultVersion struct {
+	Version int
+	Sealed  time.Time
+
+	blob string
+}
+
+// listVaultVersions lists the versions in a vault's history, newest first.
+func listVaultVersions(backend Backend, name string) ([]VaultVersion, error) {
+	blobs, err := backend.List(HistoryBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []VaultVersion
+	for _, blob := range blobs {
+		if !strings.HasPrefix(blob.Name, name+"/") {
+			continue
+		}
+
+		var version int
+		var sealed int64
+		_, err := fmt.Sscanf(strings.TrimPrefix(blob.Name, name+"/"), "%d-%d", &version, &sealed)
+		if err != nil {
+			continue
+		}
+
+		versions = append(versions, VaultVersion{
+			Version: version,
+			Sealed:  time.Unix(sealed, 0),
+			blob:    blob.Name,
+		})
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+
+	return versions, nil
+}
+
+// removeVaultHistory removes every version in a vault's history (so a
+// permanently removed vault can't be restored from its history).
+func removeVaultHistory(backend Backend, name string) error {
+	versions, err := listVaultVersions(backend, name)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range versions {
+		err = backend.Remove(HistoryBlob, v.blob)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backupVaultFile preserves the existing vault (if any) as the newest version
+// in the vault's history, pruning the history down to VaultHistoryLimit.
+func backupVaultFile(backend Backend, name string) error {
+	if VaultHistoryLimit <= 0 {
+		return nil
+	}
+
+	vaults, err := backend.List(VaultBlob)
+	if err != nil {
+		return err
+	}
+
+	var existing *BlobInfo
+	for i := range vaults {
+		if vaults[i].Name == name {
+			existing = &vaults[i]
+		}
+	}
+	if existing == nil {
+		return nil
+	}
+
+	content, err := backend.Read(VaultBlob, name)
+	if err != nil {
+		return err
+	}
+
+	return addVaultVersion(backend, name, content, existing.Modified)
+}
+
+// addVaultVersion adds sealed vault content as the newest version in the
+// vault's history, pruning the history down to VaultHistoryLimit (though the
+// version being added is always kept).
+func addVaultVersion(backend Backend, name string, content []byte, sealed time.Time) error {
+	versions, err := listVaultVersions(backend, name)
+	if err != nil {
+		return err
+	}
+
+	nextVersion := 1
+	if len(versions) > 0 {
+		nextVersion = versions[0].Version + 1
+	}
+
+	blob := fmt.Sprintf("%s/%d-%d", name, nextVersion, sealed.Unix())
+	err = backend.Write(HistoryBlob, blob, content)
+	if err != nil {
+		return err
+	}
+
+	// prune the oldest versions beyond the limit
+	versions, err = listVaultVersions(backend, name)
+	if err != nil {
+		return err
+	}
+	limit := VaultHistoryLimit
+	if limit < 1 {
+		limit = 1
+	}
+	for i := limit; i < len(versions); i++ {
+		backend.Remove(HistoryBlob, versions[i].blob)
+	}
+
+	return nil
+}
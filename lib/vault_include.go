@@ -0,0 +1,176 @@
+package vaulted
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IncludeCycleError is returned when a vault includes itself (directly or
+// through other included vaults).
+type IncludeCycleError struct {
+	// Chain lists the vaults that make up the cycle, starting and ending
+	// with the same vault.
+	Chain []string
+}
+
+func (e *IncludeCycleError) Error() string {
+	return fmt.Sprintf("Vault includes itself: %s", strings.Join(e.Chain, " -> "))
+}
+
+// ResolveIncludes opens the vaults included by the vault (and the vaults they
+// include), so their content is merged into the vault's sessions. Each
+// included vault is opened like OpenVault, so its password is only requested
+// if it can't be opened with the agent or an identity.
+func (s *store) ResolveIncludes(v *Vault, name string) error {
+	r := &includeResolver{
+		store:  s,
+		opened: make(map[string]*Vault),
+	}
+	return r.resolve(v, []string{name})
+}
+
+type includeResolver struct {
+	store *store
+
+	// opened holds the vaults that have been opened, so vaults that are
+	// included more than once are only opened once
+	opened map[string]*Vault
+}
+
+func (r *includeResolver) resolve(v *Vault, chain []string) error {
+	v.Included = nil
+	for _, include := range v.Includes {
+		for i, name := range chain {
+			if name == include {
+				cycle := append(append([]string{}, chain[i:]...), include)
+				return &IncludeCycleError{Chain: cycle}
+			}
+		}
+
+		included, ok := r.opened[include]
+		if !ok {
+			var err error
+			included, _, err = r.store.OpenVault(include)
+			if err != nil {
+				return fmt.Errorf("Could not open included vault '%s': %v", include, err)
+			}
+
+			err = r.resolve(included, append(chain[:len(chain):len(chain)], include))
+			if err != nil {
+				return err
+			}
+			r.opened[include] = included
+		}
+
+		v.Included = append(v.Included, included)
+	}
+
+	return nil
+}
+
+// MergedVars returns the vault's vars merged over the vars of its included
+// vaults. Later includes take precedence over earlier ones, and the vault's
+// own vars take precedence over all of them.
+func (v *Vault) MergedVars() map[string]string {
+	vars := make(map[string]string)
+	for _, included := range v.Included {
+		for key, value := range included.MergedVars() {
+			vars[key] = value
+		}
+	}
+	for key, value := range v.Vars {
+		vars[key] = value
+	}
+	return vars
+}
+
+// MergedCommandVars returns the vault's command vars merged over the command
+// vars of its included vaults (with the same precedence as MergedVars). A var
+// set by a vault that takes precedence replaces a command var with the same
+// name.
+func (v *Vault) MergedCommandVars() map[string]*CommandVar {
+	commandVars := make(map[string]*CommandVar)
+	for _, included := range v.Included {
+		for key := range included.MergedVars() {
+			delete(commandVars, key)
+		}
+		for key, commandVar := range included.MergedCommandVars() {
+			commandVars[key] = commandVar
+		}
+	}
+	for key := range v.Vars {
+		delete(commandVars, key)
+	}
+	for key, commandVar := range v.CommandVars {
+		commandVars[key] = commandVar
+	}
+	return commandVars
+}
+
+// MergedSSHKeys returns the vault's SSH keys merged over the SSH keys of its
+// included vaults (with the same precedence as MergedVars).
+func (v *Vault) MergedSSHKeys() map[string]string {
+	keys := make(map[string]string)
+	for _, included := range v.Included {
+		for key, value := range included.MergedSSHKeys() {
+			keys[key] = value
+		}
+	}
+	for key, value := range v.SSHKeys {
+		keys[key] = value
+	}
+	return keys
+}
+
+// MergedFiles returns the vault's files merged over the files of its included
+// vaults (with the same precedence as MergedVars).
+func (v *Vault) MergedFiles() map[string]*SecretFile {
+	files := make(map[string]*SecretFile)
+	for _, included := range v.Included {
+		for name, file := range included.MergedFiles() {
+			files[name] = file
+		}
+	}
+	for name, file := range v.Files {
+		files[name] = file
+	}
+	return files
+}
+
+// MergedSSHOptions returns the vault's SSH options merged over the SSH options
+// of its included vaults. The signing URL and principals are taken from the
+// vault that takes precedence (see MergedVars) and sets them, while whether
+// the proxy is disabled (or an RSA key generated) is taken from the vault that
+// takes precedence and has SSH options.
+func (v *Vault) MergedSSHOptions() *SSHOptions {
+	if len(v.Included) == 0 {
+		return v.SSHOptions
+	}
+
+	var merged *SSHOptions
+	options := []*SSHOptions{}
+	for _, included := range v.Included {
+		options = append(options, included.MergedSSHOptions())
+	}
+	options = append(options, v.SSHOptions)
+
+	for _, o := range options {
+		if o == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &SSHOptions{}
+		}
+
+		merged.DisableProxy = o.DisableProxy
+		merged.GenerateRSAKey = o.GenerateRSAKey
+		if o.VaultSigningUrl != "" {
+			merged.VaultSigningUrl = o.VaultSigningUrl
+		}
+		if len(o.ValidPrincipals) > 0 {
+			merged.ValidPrincipals = append([]string{}, o.ValidPrincipals...)
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,214 @@
+package vaulted_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVaultIncludesMerge(t *testing.T) {
+	vault := &vaulted.Vault{
+		Vars: map[string]string{
+			"SHARED": "vault",
+			"VAULT":  "vault",
+		},
+		SSHOptions: &vaulted.SSHOptions{
+			DisableProxy: true,
+		},
+		Included: []*vaulted.Vault{
+			{
+				Vars: map[string]string{
+					"SHARED": "first",
+					"FIRST":  "first",
+					"LATER":  "first",
+				},
+				SSHKeys: map[string]string{
+					"first": "first key",
+				},
+				Files: map[string]*vaulted.SecretFile{
+					"kubeconfig": {Content: "first"},
+				},
+				SSHOptions: &vaulted.SSHOptions{
+					GenerateRSAKey:  true,
+					VaultSigningUrl: "https://vault.example.com/v1/ssh/sign/first",
+					ValidPrincipals: []string{"first"},
+				},
+			},
+			{
+				Vars: map[string]string{
+					"LATER": "second",
+				},
+				Included: []*vaulted.Vault{
+					{
+						Vars: map[string]string{
+							"NESTED": "nested",
+						},
+					},
+				},
+				SSHOptions: &vaulted.SSHOptions{
+					VaultSigningUrl: "https://vault.example.com/v1/ssh/sign/second",
+				},
+			},
+		},
+	}
+
+	expectedVars := map[string]string{
+		"SHARED": "vault",
+		"VAULT":  "vault",
+		"FIRST":  "first",
+		"LATER":  "second",
+		"NESTED": "nested",
+	}
+	if !reflect.DeepEqual(vault.MergedVars(), expectedVars) {
+		t.Fatalf("expected vars: %#v, got: %#v", expectedVars, vault.MergedVars())
+	}
+
+	expectedSSHKeys := map[string]string{
+		"first": "first key",
+	}
+	if !reflect.DeepEqual(vault.MergedSSHKeys(), expectedSSHKeys) {
+		t.Fatalf("expected SSH keys: %#v, got: %#v", expectedSSHKeys, vault.MergedSSHKeys())
+	}
+
+	commandVars := (&vaulted.Vault{
+		Vars: map[string]string{"OWN": "value"},
+		Included: []*vaulted.Vault{
+			{CommandVars: map[string]*vaulted.CommandVar{
+				"EARLIER": {Command: "earlier"},
+				"OWN":     {Command: "own"},
+			}},
+			{Vars: map[string]string{"EARLIER": "later value"}},
+			{CommandVars: map[string]*vaulted.CommandVar{
+				"LATER": {Command: "later"},
+			}},
+		},
+	}).MergedCommandVars()
+	if len(commandVars) != 1 || commandVars["LATER"] == nil {
+		t.Fatalf("expected only the later command var, got: %#v", commandVars)
+	}
+
+	files := vault.MergedFiles()
+	if len(files) != 1 || files["kubeconfig"].Content != "first" {
+		t.Fatalf("expected the included file, got: %#v", files)
+	}
+
+	expectedSSHOptions := &vaulted.SSHOptions{
+		DisableProxy:    true,
+		VaultSigningUrl: "https://vault.example.com/v1/ssh/sign/second",
+		ValidPrincipals: []string{"first"},
+	}
+	if !reflect.DeepEqual(vault.MergedSSHOptions(), expectedSSHOptions) {
+		t.Fatalf("expected SSH options: %#v, got: %#v", expectedSSHOptions, vault.MergedSSHOptions())
+	}
+
+	session, err := vault.NewSession("vault")
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	if !reflect.DeepEqual(session.Vars, expectedVars) {
+		t.Fatalf("expected session vars: %#v, got: %#v", expectedVars, session.Vars)
+	}
+	if !reflect.DeepEqual(session.SSHKeys, expectedSSHKeys) {
+		t.Fatalf("expected session SSH keys: %#v, got: %#v", expectedSSHKeys, session.SSHKeys)
+	}
+}
+
+func TestVaultIncludesSessionCacheKey(t *testing.T) {
+	included := &vaulted.Vault{
+		Vars: map[string]string{
+			"COMMON": "one",
+		},
+	}
+	vault := &vaulted.Vault{
+		Includes: []string{"common"},
+		Included: []*vaulted.Vault{included},
+	}
+
+	key := vaulted.VaultSessionCacheKey(vault)
+
+	included.Vars["COMMON"] = "two"
+	if vaulted.VaultSessionCacheKey(vault) == key {
+		t.Fatal("expected the key to change with the included content")
+	}
+
+	vault.Included = nil
+	if vaulted.VaultSessionCacheKey(vault) == vaulted.VaultSessionCacheKey(&vaulted.Vault{}) {
+		t.Fatal("expected the key to depend on the names of included vaults")
+	}
+}
+
+func TestResolveIncludes(t *testing.T) {
+	store := vaulted.NewWithBackend(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	vaults := map[string]*vaulted.Vault{
+		"common": {
+			Vars: map[string]string{"COMMON": "common"},
+		},
+		"region": {
+			Vars:     map[string]string{"AWS_REGION": "us-west-2"},
+			Includes: []string{"common"},
+		},
+		"app": {
+			Vars:     map[string]string{"APP": "app"},
+			Includes: []string{"common", "region"},
+		},
+		"loop-a": {
+			Includes: []string{"loop-b"},
+		},
+		"loop-b": {
+			Includes: []string{"app", "loop-a"},
+		},
+		"missing": {
+			Includes: []string{"nonexistent"},
+		},
+	}
+	for name, vault := range vaults {
+		err := store.SealVaultWithPassword(vault, name, "password")
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	app, _, err := store.OpenVault("app")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	err = store.ResolveIncludes(app, "app")
+	if err != nil {
+		t.Fatalf("failed to resolve includes: %v", err)
+	}
+
+	expected := map[string]string{
+		"COMMON":     "common",
+		"AWS_REGION": "us-west-2",
+		"APP":        "app",
+	}
+	if !reflect.DeepEqual(app.MergedVars(), expected) {
+		t.Fatalf("expected: %#v, got: %#v", expected, app.MergedVars())
+	}
+
+	// vaults included more than once are only opened once
+	if app.Included[0] != app.Included[1].Included[0] {
+		t.Fatal("expected the common vault to be opened once")
+	}
+
+	loop, _, err := store.OpenVault("loop-a")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	err = store.ResolveIncludes(loop, "loop-a")
+	cycle, ok := err.(*vaulted.IncludeCycleError)
+	if !ok || !reflect.DeepEqual(cycle.Chain, []string{"loop-a", "loop-b", "loop-a"}) {
+		t.Fatalf("expected an include cycle error, got: %v", err)
+	}
+
+	missing, _, err := store.OpenVault("missing")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	err = store.ResolveIncludes(missing, "missing")
+	if err == nil {
+		t.Fatal("expected an error for a missing included vault")
+	}
+}
@@ -0,0 +1,71 @@
+package vaulted
+
+import (
+	"time"
+)
+
+// VaultMetadata describes a vault. Metadata isn't encrypted, so it can be
+// listed without the vault's password, and must never hold secrets. It is
+// authenticated along with the vault's content, so changes made to it outside
+// of Vaulted are detected when the vault is next opened.
+type VaultMetadata struct {
+	Description  string   `json:"description,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Owner        string   `json:"owner,omitempty"`
+	AWSAccountID string   `json:"aws_account_id,omitempty"`
+
+	// Created and Modified are maintained when the vault is sealed.
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+}
+
+func (m *VaultMetadata) Clone() *VaultMetadata {
+	if m == nil {
+		return nil
+	}
+
+	metadata := *m
+	if m.Tags != nil {
+		metadata.Tags = append([]string{}, m.Tags...)
+	}
+	return &metadata
+}
+
+// HasTag reports whether the vault is tagged with tag.
+func (m *VaultMetadata) HasTag(tag string) bool {
+	if m == nil {
+		return false
+	}
+
+	for _, t := range m.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// sealedMetadata returns the metadata to seal the vault with. Vaults sealed
+// without metadata retain the metadata of the existing vault file.
+func sealedMetadata(vault *Vault, existing *VaultFile) *VaultMetadata {
+	metadata := vault.Metadata.Clone()
+	if metadata == nil && existing != nil {
+		metadata = existing.Metadata.Clone()
+	}
+	if metadata == nil {
+		metadata = &VaultMetadata{}
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if existing == nil {
+		metadata.Created = now
+	} else if existing.Metadata != nil {
+		metadata.Created = existing.Metadata.Created
+	} else {
+		// vaults sealed before metadata was kept have an unknown creation time
+		metadata.Created = time.Time{}
+	}
+	metadata.Modified = now
+
+	return metadata
+}
@@ -0,0 +1,159 @@
+package vaulted
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrVaultNotInTrash occurs when attempting to restore a vault that
+	// hasn't been moved to the trash.
+	ErrVaultNotInTrash = errors.New("Vault not found in trash")
+
+	// ErrVaultExists occurs when attempting to restore a vault from the trash
+	// over a vault that already exists.
+	ErrVaultExists = errors.New("Vault already exists")
+)
+
+// TrashedVault describes a vault that was moved to the trash.
+type TrashedVault struct {
+	Name    string
+	Removed time.Time
+
+	blob string
+}
+
+// listTrash lists the vaults in the trash, most recently removed first.
+func listTrash(backend Backend) ([]TrashedVault, error) {
+	blobs, err := backend.List(TrashBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	var trashed []TrashedVault
+	for _, blob := range blobs {
+		parts := strings.SplitN(blob.Name, "-", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		removed, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		trashed = append(trashed, TrashedVault{
+			Name:    parts[1],
+			Removed: time.Unix(0, removed),
+			blob:    blob.Name,
+		})
+	}
+
+	sort.Slice(trashed, func(i, j int) bool {
+		return trashed[i].Removed.After(trashed[j].Removed)
+	})
+
+	return trashed, nil
+}
+
+// trashVaultFile moves a vault into the trash.
+func trashVaultFile(backend Backend, name string) error {
+	content, err := backend.Read(VaultBlob, name)
+	if err != nil {
+		return err
+	}
+
+	blob := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + name
+	err = backend.Write(TrashBlob, blob, content)
+	if err != nil {
+		return err
+	}
+
+	err = backend.Remove(VaultBlob, name)
+	if err != nil {
+		backend.Remove(TrashBlob, blob)
+		return err
+	}
+
+	return nil
+}
+
+// restoreTrashedVault moves the most recently trashed copy of a vault back
+// out of the trash.
+func restoreTrashedVault(backend Backend, name string) error {
+	_, err := backend.Read(VaultBlob, name)
+	if err == nil {
+		return ErrVaultExists
+	}
+
+	trashed, err := listTrash(backend)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range trashed {
+		if t.Name == name {
+			content, err := backend.Read(TrashBlob, t.blob)
+			if err != nil {
+				return err
+			}
+
+			err = backend.Write(VaultBlob, name, content)
+			if err != nil {
+				return err
+			}
+
+			return backend.Remove(TrashBlob, t.blob)
+		}
+	}
+
+	return ErrVaultNotInTrash
+}
+
+// purgeTrash permanently removes the vaults that were moved to the trash
+// before the specified time, along with their history. The history is kept
+// while a vault of the same name exists (or remains in the trash), since it
+// shares the history.
+func purgeTrash(backend Backend, before time.Time) error {
+	trashed, err := listTrash(backend)
+	if err != nil {
+		return err
+	}
+
+	purged := make(map[string]bool)
+	for _, t := range trashed {
+		if t.Removed.Before(before) {
+			err = backend.Remove(TrashBlob, t.blob)
+			if err != nil {
+				return err
+			}
+			purged[t.Name] = true
+		}
+	}
+
+	remaining, err := listTrash(backend)
+	if err != nil {
+		return err
+	}
+	for _, t := range remaining {
+		delete(purged, t.Name)
+	}
+
+	for name := range purged {
+		_, err = backend.Read(VaultBlob, name)
+		if !os.IsNotExist(err) {
+			continue
+		}
+
+		err = removeVaultHistory(backend, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,376 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"golang.org/x/crypto/ssh"
+)
+
+// The weakest key derivation parameters that aren't reported by VerifyVault.
+const (
+	MinPBKDF2Iterations = BaseIterations
+	MinArgon2idTime     = 1
+	MinArgon2idMemory   = 19 * 1024
+)
+
+// VerifyOptions customizes the checks performed by VerifyVault.
+type VerifyOptions struct {
+	// Open opens the vault (requesting its password, if needed) to verify
+	// its content as well.
+	Open bool
+}
+
+// VaultReport lists the problems found with a vault. Warnings describe things
+// that work, but should be addressed (e.g. an outdated file format).
+type VaultReport struct {
+	Name     string
+	Problems []string
+	Warnings []string
+}
+
+// OK reports whether no problems were found.
+func (r *VaultReport) OK() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *VaultReport) problem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+func (r *VaultReport) warning(format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// VerifyVault checks the vault file (and its session cache) for problems,
+// without opening the vault unless options.Open is set.
+func (s *store) VerifyVault(name string, options *VerifyOptions) (*VaultReport, error) {
+	if options == nil {
+		options = &VerifyOptions{}
+	}
+
+	content, err := s.backend.Read(VaultBlob, name)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VaultReport{Name: name}
+
+	permissionProblems, err := checkPermissions(s.backend, VaultBlob, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range permissionProblems {
+		report.problem("%s", p)
+	}
+
+	vf := VaultFile{}
+	err = json.Unmarshal(content, &vf)
+	if err != nil {
+		report.problem("The vault file is not valid JSON: %v", err)
+		return report, nil
+	}
+	vf.verify(name, report)
+	if vf.Key != nil {
+		verifyCalibration(vf.Key, report)
+	}
+
+	s.verifySessionCache(name, report)
+
+	if options.Open {
+		vault, _, err := s.OpenVault(name)
+		if isInsecurePermissions(err) {
+			// the permission problems have already been reported
+			return report, nil
+		}
+		if err != nil {
+			report.problem("The vault could not be opened: %v", err)
+			return report, nil
+		}
+		vault.verify(report)
+		s.verifyIncludes(vault, name, report)
+
+		_, err = s.openSessionCache(name)
+		if err != nil && !os.IsNotExist(err) && !isInsecurePermissions(err) {
+			report.warning("The session cache could not be opened (it is replaced when a session is next created): %v", err)
+		}
+	}
+
+	return report, nil
+}
+
+// verifyIncludes checks that the vaults included by the vault (and the vaults
+// they include) exist and don't include each other in a cycle. Like
+// ResolveIncludes, the included vaults are opened to follow their includes, and
+// each vault is only followed once.
+func (s *store) verifyIncludes(v *Vault, name string, report *VaultReport) {
+	visited := map[string]bool{name: true}
+	s.verifyIncludeChain(v, []string{name}, visited, report)
+}
+
+func (s *store) verifyIncludeChain(v *Vault, chain []string, visited map[string]bool, report *VaultReport) {
+	for _, include := range v.Includes {
+		cycle := false
+		for i, name := range chain {
+			if name == include {
+				err := &IncludeCycleError{Chain: append(append([]string{}, chain[i:]...), include)}
+				report.problem("%v", err)
+				cycle = true
+				break
+			}
+		}
+		if cycle || visited[include] {
+			continue
+		}
+		visited[include] = true
+
+		if !s.VaultExists(include) {
+			report.problem("The included vault '%s' does not exist", include)
+			continue
+		}
+
+		included, _, err := s.OpenVault(include)
+		if err != nil {
+			report.problem("The included vault '%s' could not be opened: %v", include, err)
+			continue
+		}
+		s.verifyIncludeChain(included, append(chain[:len(chain):len(chain)], include), visited, report)
+	}
+}
+
+func (vf *VaultFile) verify(name string, report *VaultReport) {
+	switch {
+	case vf.Version > VaultFileVersion:
+		report.problem("The vault was sealed by a newer version of vaulted (file version %d)", vf.Version)
+	case vf.Version < VaultFileVersion:
+		report.warning("The vault uses an older file format (version %d); it is upgraded when the vault is sealed", vf.Version)
+	}
+
+	if vf.Version >= 2 && vf.Name != name {
+		report.problem("The vault was sealed for a different vault (%s)", vf.Name)
+	}
+
+	if vf.Key == nil {
+		report.problem("The vault has no key configuration")
+	} else {
+		vf.Key.verify(report)
+	}
+
+	verifyEncryption(vf.Method, vf.Details, report)
+	if len(vf.Ciphertext) == 0 {
+		report.problem("The vault has no content")
+	}
+
+	if len(vf.Recipients) > 0 && !vf.usesDataKey() {
+		report.problem("The vault has recipients, but no wrapped key")
+	}
+}
+
+func (vk *VaultKey) verify(report *VaultReport) {
+	switch vk.Method {
+	case KeyMethodPBKDF2SHA512:
+		iterations := vk.Details.Int("iterations")
+		if iterations <= 0 {
+			report.problem("The key has no iteration count")
+		} else if iterations < MinPBKDF2Iterations {
+			report.warning("The key derivation iterations (%d) are below the minimum (%d); change the password to use stronger parameters", iterations, MinPBKDF2Iterations)
+		}
+
+	case KeyMethodArgon2id:
+		time := vk.Details.Int("time")
+		memory := vk.Details.Int("memory")
+		threads := vk.Details.Int("threads")
+		if time <= 0 || memory <= 0 || threads <= 0 || threads > 255 {
+			report.problem("The key has invalid argon2id parameters")
+		} else if time < MinArgon2idTime || memory < MinArgon2idMemory {
+			report.warning("The key derivation parameters (time %d, memory %d KiB) are below the minimum (time %d, memory %d KiB); change the password to use stronger parameters", time, memory, MinArgon2idTime, MinArgon2idMemory)
+		}
+
+	default:
+		report.problem("The key uses an unknown key derivation method (%s)", vk.Method)
+		return
+	}
+
+	if len(vk.Details.Bytes("salt")) < 16 {
+		report.problem("The key's salt is missing or too short")
+	}
+}
+
+// verifyCalibration warns if the key derivation parameters of the key are
+// well below those calibrated on this machine (see CalibrationFloor). A
+// damaged calibration file is ignored (it is replaced the next time a method
+// is calibrated).
+func verifyCalibration(vk *VaultKey, report *VaultReport) {
+	calibrations, err := LoadCalibrations()
+	if err != nil || calibrations[vk.Method] == nil {
+		return
+	}
+
+	calibration := calibrations[vk.Method]
+	cost := keyCost(vk.Method, vk.Details)
+	if cost > 0 && cost < keyCost(vk.Method, calibration.Details)*CalibrationFloor {
+		report.warning("The key derivation parameters (%s) are below those calibrated for this machine (%s, taking %s); change the password with --calibrate to use stronger parameters", describeKeyDetails(vk.Method, vk.Details), describeKeyDetails(vk.Method, calibration.Details), calibration.Target)
+	}
+}
+
+func describeKeyDetails(method string, details Details) string {
+	switch method {
+	case KeyMethodPBKDF2SHA512:
+		return fmt.Sprintf("%d iterations", details.Int("iterations"))
+	case KeyMethodArgon2id:
+		return fmt.Sprintf("time %d, memory %d KiB", details.Int("time"), details.Int("memory"))
+	}
+	return method
+}
+
+func verifyEncryption(method string, details Details, report *VaultReport) {
+	nonceSize := 0
+	switch method {
+	case MethodSecretbox, MethodXChaCha20Poly1305:
+		nonceSize = 24
+	case MethodAES256GCM:
+		nonceSize = 12
+	default:
+		report.problem("The vault uses an unknown encryption method (%s)", method)
+		return
+	}
+
+	if len(details.Bytes("nonce")) != nonceSize {
+		report.problem("The vault's nonce is missing or the wrong size")
+	}
+}
+
+func (s *store) verifySessionCache(name string, report *VaultReport) {
+	content, err := s.backend.Read(SessionBlob, name)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		report.problem("The session cache could not be read: %v", err)
+		return
+	}
+
+	sf, err := parseSessionFile(content)
+	if err != nil {
+		report.warning("The session cache is not valid (it is replaced when a session is next created): %v", err)
+		return
+	}
+
+	permissionProblems, err := checkPermissions(s.backend, SessionBlob, name)
+	if err != nil {
+		report.problem("The session cache could not be checked: %v", err)
+	}
+	for _, p := range permissionProblems {
+		report.problem("%s", p)
+	}
+
+	if sf.Version < SessionFileVersion {
+		report.warning("The session cache uses an older file format (version %d); it is upgraded when a session is next created", sf.Version)
+	}
+}
+
+func (v *Vault) verify(report *VaultReport) {
+	if v.AWSKey != nil {
+		v.AWSKey.verify(report)
+	}
+
+	if v.Duration != 0 {
+		maxDuration := 36 * time.Hour
+		if v.AWSKey != nil && v.AWSKey.ForgoTempCredGeneration {
+			maxDuration = 999 * time.Hour
+		}
+		if v.Duration < 15*time.Minute || v.Duration > maxDuration {
+			report.problem("The session duration (%s) must be between 15m and %s", v.Duration, maxDuration)
+		} else if v.AWSKey != nil && v.AWSKey.Role != "" && v.Duration > MaxRoleSessionDuration {
+			report.warning("The session duration (%s) is limited to %s when assuming a role", v.Duration, MaxRoleSessionDuration)
+		}
+	}
+
+	for name := range v.Vars {
+		if name == "" || strings.ContainsAny(name, "=\x00") {
+			report.problem("The variable name %q is not valid", name)
+		}
+	}
+
+	for name, commandVar := range v.CommandVars {
+		if !validVarName(name) {
+			report.problem("The variable name %q is not valid", name)
+		}
+		if strings.TrimSpace(commandVar.Command) == "" {
+			report.problem("The variable %s has no command", name)
+		}
+		if commandVar.Timeout < 0 {
+			report.problem("The timeout of the variable %s (%s) must not be negative", name, commandVar.Timeout)
+		}
+		if _, exists := v.Vars[name]; exists {
+			report.warning("The variable %s is set both to a value and a command (the command is used)", name)
+		}
+	}
+
+	_, err := interpolateVars(v.Vars, nil)
+	if err != nil {
+		report.problem("%v", err)
+	}
+
+	for name, file := range v.Files {
+		if !ValidSecretFileName(name) {
+			report.problem("The file name %q is not valid", name)
+		}
+		if file.EnvVar != "" && !validVarName(file.EnvVar) {
+			report.problem("The variable name %q of the file %s is not valid", file.EnvVar, name)
+		}
+	}
+
+	for comment, key := range v.SSHKeys {
+		_, err = ssh.ParseRawPrivateKey([]byte(key))
+		if err != nil {
+			report.problem("The SSH key %s could not be parsed: %v", comment, err)
+		}
+	}
+
+	if v.SSHOptions != nil && v.SSHOptions.VaultSigningUrl != "" {
+		_, err = url.Parse(v.SSHOptions.VaultSigningUrl)
+		if err != nil {
+			report.problem("The HashiCorp Vault signing URL is not valid: %v", err)
+		}
+	}
+}
+
+func (k *AWSKey) verify(report *VaultReport) {
+	if k.ID == "" || k.Secret == "" {
+		report.problem("The AWS key is missing its ID or secret")
+	}
+
+	if k.Region != nil && !knownRegion(*k.Region) {
+		report.warning("The AWS region %s is not a known region", *k.Region)
+	}
+
+	if strings.HasPrefix(k.MFA, "arn:") {
+		mfa, err := arn.Parse(k.MFA)
+		if err != nil || mfa.Service != "iam" || !strings.HasPrefix(mfa.Resource, "mfa/") {
+			report.problem("The MFA device %s is not a valid MFA device ARN", k.MFA)
+		}
+	}
+
+	if k.Role != "" {
+		role, err := arn.Parse(k.Role)
+		if err != nil || role.Service != "iam" || !strings.HasPrefix(role.Resource, "role/") {
+			report.problem("The role %s is not a valid role ARN", k.Role)
+		}
+	}
+}
+
+func knownRegion(region string) bool {
+	for _, partition := range endpoints.DefaultPartitions() {
+		if _, exists := partition.Regions()[region]; exists {
+			return true
+		}
+	}
+	return false
+}
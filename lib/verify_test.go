@@ -0,0 +1,130 @@
+package vaulted_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miquella/xdg"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVerifyVault(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	// the test vault was sealed with an older file format and weaker key
+	// derivation parameters, and its session cache is empty
+	report, err := store.VerifyVault("aaa", nil)
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if !report.OK() || len(report.Warnings) != 3 {
+		t.Fatalf("expected 3 warnings, got %#v", report)
+	}
+
+	vault := &vaulted.Vault{
+		Duration: 2 * time.Hour,
+		AWSKey: &vaulted.AWSKey{
+			AWSCredentials: vaulted.AWSCredentials{
+				ID:     "id",
+				Secret: "secret",
+			},
+			Role: "arn:aws:iam::123456789012:user/not-a-role",
+		},
+		Vars: map[string]string{
+			"LOOP": "${LOOP}",
+		},
+		SSHKeys: map[string]string{
+			"broken": "not a key",
+		},
+	}
+	err = store.SealVaultWithPassword(vault, "testing", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	report, err = store.VerifyVault("testing", nil)
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if !report.OK() || len(report.Warnings) != 0 {
+		t.Fatalf("expected no problems without opening the vault, got %#v", report)
+	}
+
+	report, err = store.VerifyVault("testing", &vaulted.VerifyOptions{Open: true})
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if len(report.Problems) != 3 || len(report.Warnings) != 1 {
+		t.Fatalf("expected 3 problems and 1 warning, got %#v", report)
+	}
+
+	// permissions and damaged files are reported
+	filename := filepath.Join(string(xdg.DATA_HOME), "vaulted", "testing")
+	err = os.Chmod(filename, 0666)
+	if err != nil {
+		t.Fatalf("failed to change permissions: %v", err)
+	}
+
+	vf := readTestVaultFile(t, "testing")
+	vf.Key.Details = nil
+	vf.Details = nil
+	writeTestVaultFile(t, "testing", vf)
+
+	report, err = store.VerifyVault("testing", nil)
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if len(report.Problems) != 4 || !strings.HasSuffix(report.Problems[0], "is writable by other users") {
+		t.Fatalf("expected 4 problems, got %#v", report)
+	}
+
+	_, err = store.VerifyVault("missing", nil)
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestVerifyIncludes(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	vaults := map[string]*vaulted.Vault{
+		"loop-a":  {Includes: []string{"loop-b"}},
+		"loop-b":  {Includes: []string{"loop-a"}},
+		"missing": {Includes: []string{"nonexistent"}},
+	}
+	for name, vault := range vaults {
+		err := store.SealVaultWithPassword(vault, name, "password")
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	// a cycle through another vault is detected (not just a vault that
+	// includes itself directly)
+	report, err := store.VerifyVault("loop-a", &vaulted.VerifyOptions{Open: true})
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	expected := "Vault includes itself: loop-a -> loop-b -> loop-a"
+	if len(report.Problems) != 1 || report.Problems[0] != expected {
+		t.Fatalf("expected %q, got %#v", expected, report)
+	}
+
+	report, err = store.VerifyVault("missing", &vaulted.VerifyOptions{Open: true})
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if len(report.Problems) != 1 || !strings.Contains(report.Problems[0], "'nonexistent' does not exist") {
+		t.Fatalf("expected the missing include to be reported, got %#v", report)
+	}
+}
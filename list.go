@@ -1,14 +1,33 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/miquella/vaulted/lib"
 )
 
 type List struct {
 	Active string
+	Long   bool
+	Tags   []string
+	Format string
+}
+
+type listedVault struct {
+	Name         string     `json:"name"`
+	Active       bool       `json:"active"`
+	Description  string     `json:"description,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
+	Owner        string     `json:"owner,omitempty"`
+	AWSAccountID string     `json:"aws_account_id,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	Modified     *time.Time `json:"modified,omitempty"`
 }
 
 func (l *List) Run(store vaulted.Store) error {
@@ -18,12 +37,112 @@ func (l *List) Run(store vaulted.Store) error {
 	}
 
 	sort.Strings(vaults)
+
+	// metadata is only read when it is needed
+	needsMetadata := l.Long || len(l.Tags) > 0 || l.Format == "json"
+
+	var listed []listedVault
 	for _, vault := range vaults {
-		if vault == l.Active {
-			vault = fmt.Sprintf("%s (active)", vault)
+		metadata := &vaulted.VaultMetadata{}
+		if needsMetadata {
+			metadata, err = store.GetVaultMetadata(vault)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !l.hasTags(metadata) {
+			continue
+		}
+
+		listed = append(listed, newListedVault(vault, vault == l.Active, metadata))
+	}
+
+	switch {
+	case l.Format == "json":
+		return l.printJSON(listed)
+	case l.Long:
+		return l.printLong(listed)
+	default:
+		for _, vault := range listed {
+			fmt.Println(vault.displayName())
+		}
+		return nil
+	}
+}
+
+func (l *List) hasTags(metadata *vaulted.VaultMetadata) bool {
+	for _, tag := range l.Tags {
+		if !metadata.HasTag(tag) {
+			return false
 		}
-		fmt.Println(vault)
 	}
+	return true
+}
+
+func (v listedVault) displayName() string {
+	if v.Active {
+		return fmt.Sprintf("%s (active)", v.Name)
+	}
+	return v.Name
+}
 
-	return nil
+func newListedVault(name string, active bool, metadata *vaulted.VaultMetadata) listedVault {
+	vault := listedVault{
+		Name:         name,
+		Active:       active,
+		Description:  metadata.Description,
+		Tags:         metadata.Tags,
+		Owner:        metadata.Owner,
+		AWSAccountID: metadata.AWSAccountID,
+	}
+
+	if !metadata.Created.IsZero() {
+		created := metadata.Created
+		vault.Created = &created
+	}
+	if !metadata.Modified.IsZero() {
+		modified := metadata.Modified
+		vault.Modified = &modified
+	}
+
+	return vault
+}
+
+func (l *List) printJSON(listed []listedVault) error {
+	if listed == nil {
+		listed = []listedVault{}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(listed)
+}
+
+func (l *List) printLong(listed []listedVault) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tOWNER\tACCOUNT\tTAGS\tMODIFIED\tDESCRIPTION")
+	for _, vault := range listed {
+		modified := ""
+		if vault.Modified != nil {
+			modified = vault.Modified.Local().Format("2 Jan 2006 15:04:05 MST")
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			vault.displayName(),
+			orDash(vault.Owner),
+			orDash(vault.AWSAccountID),
+			orDash(strings.Join(vault.Tags, ",")),
+			orDash(modified),
+			orDash(vault.Description),
+		)
+	}
+	return w.Flush()
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
 }
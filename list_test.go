@@ -2,7 +2,9 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/miquella/vaulted/lib"
 )
@@ -47,3 +49,76 @@ func TestListWithActive(t *testing.T) {
 		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
 	}
 }
+
+func TestListLong(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Metadata: &vaulted.VaultMetadata{
+			Description:  "Production account",
+			Tags:         []string{"prod", "aws"},
+			Owner:        "ops",
+			AWSAccountID: "123456789012",
+			Modified:     time.Unix(1136239445, 0),
+		},
+	}
+	store.Vaults["two"] = &vaulted.Vault{}
+
+	output := CaptureStdout(func() {
+		l := List{
+			Long:   true,
+			Active: "two",
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte("" +
+		"NAME          OWNER  ACCOUNT       TAGS      MODIFIED                 DESCRIPTION\n" +
+		"one           ops    123456789012  prod,aws  2 Jan 2006 22:04:05 UTC  Production account\n" +
+		"two (active)  -      -             -         -                        -\n")
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestListTagsJSON(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Metadata: &vaulted.VaultMetadata{
+			Tags:     []string{"prod", "aws"},
+			Modified: time.Unix(1136239445, 0).UTC(),
+		},
+	}
+	store.Vaults["two"] = &vaulted.Vault{
+		Metadata: &vaulted.VaultMetadata{
+			Tags: []string{"dev", "aws"},
+		},
+	}
+	store.Vaults["three"] = &vaulted.Vault{}
+
+	output := CaptureStdout(func() {
+		l := List{
+			Tags:   []string{"aws", "prod"},
+			Format: "json",
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var listed []map[string]interface{}
+	err := json.Unmarshal(output, &listed)
+	if err != nil {
+		t.Fatalf("Failed to parse output: %v\n%s", err, output)
+	}
+
+	if len(listed) != 1 || listed[0]["name"] != "one" || listed[0]["modified"] != "2006-01-02T22:04:05Z" {
+		t.Fatalf("Unexpected output:\n%s", output)
+	}
+	if _, exists := listed[0]["created"]; exists {
+		t.Fatalf("Expected an unknown creation time to be omitted:\n%s", output)
+	}
+}
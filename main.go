@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/miquella/vaulted/lib"
 	"github.com/miquella/vaulted/lib/legacy"
@@ -13,6 +15,7 @@ const (
 	EX_USAGE_ERROR     = 64
 	EX_DATA_ERROR      = 65
 	EX_UNAVAILABLE     = 69
+	EX_NO_PERMISSION   = 77
 	EX_TEMPORARY_ERROR = 79
 )
 
@@ -26,17 +29,29 @@ var (
 	ErrFileNotExist      = ErrorWithExitCode{os.ErrNotExist, EX_USAGE_ERROR}
 	ErrNoPasswordEntered = ErrorWithExitCode{errors.New("Could not get password"), EX_UNAVAILABLE}
 	ErrNoMFATokenEntered = ErrorWithExitCode{errors.New("Could not get MFA token"), EX_UNAVAILABLE}
+	ErrNoKeyfileEntered  = ErrorWithExitCode{errors.New("Could not get keyfile"), EX_UNAVAILABLE}
+	ErrVaultNotExist     = errors.New("The vault does not exist")
 )
 
 func main() {
 	command, err := ParseArgs(os.Args[1:])
 	if err == nil {
+		err = configureHistoryLimit()
+	}
+	if err == nil {
+		err = configureSettings("")
+	}
+	if err == nil {
+		err = configureInsecurePermissions()
+	}
+	if err == nil {
+		configureIdentityPath()
 		steward := NewSteward()
 		store := struct {
 			vaulted.Store
 			legacy.LegacyStore
 		}{
-			Store:       vaulted.New(steward),
+			Store:       newStore(steward),
 			LegacyStore: legacy.New(steward),
 		}
 
@@ -57,7 +72,93 @@ func main() {
 	}
 }
 
+func newStore(steward vaulted.Steward) vaulted.Store {
+	dir := VaultDir
+	if dir == "" {
+		dir = os.Getenv("VAULTED_DIR")
+	}
+
+	if dir != "" {
+		return vaulted.NewWithBackend(steward, vaulted.NewDirBackend(dir))
+	}
+
+	return vaulted.New(steward)
+}
+
+func configureHistoryLimit() error {
+	limit := os.Getenv("VAULTED_HISTORY_LIMIT")
+	if limit == "" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(limit)
+	if err != nil || n < 0 {
+		return ErrorWithExitCode{fmt.Errorf("Invalid VAULTED_HISTORY_LIMIT: %s", limit), EX_USAGE_ERROR}
+	}
+
+	vaulted.VaultHistoryLimit = n
+	return nil
+}
+
+// configureSettings applies the settings of the vault (or the global settings,
+// if vaultName is empty) that are used by the vaulted library.
+func configureSettings(vaultName string) error {
+	config, err := currentConfig()
+	if err != nil {
+		return ErrorWithExitCode{err, EX_DATA_ERROR}
+	}
+
+	durations := map[string]*time.Duration{
+		"session_duration":  &vaulted.STSDurationDefault,
+		"session_tolerance": &vaulted.SessionTolerance,
+		"role_duration":     &vaulted.MaxRoleSessionDuration,
+	}
+	for name, target := range durations {
+		setting := findSetting(name)
+		value, source := config.Lookup(setting, vaultName)
+
+		// values from the configuration file have already been validated
+		err = setting.validate(value)
+		if err != nil {
+			return ErrorWithExitCode{fmt.Errorf("Invalid %s (from %s): %s", name, source, value), EX_USAGE_ERROR}
+		}
+
+		*target, _ = time.ParseDuration(value)
+	}
+
+	return nil
+}
+
+func configureInsecurePermissions() error {
+	allow := os.Getenv("VAULTED_ALLOW_INSECURE_PERMISSIONS")
+	if allow == "" {
+		return nil
+	}
+
+	b, err := strconv.ParseBool(allow)
+	if err != nil {
+		return ErrorWithExitCode{fmt.Errorf("Invalid VAULTED_ALLOW_INSECURE_PERMISSIONS: %s", allow), EX_USAGE_ERROR}
+	}
+
+	vaulted.AllowInsecurePermissions = b
+	return nil
+}
+
+func configureIdentityPath() {
+	path := os.Getenv("VAULTED_IDENTITY")
+	if path != "" {
+		vaulted.IdentityPath = path
+	}
+}
+
 func mapErrorWithExitCode(err error) error {
+	if perr, ok := err.(*vaulted.InsecurePermissionsError); ok {
+		return ErrorWithExitCode{
+			fmt.Errorf("%s\nRun 'vaulted fix-permissions' to correct them, or set VAULTED_ALLOW_INSECURE_PERMISSIONS=1 to use them anyway", perr),
+			EX_NO_PERMISSION,
+		}
+	}
+
 	switch err {
 	case vaulted.ErrIncorrectPassword:
 		return ErrorWithExitCode{vaulted.ErrIncorrectPassword, EX_TEMPORARY_ERROR}
@@ -65,6 +166,30 @@ func mapErrorWithExitCode(err error) error {
 		return ErrorWithExitCode{vaulted.ErrInvalidKeyConfig, EX_DATA_ERROR}
 	case vaulted.ErrInvalidEncryptionConfig:
 		return ErrorWithExitCode{vaulted.ErrInvalidEncryptionConfig, EX_DATA_ERROR}
+	case vaulted.ErrVaultNameMismatch:
+		return ErrorWithExitCode{vaulted.ErrVaultNameMismatch, EX_DATA_ERROR}
+	case vaulted.ErrVaultTampered:
+		return ErrorWithExitCode{vaulted.ErrVaultTampered, EX_DATA_ERROR}
+	case vaulted.ErrVaultVersionNotFound:
+		return ErrorWithExitCode{vaulted.ErrVaultVersionNotFound, EX_USAGE_ERROR}
+	case vaulted.ErrVaultNotInTrash:
+		return ErrorWithExitCode{vaulted.ErrVaultNotInTrash, EX_USAGE_ERROR}
+	case vaulted.ErrVaultExists:
+		return ErrorWithExitCode{vaulted.ErrVaultExists, EX_USAGE_ERROR}
+	case vaulted.ErrIncorrectKeyfile:
+		return ErrorWithExitCode{vaulted.ErrIncorrectKeyfile, EX_TEMPORARY_ERROR}
+	case vaulted.ErrKeyfileRequired:
+		return ErrorWithExitCode{vaulted.ErrKeyfileRequired, EX_UNAVAILABLE}
+	case vaulted.ErrAgentNotRunning:
+		return ErrorWithExitCode{vaulted.ErrAgentNotRunning, EX_UNAVAILABLE}
+	case vaulted.ErrAgentAlreadyRunning:
+		return ErrorWithExitCode{vaulted.ErrAgentAlreadyRunning, EX_USAGE_ERROR}
+	case vaulted.ErrInvalidRecipient:
+		return ErrorWithExitCode{vaulted.ErrInvalidRecipient, EX_USAGE_ERROR}
+	case vaulted.ErrRecipientNotFound:
+		return ErrorWithExitCode{vaulted.ErrRecipientNotFound, EX_USAGE_ERROR}
+	case vaulted.ErrInvalidIdentity:
+		return ErrorWithExitCode{vaulted.ErrInvalidIdentity, EX_DATA_ERROR}
 	default:
 		return err
 	}
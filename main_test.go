@@ -2,9 +2,11 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/miquella/vaulted/lib"
@@ -59,16 +61,26 @@ func WriteStdin(b []byte, f func()) {
 
 func NewTestStore() *TestStore {
 	return &TestStore{
-		Passwords: make(map[string]string),
-		Vaults:    make(map[string]*vaulted.Vault),
-		Sessions:  make(map[string]*vaulted.Session),
+		Passwords:   make(map[string]string),
+		Vaults:      make(map[string]*vaulted.Vault),
+		Sessions:    make(map[string]*vaulted.Session),
+		SealOptions: make(map[string]vaulted.SealOptions),
+		History:     make(map[string][]*vaulted.Vault),
+		Trash:       make(map[string]*vaulted.Vault),
+		Synced:      make(map[string]vaulted.SyncOptions),
+		Recipients:  make(map[string][]string),
 	}
 }
 
 type TestStore struct {
-	Passwords map[string]string
-	Vaults    map[string]*vaulted.Vault
-	Sessions  map[string]*vaulted.Session
+	Passwords   map[string]string
+	Vaults      map[string]*vaulted.Vault
+	Sessions    map[string]*vaulted.Session
+	SealOptions map[string]vaulted.SealOptions
+	History     map[string][]*vaulted.Vault
+	Trash       map[string]*vaulted.Vault
+	Synced      map[string]vaulted.SyncOptions
+	Recipients  map[string][]string
 
 	LegacyPassword     string
 	LegacyEnvironments map[string]legacy.Environment
@@ -98,6 +110,17 @@ func (ts TestStore) VaultExists(name string) bool {
 	return exists
 }
 
+func (ts TestStore) GetVaultMetadata(name string) (*vaulted.VaultMetadata, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	if ts.Vaults[name].Metadata == nil {
+		return &vaulted.VaultMetadata{}, nil
+	}
+	return ts.Vaults[name].Metadata.Clone(), nil
+}
+
 func (ts TestStore) ListVaults() ([]string, error) {
 	var vaults []string
 	for name := range ts.Vaults {
@@ -111,12 +134,24 @@ func (ts TestStore) SealVault(vault *vaulted.Vault, name string) error {
 }
 
 func (ts TestStore) SealVaultWithPassword(vault *vaulted.Vault, name, password string) error {
+	if previous, exists := ts.Vaults[name]; exists {
+		ts.History[name] = append(ts.History[name], previous)
+	}
+
 	ts.Passwords[name] = password
 	ts.Vaults[name] = cloneVault(vault)
 
 	return nil
 }
 
+func (ts TestStore) SealVaultWithOptions(vault *vaulted.Vault, name, password string, options *vaulted.SealOptions) error {
+	if options != nil {
+		ts.SealOptions[name] = *options
+	}
+
+	return ts.SealVaultWithPassword(vault, name, password)
+}
+
 func (ts TestStore) OpenVault(name string) (*vaulted.Vault, string, error) {
 	return ts.OpenVaultWithPassword(name, "prompted password")
 }
@@ -129,6 +164,28 @@ func (ts TestStore) OpenVaultWithPassword(name, password string) (*vaulted.Vault
 	return cloneVault(ts.Vaults[name]), ts.Passwords[name], nil
 }
 
+func (ts TestStore) ResolveIncludes(vault *vaulted.Vault, name string) error {
+	vault.Included = nil
+	for _, include := range vault.Includes {
+		if include == name {
+			return &vaulted.IncludeCycleError{Chain: []string{name, include}}
+		}
+
+		included, _, err := ts.OpenVault(include)
+		if err != nil {
+			return err
+		}
+
+		err = ts.ResolveIncludes(included, include)
+		if err != nil {
+			return err
+		}
+		vault.Included = append(vault.Included, included)
+	}
+
+	return nil
+}
+
 func (ts TestStore) RemoveVault(name string) error {
 	if !ts.VaultExists(name) {
 		return os.ErrNotExist
@@ -140,7 +197,161 @@ func (ts TestStore) RemoveVault(name string) error {
 	return nil
 }
 
-func (ts TestStore) GetSession(vault *vaulted.Vault, name, password string) (*vaulted.Session, error) {
+func (ts TestStore) TrashVault(name string) error {
+	if !ts.VaultExists(name) {
+		return os.ErrNotExist
+	}
+
+	ts.Trash[name] = ts.Vaults[name]
+	delete(ts.Vaults, name)
+
+	return nil
+}
+
+func (ts TestStore) ListTrash() ([]vaulted.TrashedVault, error) {
+	var names []string
+	for name := range ts.Trash {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var trashed []vaulted.TrashedVault
+	for _, name := range names {
+		trashed = append(trashed, vaulted.TrashedVault{
+			Name:    name,
+			Removed: time.Unix(1136239445, 0).UTC(),
+		})
+	}
+	return trashed, nil
+}
+
+func (ts TestStore) RestoreTrashedVault(name string) error {
+	if ts.VaultExists(name) {
+		return vaulted.ErrVaultExists
+	}
+
+	vault, exists := ts.Trash[name]
+	if !exists {
+		return vaulted.ErrVaultNotInTrash
+	}
+
+	ts.Vaults[name] = vault
+	delete(ts.Trash, name)
+
+	return nil
+}
+
+func (ts TestStore) PurgeTrash(before time.Time) error {
+	for name := range ts.Trash {
+		if time.Unix(1136239445, 0).Before(before) {
+			delete(ts.Trash, name)
+		}
+	}
+
+	return nil
+}
+
+func (ts TestStore) ListVaultVersions(name string) ([]vaulted.VaultVersion, error) {
+	var versions []vaulted.VaultVersion
+	for i := len(ts.History[name]); i > 0; i-- {
+		versions = append(versions, vaulted.VaultVersion{
+			Version: i,
+			Sealed:  time.Unix(1136239445, 0).UTC(),
+		})
+	}
+	return versions, nil
+}
+
+func (ts TestStore) RestoreVaultVersion(name string, version int) error {
+	if version < 1 || version > len(ts.History[name]) {
+		return vaulted.ErrVaultVersionNotFound
+	}
+
+	return ts.SealVaultWithPassword(ts.History[name][version-1], name, ts.Passwords[name])
+}
+
+func (ts TestStore) SyncVaults(options *vaulted.SyncOptions) (*vaulted.SyncResult, error) {
+	ts.Synced[options.Remote] = *options
+
+	return &vaulted.SyncResult{}, nil
+}
+
+func (ts TestStore) FixPermissions() ([]vaulted.PermissionProblem, []vaulted.PermissionProblem, error) {
+	return nil, nil, nil
+}
+
+func (ts TestStore) SealBundle(bundle *vaulted.Bundle, name string) ([]byte, error) {
+	return json.Marshal(bundle)
+}
+
+func (ts TestStore) OpenBundle(content []byte, name string) (*vaulted.Bundle, string, error) {
+	bundle := &vaulted.Bundle{}
+	err := json.Unmarshal(content, bundle)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return bundle, "prompted open password", nil
+}
+
+func (ts TestStore) VerifyVault(name string, options *vaulted.VerifyOptions) (*vaulted.VaultReport, error) {
+	vault, exists := ts.Vaults[name]
+	if !exists {
+		return nil, os.ErrNotExist
+	}
+
+	report := &vaulted.VaultReport{Name: name}
+	if options != nil && options.Open && vault.AWSKey != nil && vault.AWSKey.Secret == "" {
+		report.Problems = append(report.Problems, "The AWS key is missing its ID or secret")
+	}
+	return report, nil
+}
+
+func (ts TestStore) Identity() (string, error) {
+	return "identity", nil
+}
+
+func (ts TestStore) ListRecipients(name string) ([]string, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	return ts.Recipients[name], nil
+}
+
+func (ts TestStore) AddRecipients(name string, recipients []string) error {
+	if !ts.VaultExists(name) {
+		return os.ErrNotExist
+	}
+
+	ts.Recipients[name] = append(ts.Recipients[name], recipients...)
+
+	return nil
+}
+
+func (ts TestStore) RemoveRecipients(name string, recipients []string) error {
+	if !ts.VaultExists(name) {
+		return os.ErrNotExist
+	}
+
+	for _, recipient := range recipients {
+		found := false
+		for i, existing := range ts.Recipients[name] {
+			if existing == recipient {
+				ts.Recipients[name] = append(ts.Recipients[name][:i], ts.Recipients[name][i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return vaulted.ErrRecipientNotFound
+		}
+	}
+
+	return nil
+}
+
+func (ts TestStore) GetSession(vault *vaulted.Vault, name string) (*vaulted.Session, error) {
 	if !ts.VaultExists(name) {
 		return nil, os.ErrNotExist
 	}
@@ -175,11 +386,15 @@ func (ts TestStore) GetSession(vault *vaulted.Vault, name, password string) (*va
 	} else {
 		s.Name = name
 
-		for key, value := range vault.Vars {
+		if vault.AWSKey != nil {
+			s.Role = vault.AWSKey.Role
+		}
+
+		for key, value := range vault.MergedVars() {
 			s.Vars[key] = value
 		}
 
-		for key, value := range vault.SSHKeys {
+		for key, value := range vault.MergedSSHKeys() {
 			s.SSHKeys[key] = value
 		}
 
@@ -191,7 +406,48 @@ func (ts TestStore) GetSession(vault *vaulted.Vault, name, password string) (*va
 	return s, nil
 }
 
-func (ts TestStore) CreateSession(vault *vaulted.Vault, name, password string) (*vaulted.Session, error) {
+func (ts TestStore) AssumeRole(vault *vaulted.Vault, name string, session *vaulted.Session, role string) (*vaulted.Session, error) {
+	s := session.Clone()
+	s.ActiveRole = role
+	s.Role = ""
+
+	return s, nil
+}
+
+func (ts TestStore) ListSessionCaches() ([]string, error) {
+	var names []string
+	for name := range ts.Sessions {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (ts TestStore) ListSessions(vault *vaulted.Vault, name string) ([]vaulted.CachedSession, error) {
+	session, exists := ts.Sessions[name]
+	if !exists {
+		return nil, nil
+	}
+
+	return []vaulted.CachedSession{
+		{
+			Role:       session.ActiveRole,
+			Expiration: session.Expiration,
+			Current:    true,
+			Reusable:   true,
+		},
+	}, nil
+}
+
+func (ts TestStore) RemoveSessionCache(name string) error {
+	if _, exists := ts.Sessions[name]; !exists {
+		return os.ErrNotExist
+	}
+
+	delete(ts.Sessions, name)
+	return nil
+}
+
+func (ts TestStore) CreateSession(vault *vaulted.Vault, name string) (*vaulted.Session, error) {
 	if !ts.VaultExists(name) {
 		return nil, os.ErrNotExist
 	}
@@ -206,11 +462,15 @@ func (ts TestStore) CreateSession(vault *vaulted.Vault, name, password string) (
 
 	s.Name = name
 
-	for key, value := range vault.Vars {
+	if vault.AWSKey != nil {
+		s.Role = vault.AWSKey.Role
+	}
+
+	for key, value := range vault.MergedVars() {
 		s.Vars[key] = value
 	}
 
-	for key, value := range vault.SSHKeys {
+	for key, value := range vault.MergedSSHKeys() {
 		s.SSHKeys[key] = value
 	}
 	return s, nil
@@ -250,5 +510,27 @@ func cloneVault(vault *vaulted.Vault) *vaulted.Vault {
 		newVault.SSHOptions = vault.SSHOptions
 	}
 
+	if vault.CommandVars != nil {
+		newVault.CommandVars = make(map[string]*vaulted.CommandVar)
+		for key, commandVar := range vault.CommandVars {
+			c := *commandVar
+			newVault.CommandVars[key] = &c
+		}
+	}
+
+	if vault.Files != nil {
+		newVault.Files = make(map[string]*vaulted.SecretFile)
+		for name, file := range vault.Files {
+			f := *file
+			newVault.Files[name] = &f
+		}
+	}
+
+	if len(vault.Includes) > 0 {
+		newVault.Includes = append([]string{}, vault.Includes...)
+	}
+
+	newVault.Metadata = vault.Metadata.Clone()
+
 	return newVault
 }
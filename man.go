@@ -1,17 +1,29 @@
 // Code generated by go-bindata.
 // sources:
 // doc/man/vaulted-add.1
+// doc/man/vaulted-agent.1
+// doc/man/vaulted-config.1
 // doc/man/vaulted-cp.1
 // doc/man/vaulted-dump.1
 // doc/man/vaulted-edit.1
 // doc/man/vaulted-env.1
 // doc/man/vaulted-exec.1
+// doc/man/vaulted-export.1
+// doc/man/vaulted-fix-permissions.1
+// doc/man/vaulted-history.1
+// doc/man/vaulted-import.1
 // doc/man/vaulted-load.1
 // doc/man/vaulted-ls.1
 // doc/man/vaulted-passwd.1
+// doc/man/vaulted-recipients.1
+// doc/man/vaulted-restore.1
 // doc/man/vaulted-rm.1
+// doc/man/vaulted-session.1
 // doc/man/vaulted-shell.1
+// doc/man/vaulted-sync.1
+// doc/man/vaulted-trash.1
 // doc/man/vaulted-upgrade.1
+// doc/man/vaulted-verify.1
 // doc/man/vaulted.1
 // DO NOT EDIT!
 
@@ -80,7 +92,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _vaultedAdd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\x41\x4e\xc3\x30\x10\x45\xf7\x39\xc5\x3f\x00\x8d\xc4\x11\xa0\x54\x4a\x16\xa4\x56\x5d\x16\x48\xde\x0c\xf1\x18\x2c\xb5\xe3\x92\x4c\x1d\x71\x7b\x14\xb7\x20\x14\xa1\xae\xdf\x9b\x37\xfa\xf5\xbe\x41\xa6\xf3\x41\xd9\xbb\x15\x79\x8f\xfb\xaa\xb6\x0d\xba\x87\xe7\x4d\x55\x1b\x53\x5d\x19\x66\xe4\x56\x88\xa2\x3c\x50\xaf\x31\xf3\xe1\x0b\xfd\xc0\xa4\x3c\x42\x3f\x18\x7d\x12\x65\x51\xa4\x00\x82\xf0\x74\xa9\x96\x98\x7d\xed\xb6\xc6\xb6\xb6\x04\x5d\x78\x74\x61\xfd\x27\xeb\xc2\x0e\x2e\xb4\x42\x47\x76\xc1\xfc\xe3\x5c\xbe\x2c\xb5\xb7\x61\xa1\x09\x4f\x4b\xc7\x36\x78\xda\xd8\xf5\xae\x35\xfb\x76\xdb\x95\xb4\x3d\xd1\x24\x23\x48\x7e\xa7\x64\xc6\x31\x79\x46\x48\x03\xd8\x47\x8d\xf2\x7e\x63\x50\x5d\x2a\x2f\xa7\x24\xf8\x3c\x47\x9d\xed\xbb\xa2\xcf\xc6\xcf\x49\x1c\x31\x52\x66\x0f\x4d\x85\x5d\x2f\xbf\x03\x00\x00\xff\xff\xb4\x37\x77\xb9\x6c\x01\x00\x00")
+var _vaultedAdd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x92\x4f\x6f\xdb\x30\x0c\xc5\xef\xfe\x14\x3c\xed\x0f\x10\x1b\x6d\x86\xee\x34\x0c\xe8\xda\x0e\xf1\x61\xad\x51\x77\x87\x61\xda\x81\x91\xa8\x58\x88\x4d\xb9\x12\x93\xd4\xdf\x7e\x90\xe3\xf4\xdf\xba\xf6\x2a\xf2\x3d\xfe\xf8\xa8\xe2\x66\x01\x5b\xdc\xb4\x42\x46\xe5\x68\x0c\x1c\x67\x45\xbd\x80\xcb\xd3\x1f\x17\x59\x51\x55\xd9\x54\x83\x54\x52\x39\x38\x16\x0a\xa8\xc5\x6d\xa9\x1d\x40\x07\x42\xa1\x08\xd2\x10\x68\xcf\x42\x2c\xe0\x2d\x20\x30\xed\xf6\xae\xa3\x59\xfd\xeb\xf2\xaa\xaa\xcb\x7a\x34\x54\xf6\x9b\xb2\x67\x8f\x6c\x95\xbd\x06\x65\x4b\xc6\x8e\x94\xad\xe0\xb7\xb2\xe5\x55\x75\x53\x5e\x5d\xd6\xca\x56\x7f\x5e\xd0\xec\xa7\xbe\x25\x5b\x86\x67\x32\xa6\xdd\x5b\x9a\x7a\x01\xe7\x17\xf5\xd9\x75\x39\x3e\x8e\xa3\xeb\x1e\x77\x1c\x01\xf9\x7e\xf5\x2d\x41\xe7\x0d\x81\xf5\x01\xc8\x38\x71\xbc\x7a\x25\x80\x62\x74\xf9\xd9\x7b\x86\xdb\x8d\x93\xd4\x3d\x1b\xdb\x53\xc7\x41\xe2\x22\x44\xdc\x92\x01\xf1\x63\xed\xa0\xac\x17\x30\xf1\x65\xc5\xcd\x21\x06\x95\xab\x7c\x6d\x6c\xda\xe5\x4b\xbf\x5c\x1b\x3b\x57\x79\x6c\xf0\xe4\x78\x3e\xc3\xb0\xf2\x3c\x77\xe6\x6b\x56\xf7\xa4\x9d\x75\xd3\x6d\xd6\x34\x80\xa1\xe0\xb6\x28\xce\x33\x74\x24\x8d\x37\xb0\x89\xfb\x89\x63\x85\xc6\x46\x62\x1d\x86\x7e\x6c\x4a\x1a\x1b\x7c\x97\xa5\xf7\x1e\x63\xdc\xf9\x60\x0a\x38\x27\x9b\xe0\x62\x12\xee\x79\x9e\x32\x28\x7b\xad\xde\x15\x59\x51\x1e\x70\x0f\x4c\x89\xd7\x45\x40\xe8\xa8\xf3\x61\x50\x79\x83\xc1\x1c\x50\xa4\xc1\x7d\x0a\x6e\xc5\xce\x3a\x8d\x2c\xed\x00\x9d\x0f\x04\x81\xa2\x8b\x82\x2c\x20\x3e\x5b\x86\x8d\x8c\xc9\x6b\x02\x14\x41\xbd\x4e\x0b\x22\xbf\x8a\xf2\x24\x39\xed\xfa\x86\xc2\x18\x5e\x24\x1d\x48\x96\xfe\x6e\x76\xa7\x1b\xd4\x0d\xce\x8f\x54\xde\xfb\x76\x38\xfe\x74\x74\x32\x43\x8a\x2a\x9f\x9f\x7c\x56\xf9\x4a\x77\xcf\x03\x7d\x94\xd3\xb3\x30\xa7\xca\xc3\x19\xe1\x03\xb2\x01\x27\x11\x22\xc5\xe8\x3c\x67\x1a\x75\x43\x1f\x5f\x8a\xf2\x9e\xe8\x9f\x14\x5f\x20\x4c\x3b\x24\xeb\x29\xe6\xc7\xb8\x63\x29\x10\x9c\x5e\x9c\x9e\x4f\x80\x71\x9f\x31\x9a\xf4\x63\x3d\x63\xdb\x0e\x19\x6e\xa4\x21\x16\xa7\x51\xe8\x01\xf8\x7d\x4c\x12\x34\x28\x58\xfc\xcf\xbc\xc3\x01\x96\xe9\x36\xb7\x1b\x17\xc8\x80\xe3\x8c\x78\xeb\x82\xe7\x8e\x58\xa6\x59\x1d\xb2\x49\xce\xdf\xcb\xaa\x56\x39\xf6\x7d\xf0\xe9\x8f\x63\xbb\xf2\xc1\x49\xd3\xc5\x22\xfb\x3b\x00\x83\x0c\x4d\x6b\x7e\x04\x00\x00")
 
 func vaultedAdd1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -100,7 +112,47 @@ func vaultedAdd1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedCp1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xc1\x6a\xeb\x30\x10\x45\xf7\xfe\x8a\xbb\x7a\xab\xc4\xf0\x3e\x21\x4d\x0c\x31\xb4\x8e\x89\xd2\x86\x82\xa0\x28\xf6\x08\x0b\x1c\xc9\x95\x14\x9b\xfc\x7d\x91\xa2\x24\x14\xda\x2e\xda\x9d\xf1\x5c\xdd\x73\x66\xf2\xdd\x1a\xa3\x38\xf5\x9e\x5a\x3e\x6f\x06\xfc\xcf\x72\xb6\x46\xb5\x78\x2a\xb2\xbc\xae\xb3\x34\x42\x33\x80\xcf\xd1\x98\x41\x91\x83\xef\x08\x8d\xd1\x9e\xb4\x87\x91\x10\x97\x02\x08\xdd\xc2\x89\x91\x1c\x94\x87\x70\x10\xd0\x34\xa5\xd9\xa4\x7c\x97\x7e\x0c\xc2\xb9\xc9\xd8\x36\x82\xd8\x6b\xb5\xa9\x59\xc9\x22\x8c\xcb\x07\x2e\x97\x77\x24\x97\x5b\x70\x59\x9a\xbe\xe5\xb2\x0e\x5f\x9a\x26\x2e\xeb\xaf\xb2\x66\x38\x7f\x9b\x66\x6b\xac\x0a\xb6\xdc\x96\xf5\xae\xdc\x54\xf1\xf5\x32\xd9\x2b\x1d\x97\xb9\x85\x93\xad\x72\x68\x2c\x89\xd0\x6c\x2c\x2c\x0d\xbd\x68\xa8\xc5\xe1\x7c\x5b\x5b\x5a\x73\xbc\xd3\xf8\xbf\x3c\xd6\x96\x32\xd5\x05\xb7\x97\xc5\xf3\xe3\xae\x58\xbd\xd5\x0b\xc6\xf6\x9b\xed\x2a\xf8\x91\x1e\x95\x35\xfa\x18\x2a\x46\x61\x95\x38\xf4\x14\x68\x8e\xfc\x2c\x5c\x6d\x52\x7d\x8f\x03\xe1\xe4\xa8\x0d\x27\xf4\x1d\x65\xd7\x7b\x41\x1a\x7b\x47\xce\x60\x7c\x47\x76\x52\x8e\x22\xf3\x96\xba\x56\x58\x7a\x3f\x91\x0b\x2b\x8c\x4a\xc4\x88\xf7\xe7\x1f\x34\xab\x62\xff\x17\xd5\xec\x93\x44\x52\xbd\x1c\xf5\xb7\xaa\x1f\x01\x00\x00\xff\xff\xac\xf1\xb5\x97\x9b\x02\x00\x00")
+var _vaultedAgent1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x54\x5d\x8f\xea\x36\x10\x7d\xcf\xaf\x98\x87\xea\xee\xae\x04\xb9\xba\x0f\x95\xaa\xbe\xdd\x2e\xe8\x82\xaa\x5d\x10\xa1\x5f\x6a\xaa\x95\xc1\x63\x62\xe1\xd8\x91\xc7\x81\xcd\xbf\xaf\xfc\x11\x48\x28\xbd\xda\xb7\x28\x9e\x8f\x33\x67\xce\x9c\x7c\xbb\x80\x13\x6b\x95\x43\x5e\x4e\xd9\x01\xb5\x83\x2f\x59\x5e\x2c\xe0\xf5\xeb\xcb\x3c\xcb\xd7\xeb\x2c\xbd\x42\x7c\x2c\xa7\x50\x19\xc5\x09\x5a\xad\xcc\xfe\x88\x3c\x66\xc3\x11\x3b\x02\xa9\xa1\xc6\xda\xd8\x2e\x54\x28\xfe\x7a\x5d\xad\x8b\x65\x11\xaa\x94\xe2\x97\x52\x3c\x8f\x6b\x91\x63\xd6\x95\x62\x03\x7f\x97\x62\xb9\x5a\x6f\x97\xab\xd7\xa2\x14\xeb\x7f\xbe\x93\x60\x9a\x52\x6c\xfe\xf7\xdd\x23\xfa\xde\x3b\x39\xe6\x5a\x0a\x11\xc5\x02\x66\xf3\xe2\x79\xb3\x0c\x6d\x43\xc6\xb6\xc2\x14\x27\x09\x98\x06\xd3\x38\x69\x34\x53\xb0\x63\xfb\xe3\xc1\x9a\x56\x73\x68\xac\xd9\x23\x11\xb8\x8a\xb9\x44\x84\xab\x30\x4e\x6f\xc4\x85\x94\x48\xda\x80\x90\x1c\x56\x7a\x8f\xc0\x12\x5b\x15\x23\xd8\x21\xfa\x1e\xa8\x91\xc3\x59\xba\x0a\xa4\x23\x68\x18\xd1\xd9\x58\x3e\x01\xd7\xa3\xc9\x8e\x88\x4d\x6c\x13\x92\x1f\xc8\xb7\x9b\x00\x19\x50\xcc\xa1\x05\xa9\x4f\x66\xcf\x3c\xd6\x80\xe1\xf7\x34\xf2\x23\xe6\x87\x1c\xc6\x34\xa0\x3e\x79\xbe\x8d\xbd\xa1\x87\x2a\x54\xaa\x14\x9b\xa7\x00\xe8\xda\x2b\x00\x33\xad\xf3\x73\xd7\x8d\x93\xfa\x00\xc2\xd8\xf0\xde\x23\x85\x47\xa6\x79\xd6\xc7\x71\xb4\xf2\xe4\xc3\x12\x2b\x20\xac\xa9\xc7\xf1\xec\xc0\xa4\x7e\xca\x03\xe5\xbf\x7a\xde\x98\x45\xa8\x50\x71\x68\xb5\x93\xca\x07\x77\x50\xb1\x13\xea\x07\x17\x49\x6a\x09\xf9\xa5\xaf\xe4\x0a\xc1\xc9\x1a\x4d\xeb\x26\xd7\xe0\x2c\x44\x86\x32\x7d\x64\xcd\xde\x65\xdd\xd6\xa0\xa4\x40\x9f\x30\x01\x63\xaf\xb4\xfa\x25\x27\x05\x1b\x1b\x94\xd5\x20\xcf\xa1\x47\x94\x31\x45\x06\xce\xb2\x41\x0e\x52\x8c\xd3\x1c\xda\x5a\x6a\x76\x25\x39\xac\x2f\x32\x5a\x2c\xbf\x6d\xe7\x9b\x17\xcf\x65\x0e\x57\x45\x69\x3c\xa1\xcd\xce\x56\x3a\xa4\xa8\x16\x67\x80\x4b\x3a\xe6\x37\xd2\x53\x92\x1c\xfa\x45\x6a\x60\x40\x1e\x9f\x8b\x62\x93\xfe\x9f\xea\x80\xed\xbd\x00\xe5\x4e\x21\xec\xba\x80\x6b\xdf\x5a\xeb\x53\x5b\x42\xfb\x73\x5a\xec\x0f\x7f\xce\xbe\xbd\x6d\x7e\x7b\xdd\x2e\x5f\xe6\x6f\xb3\xe5\xe6\x73\xda\xf4\xe7\xd0\x25\xa7\x78\x28\xf0\x68\x2c\x0c\x12\x9e\xbf\x3e\x2f\xe6\x6f\x8b\xd5\xcb\xfc\x7e\x7c\x26\x45\x0a\xbf\x29\xef\x6b\x49\x02\x6d\x1c\x10\xba\xa7\xfc\x22\x42\x8b\xa2\x25\x0c\xd3\xb6\x84\x01\x6e\x1a\xea\x31\xb0\xce\xac\xcb\x2e\xdc\x3e\xf5\x4c\xa7\x10\x63\xc3\x4d\x70\x69\x71\xef\x8c\xed\x02\x07\x67\x7f\x2f\xbb\x0e\x98\x36\xae\x42\x1b\xa6\x0e\x91\x94\x8d\xa9\xb9\x3e\x53\x9e\xe5\xdb\xde\x11\x7a\xd3\xc9\x0a\xff\x41\xc3\xcd\x46\xe1\x5f\xaf\x7d\x9c\x16\xad\xe7\x0f\xd9\x20\x01\x53\xca\x9f\xda\xe5\xf4\x83\xee\x76\xdd\xa0\x18\xd3\x3c\x88\x6a\xd0\x60\x58\xae\x77\xaa\x0f\x96\x1b\x4a\x29\xda\x81\x6d\xb5\x96\xfa\x10\x6c\x20\x9a\x4d\x96\xbc\x84\x09\x87\xf6\xcc\x2c\x1f\x5c\xd6\x18\x9a\x3f\xc0\x1b\x4a\x92\x2d\xce\x24\x35\x8a\x75\x03\xd0\x0f\xe4\x37\xea\x2f\x9f\xc2\x4c\x5e\x9f\x03\x33\x22\x38\x57\x86\x12\xec\x41\xbb\xeb\x52\x27\xc0\x94\xd1\x87\x78\x23\xe7\x0a\x35\x20\xdb\x57\x3e\x01\xf0\xbd\x91\x16\xfd\x76\x8a\x05\x24\xff\x1f\xc0\x2a\xa7\xe5\xd4\x5f\x7b\x39\x4d\xe7\xee\x55\x56\x8a\x25\x6f\x6d\x70\xbb\x52\xac\x13\x7f\xf1\xa0\x82\x25\xdf\xf5\x8d\x71\x52\xf9\x29\x87\x19\x8a\x08\xdf\x99\xa4\xe8\x2f\x3f\xd6\xa5\xd8\x94\x9f\xf2\x1b\x04\x35\x7b\x2f\xa7\xbd\x85\x7c\x10\x01\x8c\xcd\x68\x9c\x31\x01\x3c\xa1\x4e\x52\xef\x02\x69\x32\x60\xcd\xb3\xff\xa2\xfa\xa9\xba\x0b\x4a\x18\x8b\x51\xa3\x7e\x6b\x9b\x56\xdf\xd1\xf1\x35\x06\xa4\x26\x87\x8c\x7b\x8d\x05\xf9\x7b\x83\x96\x77\x05\xff\xef\x00\xab\x21\x7f\x7f\x0f\x08\x00\x00")
+
+func vaultedAgent1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedAgent1,
+		"vaulted-agent.1",
+	)
+}
+
+func vaultedAgent1() (*asset, error) {
+	bytes, err := vaultedAgent1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-agent.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedConfig1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x57\xdd\x6f\xdb\x36\x10\x7f\xe7\x5f\x71\x08\x86\x36\x01\x1c\xb9\x69\xd6\x01\xeb\xd0\x87\x34\x51\x63\x63\x8e\x6d\x58\x6e\xbb\x21\x0a\x0c\x5a\x3a\x59\x44\x24\x52\x23\x29\xbb\x7e\xd9\xdf\x3e\xf0\x43\xb6\xfc\xd5\x14\xdd\x9b\x45\xde\x1d\xef\x7e\xf7\xbb\x0f\x07\xd3\x1e\x2c\x69\x5d\x68\x4c\xe3\xcb\x44\xf0\x8c\x2d\xe0\x8a\x04\x51\x0f\x86\x37\x0f\x21\x09\xc6\x63\xe2\xaf\xc1\xdf\xc6\x97\x50\x52\x4e\x17\xa8\x40\xe7\xe8\x4f\x6b\x49\x35\x13\x1c\x32\x56\xa0\xd5\x8e\xfe\x1e\x8e\xc6\x51\x3f\xb2\x16\xe2\xec\x63\x9c\xdd\xee\xd9\x29\x54\x9c\x4d\xe0\xd1\xdd\xc5\x97\xf1\xa5\xbd\x37\x67\x71\xd6\xe7\xb4\xc4\x38\x1b\x3f\x91\x60\x2e\x4f\xa8\x33\xa5\x7f\xc8\xc0\xa9\xf7\x17\xd8\xc8\x2a\xd4\x9a\xf1\x45\x9c\x8d\x7f\xde\x9a\x3a\x66\x2d\xce\xfa\x4b\x5a\xd4\xf8\xbf\x2c\xd7\x5c\xfd\x94\xa7\x51\x0f\xee\xc2\xe8\x76\xd2\x1f\x4f\xfb\xa3\xa1\xb5\x3f\x3d\x9a\x2f\xc8\x45\x91\xba\x64\xa6\x98\x19\x5b\x0a\x6a\x85\x29\xcc\xd7\xf0\xc5\xf9\x12\x40\xe4\x5e\x56\x90\x50\x0e\x73\x34\xf1\x92\x45\x21\xe6\xb4\x28\xd6\x1d\x10\x12\x32\x21\x81\x82\x62\x7c\x51\xa0\x63\x14\x9c\x8b\x25\x4a\xc9\x52\xc6\x17\xd6\xba\x93\x07\x1f\x84\xd5\xd0\x39\xd5\x4e\xfa\x22\x20\xc1\xb4\x81\xc0\x71\xa3\x0b\xfe\xcb\xa5\x9a\x0c\x98\xd2\xce\x4f\x8b\x2a\x88\x0c\x90\x26\x79\x63\xb0\x03\xb4\x10\x7c\x01\x2b\xa6\x73\x58\xe5\x28\xb1\x25\x9b\x88\x12\x15\x64\x52\x94\x01\x7c\x65\x3a\x17\xb5\x26\x87\x08\x76\xac\x86\x6a\x62\x6d\x1e\xb0\xd7\xc0\xf8\x09\xc2\x03\x95\x68\xf9\x88\x29\x50\x45\x56\x58\x14\xed\x60\x8e\x11\x8d\x8c\x6a\x5d\xd5\xfb\xd1\xec\x88\xc4\xaf\xda\x46\x5e\xe2\x17\x89\x50\xab\xbd\x6b\x2d\xda\x12\xa7\xfd\x0f\x60\xba\xf1\x82\x29\x48\x72\x4c\x9e\x31\x25\x73\xcc\x84\xc7\xd0\x46\x69\xaf\x28\x5f\x60\xda\x76\xec\x38\x3d\xc9\x04\x4b\xb1\xc4\x7d\x8f\x0c\xfe\x27\x9c\xe8\x80\x12\xc0\xb4\x6a\x38\x08\xcc\xb1\x30\xb0\x4c\x1e\x59\x12\x47\xad\x77\x4f\x10\x9f\xdc\x1b\x1c\x84\xf4\xae\xaa\x76\x42\x3d\xe3\xb0\x25\xee\x52\xdb\x01\x49\x75\x8e\xe6\x92\xf2\x16\x55\x89\x57\x74\x3e\x44\xe1\x74\xda\x1f\xde\x47\x3b\x59\x51\x8a\x09\x3e\x4b\x7d\x24\x86\xa5\x06\xcb\xe6\xdb\x24\xd5\xcb\x28\xfb\xfa\xd2\x95\x97\xa5\x7d\x2a\xf8\x6b\x0d\xaa\xc2\x84\x65\x6b\x10\x1c\xe1\x5c\x21\x92\x4d\x37\xc6\x94\xe9\xf3\xab\x8b\x8b\x00\x1e\x6a\xa5\x4d\xd1\xcd\x51\xaf\x10\xb9\x2f\x8b\xab\x77\xa5\x89\x9d\xf2\xd4\x1f\x5c\xff\x96\xc7\xd9\x24\x7e\x15\xc0\x5d\x53\xc7\x5a\xf8\xbb\x2b\x7f\x75\xc4\x79\x2d\x0a\x94\x94\x27\x68\xbc\xef\x89\x15\xd8\x2a\xf2\xd9\xa7\x90\xd0\x24\xc7\xb4\x09\x03\xf0\x5b\xc5\x24\xfa\x10\x98\x06\xa5\x45\xa5\x60\x8e\x06\x5f\x89\xb6\x6f\xec\x86\xe1\x15\x5d\x24\x23\xd7\x11\x52\xe4\xa6\xbb\xb8\x5c\x18\x5f\xbe\xdc\x7c\x1e\x4c\xc3\xbb\x59\x14\x46\x51\x7f\x34\x9c\x4d\x47\x83\x70\x72\x33\xbc\x0d\x8d\x4f\xc8\x97\x4c\x0a\x5e\x22\x37\xbd\x42\x32\x3a\x2f\xf0\x68\x8c\xef\xca\x83\x20\xa5\x28\xf0\x20\x3d\x25\xfd\xc6\xca\xba\x3c\x9d\x26\xaa\x54\x5d\x62\x0a\x46\x5b\x7d\x1f\x7f\xb2\xc5\xff\xea\x6d\x6e\x7b\x88\x39\x29\xe9\x1a\xb8\xd0\x80\xdf\x12\xc4\x14\x74\xeb\xd5\x06\xc9\xcd\xeb\x4d\x31\x60\x6a\x1e\x27\x46\xd6\x3c\x0c\x8c\xc3\xcd\xd7\xe8\x07\xb3\x49\xd5\x73\x45\x95\x6a\x42\xf4\x9f\x50\x49\xb1\x90\xb4\x74\x0d\x5d\x0b\xf3\x5d\x56\xda\x06\x69\xee\x57\x42\xa6\xca\x26\xac\xd9\x02\x6c\x9e\xc8\x4b\x79\xba\x89\xfe\x1c\xdf\x44\x91\xe1\xdf\xf1\xec\x7c\x5c\x37\x85\xdc\x21\xdb\x87\x4c\xa3\x74\x1e\xb8\x58\x41\xb8\x72\xd3\x28\x4b\xc6\xe9\x4e\xdb\x6c\xb4\x66\x5a\x32\x54\x0d\x37\x4b\xca\xd7\xa0\x59\xe9\x2b\xbb\x11\x32\x19\xa4\x4d\x9f\x56\xbb\x6f\x78\x26\x2f\xd8\xd2\x70\xb4\xae\x02\x72\x08\xe8\xf5\x61\x75\xe4\x58\x14\x0d\x9a\xf6\x03\x54\x45\x57\xdc\xcd\xc5\xdd\x49\xdd\xc8\xee\x00\x19\x5f\xda\x63\xc7\xfb\xcf\xbc\x60\xcf\xb6\x97\x12\x61\x3b\xcd\x31\xd4\x54\xa7\x85\x74\xd4\x0b\x07\x83\x53\xf8\x42\x2a\x50\xf1\xd7\x9a\xf8\x09\x8b\xed\x36\xf7\x07\x30\x0b\x82\xe0\xc5\xda\xe5\x9d\x65\xed\x7b\x60\x46\xb5\xc5\xba\x63\x80\x74\xe7\x8c\x77\x55\x6e\x83\x62\xd9\xbe\x4f\xce\x82\x42\x0d\xc8\x4c\x38\x66\x78\x8f\xc7\xe4\xce\x33\xda\x25\xda\x75\x36\x86\xa9\x9b\xc8\x14\x6a\xce\x34\x9c\x63\xb0\x08\xbc\xbd\xdf\xdf\xd8\x0e\x26\xa4\xff\xb6\xf5\x73\xe1\xba\xed\x78\x12\xde\x86\x77\xe1\xf0\x36\xdc\xac\x2e\x9b\x39\x49\x5b\xa1\xf8\xf9\x24\x95\x06\x91\xbd\x27\xc1\x24\x22\x41\x7f\x0c\x57\x01\x5c\x5b\xa5\xa3\xf0\xed\x2d\x26\x8d\x35\x13\x29\xe5\x6b\xe3\x41\x7f\x0c\x6f\x1b\x13\xfb\xe3\xe3\x85\x75\xc0\x69\x5f\x37\xda\x7b\x5b\xcf\x0b\x6a\xbf\xee\x3d\xfa\x7a\x33\x10\x03\x12\x4c\x1c\x16\x9b\x5d\x6c\x8b\xf0\x7c\xbd\xa5\xbf\x56\x58\x64\x1e\x67\xa6\xd5\x41\xbf\xb9\x00\x4d\x9f\x91\x54\x12\x13\x4c\x91\x27\x0e\x8e\xd3\x5e\x45\x3d\xf8\xd4\x1f\x84\xf0\x69\x34\x79\xb8\x99\x7e\x6f\x91\x64\x0a\x28\x4c\x47\x0f\x03\x88\x1f\x0b\xfa\x94\x6b\x5d\xa9\xf7\xdd\xae\x16\x65\x11\x30\xd1\x8d\x1f\x25\x7d\x72\x56\xe1\x7e\x07\x95\x3d\xc2\x10\xaa\x5d\x5b\x10\x95\x49\x77\xb3\x81\xb8\xce\xfa\x9d\x0d\x6d\xc7\x88\x41\x9a\xb6\x57\xea\xc0\xd4\xf8\x76\xf2\x6b\xc3\x84\xf7\x36\x1c\x4b\x1a\x9e\x91\xfd\x71\x0e\x1f\xe0\xec\x6d\x7e\x46\x76\x5b\x11\x7c\x80\x77\x84\x3c\x3a\x9b\x95\x14\x69\x9d\x18\xe1\xa7\xa3\xea\xd7\x6f\xca\x33\xd2\x34\xe3\x0f\x70\xd6\xad\x95\x74\xb5\xa5\xf2\xf8\xd2\x5f\x9c\x91\x20\x63\x9b\xf4\xda\x9d\x1b\x8c\x9b\x7e\xca\x26\x82\x6b\xca\xb8\x59\x69\x24\x4d\x34\x4a\x05\x62\xbb\xae\x14\xa8\xcd\x51\x07\x52\xb6\x60\x5a\x75\x7c\x2d\xcd\x76\x77\x83\xf8\xd2\xf4\xb2\xd2\x0f\xb2\x7f\x6a\x61\xfa\x56\xbb\x16\x7d\x3c\x67\xe5\x3a\xb0\xbf\xce\x9e\xb6\xb5\x68\xd2\x1f\xbd\x90\x78\x67\xe5\x97\xbf\xee\xee\x67\xb7\xa3\xe1\xa7\xfe\xfd\xac\x37\x7a\x08\xbb\xbe\x19\x76\x9d\x92\xdf\xd5\xce\xf5\xba\x62\x89\xf9\xeb\xe0\xf3\xf3\x6f\x37\x70\x02\x87\xf2\x17\x71\x36\xb6\x7f\x30\x36\x7b\xa8\x41\x26\x3d\x3e\x96\xdc\xcb\x27\x77\x06\xf2\xdf\x00\x72\x5f\x09\xc0\xee\x0e\x00\x00")
+
+func vaultedConfig1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedConfig1,
+		"vaulted-config.1",
+	)
+}
+
+func vaultedConfig1() (*asset, error) {
+	bytes, err := vaultedConfig1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-config.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedCp1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x54\x5f\x6f\xeb\x34\x14\x7f\xcf\xa7\x38\x4f\xb0\x49\x4d\x74\x57\x34\x9e\x10\x52\xd9\x8a\x16\x09\xb6\x68\x19\x5c\x21\x8c\xd0\xa9\x7d\xdc\x58\x4d\xec\x60\x9f\xb6\xcb\xb7\x47\x76\x92\x75\x9d\xee\x10\x8c\xb7\x2a\xf6\xf9\xfd\x3d\x6e\xf1\x74\x07\x07\xdc\xb7\x4c\x4a\xe4\xb2\x87\xab\xac\xa8\xef\xe0\x7e\xf5\xf3\x3a\x2b\xaa\x2a\x9b\x8e\x40\xf6\x20\x72\x90\xae\x37\x14\x80\x1b\x02\xe9\x2c\x93\x65\x70\x1a\x70\x04\x00\xb4\x0a\x02\x1e\x28\x80\x61\xc0\x00\x08\x96\x8e\xd3\xd9\xd1\x70\x33\x7d\xe8\x31\x84\xa3\xf3\x2a\x11\xd5\xbf\xdd\x3f\x54\x75\x59\x27\x32\xa1\x7f\x10\xfa\xe6\x44\x29\xf4\x23\x08\x5d\xba\x56\x09\x5d\xc5\x5f\x96\x8e\xf1\xd7\xef\x42\x97\x0f\xd5\x53\xf9\x70\x5f\x0b\x5d\xfd\xf1\xa5\x59\xd7\x0f\xff\x7a\xba\xbe\x83\xdb\x75\x7d\xf3\x58\xa6\x8f\x09\xed\x66\x72\x67\x6c\x32\x7b\x1a\x1e\xdd\x98\x00\xd2\x13\x46\x26\xe7\xc1\x53\xdf\xa2\x24\x05\x9b\xe1\x25\x16\xed\x5d\x77\x62\x17\x5f\x15\x09\xb6\xd4\x13\x5c\xd4\xfa\xeb\xea\x97\x9f\x9e\xd6\xb7\x7f\x56\xab\xba\xfe\xfc\xf0\x78\x1b\xf5\x92\x3d\x18\xef\x6c\x17\x21\x0e\xe8\x0d\x6e\x5a\x8a\x6c\x81\x78\x11\x53\x3d\x9a\xb6\x85\x0d\xc1\x3e\x90\x8a\x11\x73\x43\xd9\x9c\x27\x68\xe7\x4f\x94\x0b\x70\xdc\x90\x3f\x9a\x40\x89\xf3\xe5\xd6\x0c\xe1\xe9\xaf\x3d\x85\x68\xe1\x60\x30\x5d\x61\x1e\xfe\x41\xe6\xfd\xfa\xf3\xff\x91\x9a\x9d\x89\x98\xa4\x8e\xa1\x7e\x58\x6a\x7d\x07\x53\x91\x59\xf1\x34\xaf\x80\xc8\x45\xbe\x53\x3a\x4a\xfc\xae\xdf\xec\x94\x5e\x8a\x3c\x34\x78\x7d\xb5\x5c\xa0\xdf\x3a\xbb\x34\xea\xfb\xac\xee\x49\x1a\x3d\xef\xf2\x8e\x06\x50\xe4\xcd\x01\xd9\x38\x0b\x1d\x71\xe3\xd4\x28\x9c\xdd\x78\x32\x2a\x23\x2b\xfd\xd0\xa7\x4b\x71\x26\x96\x7c\xe6\xab\x80\x52\x83\xeb\x0c\x33\xa9\x45\x9a\x98\xb0\x9c\x3e\xd9\x8d\x21\x79\x62\x34\x96\x14\x5c\x18\x9d\x5e\x4b\xeb\x09\xd5\x90\xd1\xb3\x09\x1c\x2e\xdf\x26\xa2\x48\xa7\xbd\x9b\xd0\x2e\x46\xa7\xe7\xee\x84\x7e\xbc\x8c\xd0\x51\x76\x91\x15\xe5\x9c\xc7\x6c\x3a\x06\x62\x02\x20\x74\xd4\x39\x3f\x88\xbc\x41\xaf\x66\x44\x6e\x30\x6d\x75\x30\x5b\x6b\xb4\x91\x68\xb9\x1d\xa0\x73\x3e\x86\x1f\x4c\x60\xb4\x0c\xec\xb2\x8d\xdf\x33\xc5\xf6\x24\x01\x32\xa3\xdc\xc5\x04\xd1\xc2\x3b\x8a\xd2\xe2\x9f\x55\x23\x4d\xdf\x90\x4f\xed\x04\x92\x9e\x78\xe3\x9e\x17\xcf\xb2\x41\xd9\xe0\xf2\x93\xc8\x7b\xd7\x0e\x57\xdf\x7c\xba\x5e\x20\x05\x91\x2f\xaf\xbf\x15\xf9\x56\x76\x6f\x1b\x7b\x55\xc4\x9b\xb6\xa6\x93\x74\x6b\x7c\xac\x17\xf1\x7f\xc9\x70\xdc\xcc\x10\x8c\xb3\x99\x44\xd9\xd0\xe5\x47\xbb\xca\xa6\xae\xe0\x3f\x75\xf5\xe2\xf5\xbd\x9a\xbe\x10\x41\x0c\x29\x6a\x9f\x7a\x7c\x9d\x47\x3a\xf2\x04\xab\xf5\xea\x76\x62\x0a\x63\x89\xa8\x94\x89\xb9\x60\xdb\x0e\x19\xee\xb9\x21\xcb\x46\x22\xd3\x29\x91\xaf\x43\x1c\x41\x85\x8c\xc5\x7b\xe0\x1d\x0e\xf3\xcb\x33\x9e\x14\x18\x9b\xbd\x7a\xf0\x13\x57\x87\x56\x45\xe4\x1f\xcb\xaa\x16\x39\xf6\xbd\x77\x87\xf8\xd8\xdb\xad\xf3\x86\x9b\x2e\x14\xd9\xdf\x03\x00\x9a\x5c\xb3\xf6\x5f\x06\x00\x00")
 
 func vaultedCp1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -120,7 +172,7 @@ func vaultedCp1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedDump1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xcd\x41\x8a\x83\x30\x14\xc6\xf1\x7d\x4e\xf1\x5d\xc0\xc0\x1c\x61\x46\x05\x33\x30\x1a\x8c\x9b\x81\x6c\x42\xcd\xa3\x42\x93\x88\xbe\xb4\xd7\x2f\xa6\x5d\x94\x76\xf9\xf8\x78\xbf\xbf\x9c\x3a\x5c\x5d\xbe\xb0\x9f\x6d\x35\xe7\xb0\xe2\x4b\x48\xd3\xa1\xff\xfe\x6b\x85\xd4\x5a\x3c\x47\x94\xcd\x56\xb8\x6d\x0b\xfb\x1d\x7c\xf6\x38\xa5\xc8\x3e\x32\x12\xc1\x3d\x10\x70\xc2\xce\x73\xca\x0c\xb7\xe3\xd7\x0c\x7d\xc1\xcc\x7f\x3f\x68\xa3\x4c\x01\x2d\xfd\x58\xaa\x5f\x59\x4b\x23\x2c\xa9\xe8\x82\xb7\xa4\xcb\x47\xd3\x9a\x7a\x54\x7a\x52\x87\xa0\xb5\x68\x72\x58\x3f\xa2\xc7\xf9\x9e\x5d\x62\xc9\x82\xd2\x16\x1c\x4b\x71\x0f\x00\x00\xff\xff\xbe\x1d\xa8\x5d\xe0\x00\x00\x00")
+var _vaultedDump1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\xcd\x41\x8a\x83\x30\x14\xc6\xf1\x7d\x4e\xf1\x5d\xc0\xc0\x1c\x61\x46\x05\x33\x30\x1a\x8c\x9b\x81\x6c\x42\xcd\xa3\x42\x93\x88\xbe\xb4\xd7\x2f\xa6\x5d\x94\x76\xf9\xf8\x78\xbf\xbf\x9c\x3a\x5c\x5d\xbe\xb0\x9f\x6d\x35\xe7\xb0\xe2\x4b\x48\xd3\xa1\xff\xfe\x6b\x85\xd4\x5a\x3c\x47\x94\xcd\x56\xb8\x6d\x0b\xfb\x1d\x7c\xf6\x38\xa5\xc8\x3e\x32\x12\xc1\x3d\x10\x70\xc2\xce\x73\xca\x0c\xb7\xe3\xd7\x0c\x7d\xc1\xcc\x7f\x3f\x68\xa3\x4c\x01\x2d\xfd\x58\xaa\x5f\x59\x4b\x23\x2c\xa9\xe8\x82\xb7\xa4\xcb\x47\xd3\x9a\x7a\x54\x7a\x52\x87\xa0\xb5\x68\x72\x58\x3f\xa2\xc7\xf9\x9e\x5d\x62\xc9\x82\xd2\x16\x1c\x4b\x71\x1f\x00\xbe\x1d\xa8\x5d\xe0\x00\x00\x00")
 
 func vaultedDump1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -140,7 +192,7 @@ func vaultedDump1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedEdit1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\xdb\x6e\xdb\x48\x0c\x7d\xd7\x57\xf0\x69\xdb\x02\x8e\x16\xdd\x3f\x70\x93\xb4\x36\x9a\x8b\x61\x39\x2d\x0a\x08\x58\xd0\x1a\xca\x9a\x76\x34\xa3\x9d\x8b\x1c\xff\xfd\x82\xd4\x25\x76\x1a\x60\xf7\x55\x43\x9e\x39\x3c\x3c\xe4\x28\xdf\xad\xa0\xc7\x64\x22\xa9\xf2\x8a\x94\x8e\xf0\x31\xcb\x8b\x15\x3c\x2c\xef\x6f\xb3\x7c\xb3\xc9\xc6\x43\x90\xb3\xf2\x0a\xb4\x8d\xe4\xb1\x8a\xba\x27\x73\x92\xaf\x01\x62\x43\x50\x39\x1b\xc9\x46\x70\x35\xa0\x05\x7a\xd6\x21\x6a\x7b\x18\xb0\x05\xb1\xf8\xf1\xf0\xb8\x29\xd6\x85\xa0\x96\xf5\xa7\xb2\xbe\x3e\xc7\x2e\xeb\x2d\x94\xf5\xda\x62\x4b\x65\xbd\x91\x8c\x9b\xdb\xe2\x7a\xbb\xde\xec\xd6\x8f\x0f\x92\x54\x74\x78\xb4\x81\xe1\x27\x12\x3d\x41\xeb\x14\x41\xed\xbc\x80\xf0\x8d\xff\x45\x26\x17\xac\xa7\xce\x59\xf8\x27\xe9\xc8\x07\x0b\x49\xb2\x74\x9c\x13\x75\x80\x80\x3d\x29\x88\x4e\xce\xa6\xcc\x62\x05\x5f\xee\x1e\x3f\x2d\xef\xb2\x7c\x5b\x64\xf9\x7a\x03\xe5\xfb\x7d\x82\xbf\xb2\x82\xb5\x29\x1a\x77\xfc\x73\xa5\x15\x41\x41\x95\xa7\x18\xb2\x7c\xef\xb3\x9d\x3b\x1c\x0c\x05\x38\x36\x14\x1b\xf2\x10\xe4\x0c\x7a\x34\x89\x02\xa0\x27\x50\x3a\x74\x06\x4f\xa4\x38\xc6\x42\xaf\xe9\x38\xd3\x05\x45\x11\xb5\x09\x99\xb6\xc2\x44\xfa\xd0\x92\x4d\x39\xec\x1a\xa6\x49\x52\x02\x33\x3e\x18\xb7\x47\x03\x68\x15\x60\x5d\x53\x35\x76\x86\x6c\xd4\x9e\x26\x7d\xb2\x40\x21\x68\x67\x25\x4c\x07\xf0\x14\x28\x72\x99\x8d\x56\x8a\x2c\x10\x56\x0d\x44\xdd\xd2\x4b\xdd\x43\x98\xeb\xc8\x92\x62\xa9\xb3\x11\x2a\xcf\xf2\xed\xad\x68\xb2\xfc\x5e\xc0\xd7\xdb\x1f\xaf\x45\xf9\xc5\xa2\x7c\xa5\x93\xc8\x70\x8f\x16\x0f\x14\x60\x59\x55\x14\x02\x7f\x86\xf5\x8d\xb0\x18\xc4\x3a\x3f\xa8\x3c\x29\xa6\x8d\x26\xe4\xe7\x80\x2d\x03\xde\x7f\x5e\x5e\x00\xde\x7f\x5e\xc2\xfb\x36\x99\xa8\xcb\xab\x1a\xab\xe8\x3c\x60\x8a\x0d\xe7\x57\x18\xb5\xb3\x1f\x60\xb9\x7d\x00\xc7\xca\x7b\x8d\x06\x6c\x6a\xf7\xe4\x73\x58\xd7\x40\x16\xf7\x86\xd4\x22\x4b\x81\x3c\x1c\xb5\x31\xb0\x27\xe8\xbc\x6b\xbb\x38\x74\x9f\xd8\x6a\x72\x47\xc5\x4e\x93\x06\xa1\x30\x7d\x71\x94\x1c\x73\x72\xe6\xa9\x45\xcd\x01\x3c\x1f\x62\xcb\x17\x15\x55\xf2\x42\x27\x17\xf6\xeb\x1a\x4e\x2e\x49\xfb\x93\x40\x15\xbb\xe2\xbc\xee\x05\x1c\x1b\x5d\x35\xe0\xaa\x2a\xf9\x00\xfb\x13\x28\xaa\x05\xe7\x7d\xa0\xa1\x39\xef\xe2\xbb\xcc\x75\x0c\x09\x7b\x32\xee\x28\xf7\x8d\x76\xf9\xb0\x10\xf8\x36\x85\x08\x0d\xf6\x24\x14\xc7\x6a\xb9\x2c\x6d\x7b\xf7\x8b\x00\xed\x09\xd6\xcb\x7b\xa8\xd0\xbc\x92\xda\xb3\xd4\x5b\x67\x48\xd8\x8a\x80\x35\x78\x67\x88\xb3\xf7\x04\x18\x42\x6a\x27\xc7\xbe\x16\x24\xfb\x2e\x5f\x39\x84\x3f\xa2\x24\x0e\x53\xd6\xe2\xb3\x6e\x53\x3b\xab\x01\x68\x8c\x3b\x92\xe2\x0a\xd9\x46\x3a\xc0\x47\x68\x5c\x1a\xfa\x73\x72\xc9\x67\x73\x28\x7b\xdc\x13\x72\x43\x62\x83\x76\x0c\x1c\x28\x4c\x73\x70\x7e\xd7\x9c\x38\x36\x36\x43\xf5\x33\x85\xb1\xb1\xe3\x2d\xe7\x35\xcb\x82\x2b\xd2\x3e\x44\x1d\x53\x24\x38\xea\xd8\x40\xa4\xb6\x73\x1e\xfd\x85\x2b\xdf\x1c\x6c\x26\x2b\x35\x9c\x05\x4a\x83\xc3\x0c\xa9\x06\x4c\x64\xba\x2c\xe8\x0c\x9e\x9d\x5b\x1e\x3e\x3b\x0f\xad\xe3\xcd\x30\x74\x13\x1c\x0f\xbf\x0e\xec\x4c\x56\x7a\x01\x93\x07\x94\xab\x52\x4b\x36\x0e\x75\xf2\x70\x5e\xae\xd6\xd0\x90\x31\x65\xbd\x2d\xff\xb8\xa8\xf4\x86\x2b\xbd\x21\x43\x71\xe8\xef\x96\x5a\xd7\xf3\x36\x32\x46\x2a\x98\xee\x0d\xd1\x79\x52\x30\xee\x9e\x69\x0b\x8e\x53\x5f\x14\x2b\x9e\xfa\xe2\xf5\xd8\x23\x83\x2f\x95\xba\x98\x52\x0e\xfe\x45\xa7\x00\xc6\xa1\x12\xc8\x71\xb5\xe2\x81\x17\xee\x60\x23\x98\x96\x53\x88\xe8\xe3\xa5\x21\x0f\x8c\xfa\x85\x2c\x79\x8c\x34\x6f\x95\xe9\x43\x00\x04\x75\xb2\xd8\xea\x6a\x01\xda\x96\x57\x2d\xb5\xce\x9f\xa6\x6b\xd9\x2f\x71\x9e\xef\xb1\xaa\x8b\xf1\x9c\x5d\x36\xee\x55\x16\x1b\x7d\xd4\x55\x32\xe8\xcd\x09\x52\xa0\x3a\x99\x81\x67\xe5\x52\x67\xa6\x5e\x4e\x37\x04\x7d\xb0\xc3\x52\x7c\xe1\xdc\x33\xe7\x15\x86\x46\x5f\x3b\xdf\xc1\x37\x99\xdf\x62\x08\x84\xa7\xed\xdd\x60\xa3\x86\x7e\x8b\x79\xda\xde\xb1\x47\x2b\x67\x6b\x7d\x48\x9e\x7e\xbb\x65\x20\xc9\x61\x15\x5a\x99\x47\x9b\xe1\x3e\x38\xc3\xbe\xed\x30\x36\x0b\x5e\x78\xba\x96\x02\xbf\x2d\x9f\xee\x76\x7f\x2f\x6f\x6e\xb6\x40\xb6\xd7\xde\x59\xb6\x0c\xf4\xe8\x35\x2f\x04\x18\x9e\x91\x61\x61\xe0\x29\xeb\xbc\xeb\xf9\x11\x13\xc7\x21\x2b\xeb\xc9\xa0\x6c\x34\x46\x66\x62\x3f\xdd\x68\x89\x78\x74\x17\x15\xa7\xb7\x2a\x7e\xe2\xe5\xba\xf1\xda\x56\xba\x9b\x86\xe7\x7a\x2a\x6d\x78\xa4\x64\xff\x76\x73\xc8\xd0\x2f\xf1\xcb\xdc\x34\x7d\x18\xdf\x9f\xa1\x09\xa3\x22\xd9\xa8\x08\x17\x31\xef\xb7\xc6\xbb\x74\x68\x5e\xf3\xb8\x20\x7a\xcb\x44\x6f\x9f\x3b\x17\x08\xe8\x39\x92\xb7\x68\x04\x53\xfc\xf8\xe6\x7c\x3b\x0f\xd6\xc9\x5b\x49\x43\x9e\xfc\x5b\xbc\x4e\x7d\x69\x9a\x82\x5e\x63\xc6\xd5\x15\xc5\xaa\xac\xd7\xcb\xa7\xdd\xaa\xac\x37\xc5\xe3\xf5\xd7\xb7\xfb\x30\x4e\x44\xe0\xff\x1c\xce\x16\xf1\xc6\x91\xf8\x7f\xe3\x0b\xa1\xa3\x4a\xd7\x9a\xd4\xec\x98\xda\xbb\x56\x60\x8d\x0e\xe3\xf0\xfe\x1b\x00\x00\xff\xff\xca\x47\x6c\x4e\xf5\x09\x00\x00")
+var _vaultedEdit1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x58\xdf\x6f\xe3\x38\x0e\x7e\xf7\x5f\xc1\xa7\x9b\x06\x48\x73\x33\xf7\x70\xef\x99\xb6\xb3\x0d\xa6\x9d\x16\x49\xbb\x83\x05\x02\x1c\x18\x8b\x8e\xb5\x95\x25\xaf\x7e\x24\xcd\x7f\x7f\x20\x25\x3b\x49\xdb\xbb\xdd\x7d\x4b\x6c\x89\x22\x3f\x7e\xfc\x48\x79\xf6\x74\x0b\x3b\x4c\x26\x92\x5a\x5f\x92\xd2\x11\xbe\x54\xb3\xd5\x2d\xfc\x98\xdf\xdf\x54\xb3\xc7\xc7\xaa\xbc\x04\x79\xb7\xbe\x04\x6d\x23\x79\xac\xa3\xde\x91\x39\xc8\xd3\x00\xb1\x25\xa8\x9d\x8d\x64\x23\xb8\x06\xd0\x02\xbd\xea\x10\xb5\xdd\x66\xdb\x62\x71\xf5\xdb\x8f\x87\xc7\xd5\x62\x25\x56\xd7\xcd\xd7\x75\x73\x75\x6a\x7b\xdd\x2c\x61\xdd\x2c\x2c\x76\xb4\x6e\x1e\x65\xc7\xf5\xcd\xea\x6a\xb9\x78\x7c\x5a\x3c\xfc\x90\x4d\xab\x1e\xf7\x36\xb0\xf9\xc1\x89\x1d\x41\xe7\x14\x41\xe3\xbc\x18\xe1\x13\xff\xcc\x99\x99\xd8\x7a\xee\x9d\x85\x3f\x92\x8e\xfc\x62\x2a\x9b\x2c\xed\xc7\x8d\x3a\x40\xc0\x1d\x29\x88\x4e\xde\x0d\x3b\x57\xb7\xf0\xcb\xdd\xc3\xd7\xf9\x5d\x35\x5b\xae\xaa\xd9\xe2\x11\xd6\x17\x9b\x04\xff\xaa\x56\x8c\xcd\xaa\x75\xfb\x7f\xde\x6a\x45\xb0\xa2\xda\x53\x0c\xd5\x6c\xe3\xab\x27\xb7\xdd\x1a\x0a\xb0\x6f\x29\xb6\xe4\x21\xc8\x3b\xd8\xa1\x49\x14\x00\x3d\x81\xd2\xa1\x37\x78\x20\xc5\x6b\x2c\xec\x34\xed\x47\x77\x41\x51\x44\x6d\x42\xa5\xad\x78\x22\x79\xe8\xc8\xa6\x19\x3c\xb5\xec\x26\x49\x08\xec\xf1\xd6\xb8\x0d\x1a\x40\xab\x00\x9b\x86\xea\x92\x19\xb2\x51\x7b\x1a\xf0\xa9\x02\x85\xa0\x9d\x95\x65\x3a\x80\xa7\x40\x91\xc3\x6c\xb5\x52\x64\x81\xb0\x6e\x21\xea\x8e\x8e\x71\xe7\x65\xae\x27\x4b\x8a\xa1\xae\x8a\xa9\x59\x35\x5b\xde\x08\x26\xbf\xce\x97\x8b\xf9\xd7\xbb\x9b\xd5\x5b\x58\x90\x61\x99\x2b\x25\x40\xcc\x95\x0a\x80\xb0\x43\xaf\x71\x63\xf8\x00\xcc\x48\x53\x04\x6d\x8f\xe7\x7d\x0a\x50\x9c\x0c\xb3\x53\x63\x75\x31\x06\x57\xae\xeb\xd0\x7e\x6c\x74\xdf\xba\x40\x19\x5c\xb6\xcd\x46\x5d\x8a\x7d\xca\x64\x80\x3a\x6f\x85\x8b\xbd\x8e\x2d\x84\xe4\xbd\x4b\x56\x31\x2e\xfb\x56\x47\x0a\x3d\xd6\x04\x9e\x3a\xb7\x23\x35\x99\x0a\xb1\x4a\xa2\xc4\xdb\xd0\xba\x64\x94\xfd\x14\x61\x43\x10\xa2\xf3\xa4\xce\x5c\x87\x0b\x9a\x6d\x67\x55\x68\x9d\x8f\xeb\x4b\xa3\x33\x85\x5e\xc8\x86\x29\xb8\x21\xf5\x01\x5e\xa8\x97\x90\xd1\x3a\xa1\x44\x8f\x21\xec\x9d\x57\xd0\xa1\xc5\x2d\xf9\x09\x27\x97\xaa\xc1\x59\xc6\x3f\x59\x10\x97\x73\xe5\x84\x16\xd6\x97\x35\xd7\x0c\xed\xc8\x1f\x72\xc2\x70\x80\x8d\x37\xa4\x40\x6a\x2a\x49\xee\x52\x88\xd0\x68\xab\x43\x5b\xb1\x09\x6d\x41\x8a\x56\x77\xe4\x52\x84\x8b\x2f\x9f\x03\x6c\x0e\xa0\xa8\xe1\x08\x26\x33\x78\xe8\xa3\x76\x16\x8d\x39\x4c\x4b\x64\x0c\x66\x8d\x96\x83\xae\xb1\x6e\x49\x89\x21\x79\x39\x1c\x99\x6c\xd4\xe6\xec\x09\xbd\xf6\xda\x53\x98\x42\x70\xa5\x28\xc7\x68\x9c\x35\x87\x1c\x52\x4b\xb6\x3a\xf3\xbb\xf6\x84\x91\xd4\x4c\x92\xbb\x68\xce\x76\x36\x5c\x08\x53\xb0\xee\x74\x43\x88\xe8\x23\x29\x09\x95\x17\x93\xf7\xce\x03\xeb\x88\x64\xbf\x1a\x99\x31\x2c\x28\xd6\xce\xa8\x75\xcd\xd4\xba\x26\x43\x91\xe4\xe0\xa5\x30\x20\x00\x42\xe8\xa9\xd6\x8d\x26\x35\x52\xec\x48\xfb\xf9\xcf\x15\x7c\xbf\xf9\xed\x2d\xe9\x5f\xd8\xd8\x77\x3a\x88\xa5\x7b\xc9\x68\x80\x79\x5d\x53\x08\xfc\x18\x16\xd7\xe2\x4b\xd6\x88\xd3\x17\xb5\x27\xc5\xd5\x8a\xe6\x9c\xf8\x1d\x1b\xbc\xff\x36\x3f\x33\x78\xff\x6d\x0e\x17\x5d\x32\x51\xaf\x2f\x1b\xac\xa3\xf3\x80\x29\xb6\xbc\xbf\x46\x4e\xe0\x04\xe6\xcb\x1f\x99\x75\x5e\xa3\x01\x9b\xba\x0d\xf9\x19\x2c\x1a\x20\xcb\x81\xa8\x69\x95\x02\x79\xd8\x6b\x63\x38\xb5\xbd\x77\x5d\x1f\xb3\xe8\x11\x2b\xac\x9c\x51\x3b\x45\x59\x97\x50\x3c\x3d\x0a\xa9\xbc\xe6\xcd\x95\xa7\x0e\x99\xce\xd2\x16\xa4\x68\x8e\x15\xa1\x92\x17\x77\xc6\x8c\x1e\x5c\x12\xd5\x4b\x62\x6a\xf5\xb4\x3a\x8d\x7b\x0a\xfb\x56\xd7\x2d\xb8\xba\x4e\xfe\x94\x97\x70\x11\x28\x6b\xd2\xa7\xf8\xa9\x72\x42\x51\xd8\x90\x71\x7b\x39\xaf\xa8\xe4\x64\x2a\xe6\x85\xf2\x2d\xee\x48\x5c\x2c\xd1\x72\x58\xda\xee\xdc\x0b\x01\xda\x03\x2c\xe6\xf7\x50\xa3\x79\x03\xb5\x67\xa8\x97\xce\x64\x1a\x08\x80\x0d\x78\x67\x88\x77\x6f\x08\x30\x84\xd4\x91\xfa\x18\x90\xea\xa7\x3c\xe5\x25\xfc\x10\x65\x63\xae\xa1\x0e\x5f\x75\x97\xba\x11\x0d\x40\x63\xdc\x9e\x14\x47\xc8\x34\xd2\x01\xbe\x40\xeb\x52\xce\xcf\xc1\x25\x5f\x8d\x4b\x59\xda\xa5\x2a\x18\x56\xb4\x65\x61\x76\x61\x90\xff\xd3\xb3\xc6\x8d\x25\xb1\x15\xaa\xdf\x53\x28\x89\x2d\xa7\x9c\xc6\x2c\x7d\x7d\x95\x36\x21\xea\x98\x22\x65\x9d\x89\xd4\xf5\xce\xa3\x3f\x63\xe5\x87\xfd\x8c\x9d\x95\x18\x4e\x16\x4a\x82\xc3\x68\x52\x65\x9b\xc8\xee\x32\xa0\xa3\xf1\xea\x94\xf2\xf0\xcd\x79\xe8\x9c\xa7\x21\x9b\xe0\x58\x5a\x75\x60\x66\x32\xd2\x53\x18\x38\xa0\x5c\x9d\x3a\xb2\x31\xc7\xc9\x3d\xe9\x7c\xa2\x08\x2d\x19\xb3\x6e\x96\xeb\x7f\xfc\xb5\x32\x37\x46\x22\x18\xce\xfd\x40\xd9\x8f\x55\xbf\x5a\xdd\x72\xd5\xff\xff\x5e\x37\x54\x29\x2f\x7e\xa1\x43\x00\xe3\x50\x89\xc9\x32\x51\xe0\x96\x6c\x2c\x34\x1a\xe5\x4c\xb4\xec\x9c\x90\x5b\xb6\xfa\x0b\x59\xf2\x18\x69\x54\x95\xe1\x41\x00\x04\x75\xb0\xd8\xe9\x7a\x0a\xda\xae\x2f\x3b\xea\x9c\x3f\x0c\xc7\xe6\xa6\x35\xd4\x77\x89\xea\xac\x3c\x47\x96\x95\x71\x82\xc1\x46\x1f\x75\x9d\x0c\x7a\x73\x80\x14\xa8\x49\x26\xfb\x59\xbb\xd4\x9b\x21\x97\xc3\x09\x41\x6f\x6d\x9e\x05\x8e\x3e\xef\xd8\xe7\x5b\x0c\xad\xbe\x72\xbe\x87\x5f\xf9\x20\x58\xe5\x85\xf0\xbc\xbc\xcb\x34\x6a\xe9\xdd\x9a\xe7\xe5\x1d\x44\xc7\x53\x58\xa3\xb7\xc9\xd3\xbb\x53\xb2\x93\xbc\xac\xb4\x23\xb4\x15\x6e\x82\x33\x29\x12\xf4\x18\x5b\x69\xb3\x3a\xb7\x8d\x5f\xe7\xcf\x77\x4f\xff\x99\x5f\x5f\x2f\x81\xec\x4e\x7b\x67\x99\x32\xc7\x51\xa1\x8c\x1e\x22\x18\x78\xa8\x7a\xef\x76\x5a\x51\x66\x1c\x06\x40\xf0\x64\x50\x14\x8d\x2d\xb3\x63\xbf\xbb\x42\x89\xb8\x77\x67\x11\xa7\x8f\x22\x7e\x0e\xe4\xe1\xd1\x6b\x5b\xeb\x7e\x28\x9e\xab\x21\xb4\x3c\x9a\x88\xfe\xf6\xe3\x92\x9c\x2f\xe1\xcb\x98\x34\xbd\x2d\x63\x57\x4e\x42\x41\xa4\x2a\x88\x70\x10\xa3\xbe\xb5\xde\xa5\x6d\xfb\xd6\x8f\x33\x47\x6f\xd8\xd1\x9b\xd7\xde\x05\x02\x7a\x8d\xe4\x2d\x1a\xb1\x29\x7c\xfc\xb0\xbe\xb9\x9b\x3a\x19\x11\x29\xef\x43\xfb\xc1\xd6\x63\xd2\x14\xec\x34\x56\x1c\xdd\x6a\x75\xbb\x6e\x16\xf3\xe7\xa7\xdb\x75\xf3\xb8\x7a\xb8\xfa\xfe\x71\x1e\x4a\x45\x04\x1e\xef\x79\xb7\x80\x57\x4a\xe2\x6f\x77\xe9\x81\x31\x8d\x77\x9d\x98\x35\x3a\x9c\x14\xef\xb7\xc5\x5f\x9c\x52\xcb\x94\xde\x68\x56\x55\x4f\xa8\x04\xec\x18\xc6\x2b\x82\x1c\x70\x7a\xbb\xe0\xa5\xb3\xd2\xd5\x2b\xfe\x93\x55\x70\xef\x75\x8c\x64\x39\x4a\xe4\x54\xef\x30\x12\x28\xed\x89\x5b\xf6\x61\x54\x00\x16\x2c\x86\xfa\x38\x25\x55\x03\x20\xd2\xf9\x3e\xd6\xb7\x32\xa2\x46\xe7\x06\xf2\xb0\xab\xe3\x9c\x99\x7d\x14\xcf\x2a\x99\x4c\x01\xe1\x25\x6d\x28\xe7\x8a\x8f\x43\x78\xba\x5b\x41\x6d\xb4\xa4\x90\x7c\xd4\x0d\x8f\x0f\xf4\x66\x0c\x3c\x0e\xd8\x55\x29\xbc\x72\x6b\x88\x6d\xa9\x0e\x97\x6b\x8e\x8f\xca\x43\x70\xf1\xf8\xfb\xf3\xd7\x9b\xab\x87\x1f\xdf\x16\xbf\x64\x77\x87\x29\x4c\xee\x6d\xae\xa9\xc6\x4d\xc3\x7c\xd9\xa2\xdd\x72\xd0\x9f\xff\xfd\xf9\xf3\xd9\x5c\xfa\x77\x99\x20\x56\xff\x07\x0d\x16\x3f\xae\xee\x9e\xaf\xdf\x33\x41\xb3\xdd\x85\xad\x4d\x52\x94\x0b\x76\x9e\x33\x02\x81\x7a\xf4\xc8\xd8\xb3\xa5\x21\x5c\xc9\x47\x00\x9d\x77\x48\x37\x1f\x1f\xcb\x0c\xcf\xb8\x85\x29\xb3\xb2\xe2\xb2\x96\x5f\x90\xa7\x97\x90\xc1\xc8\x4c\x29\xf6\x46\x43\xc5\xf0\xc5\x00\x57\xf9\x1f\x5b\x3a\x0c\x8b\x26\x15\x7a\x82\x8e\xfc\xf6\xb4\xaf\xbc\xbd\x41\x95\xf1\x1b\xe3\x48\xdc\xd0\xa2\xcf\xae\x06\xda\x91\x47\x53\x0d\x87\x49\xda\x38\x5c\x67\xc5\x6f\x28\x9d\x82\x7d\x0e\x47\xf9\x9e\xe4\xf9\xdd\x12\xa9\x50\xc6\x22\xbe\xd0\xf0\x0d\xd5\xd9\xf3\x76\xf9\xf8\x98\x67\x22\xa9\x6f\xec\x04\x8e\xe9\x50\xa3\xa2\xd5\x8d\x3e\x2a\xf1\xe6\x90\xdb\xbf\x4c\x39\xa3\xa9\xe9\x59\x5c\x6e\x6f\xab\x7c\x21\x89\xf8\x42\x3c\x1a\x50\x4d\x8a\x6c\x4d\xe0\x76\xe4\x3f\x42\x31\xe3\x9c\x7f\x83\x91\x39\xaa\xc8\x38\xa7\xb2\x62\x3b\xef\xcc\x10\x7a\xa3\xc9\xb3\x17\x21\x27\xf2\x0d\x08\x62\xf3\x44\xb9\xd1\x53\x55\x72\x31\x06\xbb\xc7\xc3\x0c\x7e\x16\x19\x1d\x80\xec\xbd\x7b\x3d\x70\xc4\x4a\x87\xac\xdb\x17\x5c\x87\xa3\xb2\x73\xe5\x6f\x4b\x7b\x57\x13\x5e\xc8\x0e\xda\x23\x93\x25\x90\x9c\xd2\x77\x10\xb0\x53\x2d\x86\x53\x96\x55\x81\xe2\x50\xcc\xe1\xa4\x3c\xf2\xf8\xc8\x12\x33\x7c\x35\x19\xa6\x90\xd3\xf2\x5d\x5f\xae\x2f\x43\x68\xd7\x97\xe2\xf6\xfa\x52\xb4\x9e\x2b\xb9\x62\x98\xbc\x56\x24\xc3\x53\xa6\x6f\x97\xbf\xad\x2c\xde\x90\x18\x3d\x41\xf9\x7a\xf0\x66\xde\x39\x5e\xdf\x86\x5b\xa2\x3e\x5e\x86\x33\xa6\x9e\xfe\x48\x24\xc3\xeb\x45\xb2\x86\x42\xa9\x81\xa2\x16\x83\xd9\xe1\x36\x2a\xee\x41\xc6\x53\xcb\x60\x19\x0f\x93\x19\xcc\xcb\xb0\x93\xbf\x38\x94\xf2\x06\x1d\x03\x99\x06\x2e\xb2\x18\x9b\x43\xa6\x7b\xee\xa1\xf9\x5e\x9e\x03\x98\xf0\x69\xf9\xca\x9f\x42\x9e\xa2\xc5\xeb\xe3\xc5\x75\x06\x57\x72\x2d\x1e\xfe\xe7\x98\x3d\xf5\x06\xeb\x21\xea\xf7\xdf\xa5\xce\x69\x5a\x65\xe5\x0b\xf9\x2b\xd3\xfd\xcd\xd3\xfc\x7a\xfe\x34\x17\x40\xef\x29\xa2\xc2\x88\x19\x2f\x19\xe2\x92\x25\x5b\xfb\x43\x7f\x84\x8e\x23\x8b\x03\x2e\x4c\xec\x82\x8b\x4b\xf1\xb4\x80\xaa\x01\xde\xdc\x56\xc6\x6f\x7f\x26\x5c\x7c\x99\x4c\x66\x70\xed\xa4\xdb\xcb\x31\x63\x1f\x29\xe5\xd2\x15\x3f\x66\x70\x95\x7d\xad\x3a\x54\x63\xff\x1e\xde\x82\x4b\x31\x68\x51\xf7\x3c\x80\x90\xca\x5f\xb9\x28\x52\x1d\x4f\xe1\x18\xbf\x2e\xe5\x34\x4e\x2b\xa6\xaf\xa7\xde\xe5\x0b\x7d\x80\x88\x5d\x4f\x9e\xf9\xe9\xb1\x14\x11\x5a\x7e\x91\xd1\x73\x9e\x13\x37\x12\x66\xf6\x56\xcd\x55\xee\x12\xa1\xf6\x5a\xe8\x5f\x04\x5d\x1d\x9f\x9c\xe9\xf8\xfb\x4b\xd1\x13\x6e\xff\xb4\x0b\xe0\x36\x4c\x47\x5e\x34\xda\x44\xf2\xa7\x8a\x9d\x33\x71\x66\xda\xb1\xe9\x87\xbd\x25\x3f\x0e\xc2\x8e\xff\x9d\x39\x03\x43\xbf\x8e\x84\xdd\xc0\x68\xab\xf4\x4e\xab\x84\x66\x32\x7b\x3f\xbf\xfc\x5c\xf1\xb7\x04\x97\x6c\x84\xc5\xf5\x68\x79\x71\x3d\x98\xe5\x05\x58\x16\x9c\x2a\xea\xe9\xb5\x8d\x2f\xd4\xac\x06\x2e\xeb\xf7\x7f\x07\x00\xa2\x2c\x41\xd5\x1e\x16\x00\x00")
 
 func vaultedEdit1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -160,7 +212,7 @@ func vaultedEdit1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedEnv1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x6d\x6f\xdb\xb8\xb2\xfe\x1c\xfe\x8a\x01\x2e\x70\x9b\x00\x8e\x8a\x74\xf7\x53\xee\xed\x05\x7c\x13\xb7\xf1\x69\x6b\x1b\x96\xb3\x45\x51\x2f\x0a\x5a\x1a\x59\xdc\x50\xa4\x96\xa4\xec\x18\x45\xff\xfb\xc1\x90\xd4\x8b\x13\x67\x5f\x0e\x70\x0e\x50\x14\x88\x24\x3e\x33\x9c\x79\x66\xe6\x21\x9d\xac\xee\x60\xc7\x1b\xe9\x30\x5f\x5f\xa2\xda\xc1\x15\x4b\xd2\x3b\x98\x8d\x3f\x4d\x58\xb2\x58\xb0\xf8\x0e\xe8\xd5\xfa\x12\x74\xe3\xea\xc6\x59\xb0\x25\x4a\x09\x99\xae\x2a\xae\x72\x0b\xae\xe4\x0e\xa4\xe6\x39\x58\xcc\x0c\x3a\x0b\x85\x36\xc0\x03\x32\x08\xe5\x34\xb8\x12\xc3\x2a\x8f\x9f\x7e\x99\xcd\x17\xe9\x34\xf5\x36\xd6\xc5\xff\xaf\x8b\x9b\x81\xa5\x75\xb1\x84\x75\x31\x55\xbc\xc2\x75\xb1\x80\xaf\xeb\x62\x3a\x5f\xac\xa6\xf3\x59\xba\x2e\x16\xbf\xb2\x64\x63\x9e\xaf\x81\xf5\xe5\xfa\x92\x5b\xdb\xd0\x1a\xbf\x9c\x1b\x75\x72\x75\x7a\x07\xb7\x93\xf4\x66\x39\xf5\x0f\xbd\x07\x37\x06\xb9\x43\x0b\x1c\x2c\x5a\x2b\xb4\x82\xc6\x0a\xb5\x85\x1d\x37\x82\x6f\x24\xbd\x51\xb9\xdf\xc2\xf8\x73\x0a\x0f\x78\x00\xeb\xb4\xc1\x1c\x84\xf2\x4f\xbd\x1f\x09\xac\x4a\x64\x06\x6d\x23\x1d\x2d\x46\xb5\x13\x46\xab\x0a\x95\x1b\x02\x19\x84\xc6\x62\x0e\x4e\xc3\x16\x15\x1a\xee\xf0\x64\x38\xf7\x42\x4a\xe6\x63\xea\x43\x17\xe3\xea\x63\xc9\xc3\x82\xc4\xfb\xbe\x6a\x03\x0b\xc2\x02\x6f\x9c\xce\xd1\x61\x46\x51\x29\x8c\xae\xfc\xe2\x10\xac\xf4\x6e\xf2\xf1\x23\xc5\xe6\x94\x63\x23\x10\xc5\x20\x47\xc2\x42\xa3\x1e\x94\xde\x2b\xd0\x06\x1a\x65\x6b\xcc\x44\x21\x30\x1f\x45\x30\x5b\x12\x52\xa6\xab\x9a\x3b\xb1\x91\xd8\x3b\x4f\x1b\xc4\x4a\x38\x87\x79\x12\xd3\x3b\x9d\x69\x87\xd7\x94\x8c\x34\xbd\xa3\xf0\x85\xaf\xc4\x56\xf9\x20\xee\x4b\x54\x6d\x2c\x28\x70\x31\x07\x14\x07\x61\x61\xcf\x0f\x14\x59\x61\x69\x7f\x79\x83\xe0\x34\x23\x47\x85\xe2\x1b\x21\x85\x3b\x50\x24\x9d\xe1\xd9\x83\xf7\x5f\x8a\x02\x9d\xa8\x10\x74\xdc\x4f\x00\x1b\xc1\xbe\x14\x59\x09\x15\x72\x0f\x8c\xde\x15\xbe\x45\xe5\xd8\x5e\x37\x32\x07\x7c\x14\x96\xb8\x9a\x63\x21\x94\x70\x28\x0f\x89\xe7\x4a\xe4\x0e\x4b\x56\x2d\x53\x5f\x60\x1a\x4b\x63\x90\x02\x7e\xd1\x48\x09\xe3\xe5\x8c\x02\x68\x4b\x6d\x1c\x10\x9f\x5b\xb7\x8c\x96\xb4\x13\x08\x38\x09\xa4\x88\x84\x3e\x4e\xd3\xfb\x4f\xd3\xd9\x7b\x18\xc3\x72\xfe\x71\x42\x21\xdb\xa0\xd4\x7b\x5f\x4f\x39\x3a\x2e\xa4\x05\xad\xa0\xd4\x7b\xf8\x25\x92\x3f\x40\x58\x0f\x69\x13\x96\x4c\x17\x6c\x49\xe8\xfe\x79\xed\x88\xcd\x15\x3f\xc0\x06\xa1\x46\x53\x68\x53\x51\xc8\x85\x2b\x75\xe3\x20\xe4\xf5\x40\x51\x6f\xab\xd5\x69\xb0\x35\xdf\x2b\x4f\x9f\x84\x7d\xa6\xe4\x08\xb5\xd3\x0f\xc4\xd9\x98\x90\x11\x64\x06\x73\x54\x4e\x70\x19\x72\x69\x75\x63\xb2\x96\x73\x39\x16\x1e\x4a\xea\x8c\x3b\x9f\xc9\x73\x4c\xb6\x09\x1b\x10\x6f\x04\x99\x56\x85\xd8\x36\xc6\x7f\x01\x85\x90\x68\x47\x20\x94\x75\x5c\x65\x08\xb5\xd1\xf4\x68\x04\xe8\xb2\xe4\x22\x79\x12\x7d\xda\x05\x77\x14\xfd\xff\xf5\x7c\x1d\x15\xc2\x96\x23\x5b\x8e\x7e\xb3\x5a\x8d\xd6\xc5\x34\x6b\xac\xd3\xd5\xba\x58\xfc\x5f\xcc\xca\x01\xf6\x54\x54\x61\x21\xed\xb1\xb1\x38\x6a\x1d\xb5\xf4\xa0\xe5\x35\x4a\x49\xc0\x81\x2e\x54\x84\x83\xa2\x0a\x4f\x59\x28\xb7\x1e\x8b\xe8\x1e\x02\x1f\x40\xc8\x9b\x75\xb1\x1c\xf9\xae\x31\xac\x17\x0f\x47\x5f\xc7\x46\x0a\xb6\x11\x8e\xca\xcf\xe7\x17\x77\x5c\x36\x21\x1c\x7d\xcb\x6c\x0b\x2f\x18\x4d\x22\x1c\xed\xf3\x18\x90\x3e\xae\x78\x4d\xfc\x22\x18\xf4\x7b\xda\x10\xff\x89\xd4\xc0\x5b\x77\x1b\x8b\x45\x23\x41\x28\xa6\x5d\x89\x86\x02\xbd\x35\xbc\xaa\x9e\x74\x2c\x3b\x8a\xc9\x26\x03\x4a\x13\x46\x26\x9b\x1c\xbd\x1d\x6e\x0c\x3f\x04\x4b\xb1\xad\xb1\x60\xcc\x60\xa5\x77\xbe\xee\xa7\x0b\x36\x0d\x4c\x8f\x76\xad\x33\xbe\xb6\x9b\xba\x96\x02\x73\xc8\x35\x5a\x0f\x5c\x71\x97\x95\xa0\x55\x57\x1a\xb5\xc1\xf5\xa5\xaf\x42\xe2\x93\x5f\x6d\x99\x08\x0d\x91\x8c\x08\xe5\xd0\xd4\x06\x03\xf7\x81\x83\xc3\x47\x07\x0e\xab\x5a\x52\x2f\x0d\x9d\x7b\xab\x25\x57\xdb\x57\x16\x36\x8d\x90\x6e\x7d\x29\x54\xcc\x0d\x7d\xfc\xba\xfd\x98\x42\x58\xf3\xec\x81\x6f\xd1\x77\x6f\x8a\x8e\xe9\xa1\x5a\x8b\x9d\xd3\x9c\xb6\xd1\x10\x0f\x84\x2b\xc9\x59\x56\x08\x94\xb9\xa5\x74\x4a\xef\xaf\xaf\xd6\x04\xc6\xd2\x6a\xe0\x3b\x2e\xa4\xcf\x2e\x55\x08\x8f\xa9\x33\x58\x4b\x9e\x79\xd3\x45\xa3\xb2\xc0\x7e\x6d\x60\x6b\x9b\x0d\x48\xf1\x80\x6c\x83\x25\xdf\x09\x1a\xa0\x2a\xef\x96\xb5\x19\xef\xd6\x04\x82\xf2\x2c\xc3\xda\x59\x5f\xbd\xb2\x41\xbf\x84\xf8\x40\x4f\x28\x46\xee\xc0\x6a\x43\x11\xcb\xe1\x1f\xe9\x7c\x16\xd3\x10\x12\x34\xa6\xb1\x06\xf8\xc8\xab\x9a\x2a\xcd\xe9\x96\x95\xbf\x35\xd6\x75\xd3\x6e\x58\xe9\x9e\x48\x1e\x27\xe4\x65\x44\x01\xf3\x71\x08\x05\xd7\x85\xee\x1a\x9e\x16\x2b\xbc\xfa\xfe\x1d\x68\x13\x90\x8c\x3f\xa7\x37\x06\x73\x0b\x3f\x7e\xbc\x5a\x17\x4b\x96\xac\x52\xc6\xa5\xdc\xe8\xc7\xff\x61\xd9\x06\xfc\x3f\x26\x41\x82\xfc\x4b\xff\x27\xec\x1d\x25\x01\x66\xbc\xc2\xb3\xd5\xa1\xc6\x33\x1a\x37\x96\xdd\x84\x89\x74\x16\xb6\x7c\xb6\x6a\x7b\x72\x9c\x54\x40\x09\xeb\x46\x71\xe8\x70\xad\x14\x8a\x6c\x27\x22\x69\xdf\x41\x2d\x6b\x9d\x3e\x0b\x0c\xf0\x70\x14\x1e\x4a\x80\xb5\x5e\x13\x50\x16\xe3\xc8\x11\x5a\x75\x2b\x92\xe9\x6d\xeb\xc3\xf4\xb6\xfb\xe8\x78\x6d\xff\x71\xea\x07\x7d\xbb\x20\xfc\xf5\xa7\x8b\x56\xfa\x01\x55\xbf\x26\x48\x18\x47\x0f\x5f\x58\x0a\xe7\x7e\xe3\x81\xc6\x58\xd5\xda\x70\x73\x18\xa6\xfa\x82\xa5\xe8\xce\x2a\x5e\x7f\x0d\xa8\xbf\x46\xf0\x71\xdb\x64\x4e\xab\x9b\xbe\xe7\x70\xa9\xd5\xb6\xab\x13\x61\x62\x57\x62\xf7\xca\xa2\x3b\xfb\xda\xe3\x59\x29\x32\x3c\x6a\x26\x70\xd4\x4c\x7a\x19\x33\x34\xb9\xc1\x42\x1b\x6f\xc9\x4b\x06\x85\xfb\xd6\x40\xb2\x9a\x3c\x99\x16\x4a\xaf\x2f\xa3\x0c\x20\xba\xdd\x0a\x1b\xcd\x94\xd8\xc9\x0e\xad\x7c\xfb\x39\x15\x0a\x5f\x53\xe6\x78\xa2\x06\xad\x57\xa3\xa9\xb8\x22\x77\x86\x9f\x9f\x90\x86\xbd\xea\xa3\x01\x87\x3c\x3f\x3d\xa6\x33\xae\x8e\xc7\x34\x2f\x1c\x9a\x30\x8e\xc3\x88\x0e\x93\x27\xb4\xb8\x5e\xd9\x1d\x09\x12\xd6\xf2\xbb\x8d\x7d\x10\x21\x03\xd9\x71\xd0\x0d\xec\x85\x2d\x07\xfa\xe3\x49\xc4\x0c\x16\x06\xfd\xc8\x62\xa9\xe3\xc6\x01\xf7\x11\x6e\xc5\xb1\x47\xa6\x07\x2f\xc7\x8b\x43\xc4\x20\x75\xfe\x58\x8b\x10\xe3\xe7\x76\xb6\x21\x29\xa4\xa2\xda\x3f\x16\x6c\xbe\x43\x63\x44\x1c\x36\xe1\x71\xe4\x84\x8f\x21\x51\x7a\xfc\x39\x8d\xaa\xd0\x92\x2c\x1e\x7c\x18\x88\x5d\xa2\x62\x03\x49\x79\xd2\xd1\x90\x04\x2f\x70\x78\xbb\x9a\x84\x2f\x01\x9c\xef\x04\x87\x13\x8e\x8e\x06\x49\x15\xce\xa2\x2c\x46\x10\x2b\x0c\x55\x26\x35\x65\x66\xa8\x73\x5e\xd9\x88\x32\xfe\x9c\x7e\x5b\x4e\xde\x4f\xe7\x33\xda\xae\x36\x83\xc7\xb7\x93\x77\xe3\xfb\x8f\xab\xc1\xeb\xae\x14\x2e\x46\x21\xfb\x98\x0f\x41\xe3\x58\x1e\x8e\xe4\x53\x46\x7a\xf5\x71\xd2\x0a\x7b\xb1\x84\x85\xca\x45\x46\x03\xd0\x0f\xfb\xcc\x89\x5d\x1b\xdd\x20\x89\xa9\x97\x7c\x98\x7c\xf1\xea\xfe\x2b\xd1\x0d\x95\xfb\xf5\x1a\xfe\x0b\xce\x3f\xdf\x4d\x66\xf0\x69\x7e\x3b\x7d\xf7\x85\x54\xec\xea\x6e\x92\x4e\xe0\x76\x7e\x93\x8e\x60\xfc\x31\x9d\xc3\xfd\xe2\x76\xbc\x9a\x5c\xf7\x47\xce\xa0\x6a\xae\x92\x2a\x27\x77\x59\x7f\x14\x7d\xc4\xcc\x3f\xbe\xf0\x56\x5a\xad\xdb\x58\xb4\xf0\xd7\xcb\x6e\x78\xc6\xea\x28\xc0\x86\xab\x42\x29\xd1\x86\xd2\x55\x1a\x34\x40\x7f\x82\x7b\x2a\x72\x5b\xc9\x1a\x46\x86\xe4\xd6\x77\x66\x7f\x1a\xc9\x9b\x41\x17\xe9\xec\xb7\x05\x73\x3e\x58\xd9\x13\xab\x3b\xbd\xe6\x82\xb4\xec\x45\x3c\xcf\x9d\xac\xa9\x8a\x46\xf2\xa6\x6b\x22\xa0\x4d\x4e\x4a\xa5\x2d\x60\x22\xb0\x96\xf8\xfc\xc0\xb5\xc1\x8c\xd3\x8c\x6e\x03\x38\x54\xbc\xb6\xd9\x58\x27\x5c\xe3\xf7\x7a\x3a\xa8\x54\xe9\xec\x64\xf1\x84\x42\x18\x7e\x4b\x6d\xa5\x36\x7a\xe7\x0b\x57\x77\x16\xe9\xec\xd1\xe9\x3c\xe6\x4a\x6d\x31\xe8\x88\x48\xec\x36\x48\xc9\xf3\x44\x53\x5a\xe8\x3c\x90\x73\x93\xbf\x30\x70\xa8\x17\x0c\x9c\xb8\x66\xc9\x32\xa5\xb2\x86\xf5\xf9\xa6\x81\x37\xac\xe7\xff\xf8\xe6\x66\x92\xa6\xdf\x3e\x4c\xbe\x7c\x9b\xde\x7a\xd9\xb1\x31\x6c\xac\x40\xf8\xb5\x85\x40\xd3\xcd\xca\x7e\x4e\x26\x70\xaf\xc4\xef\xfe\xd0\x09\xc8\xb3\xd2\x8f\x36\x5d\x0c\xa2\x45\xf9\x7f\xb1\xb9\x3c\xf7\x22\x9d\xdc\x2c\x27\xab\x81\x33\xad\x27\xab\xee\x90\xdf\x69\x12\x2b\xb6\x0a\x0c\xfe\xde\xa0\x75\xf6\xdf\xe0\x49\x9a\x4e\xe7\xb3\x6f\xab\xf9\x87\x89\x6f\x17\xaf\xe1\xc8\xcd\xfb\xe5\x74\xf5\xa5\x7b\xeb\x7d\x5c\x84\xec\xc6\x13\x7b\x9c\x42\x27\x4d\xfe\x11\x14\xf5\xd8\xc8\x93\x9c\x79\x1a\xd6\x35\x1d\x8f\x25\x6e\x79\x76\x80\xf4\xf6\x03\xb9\xbc\x9c\x84\x56\x73\x7c\x20\xfe\x0f\xb6\x9c\xf1\x93\x33\x7a\x3b\x9d\xbb\x13\x19\xa0\xf0\x27\x28\x4f\x66\x8f\xf2\xca\x3e\x39\xd5\xd2\x14\x79\x7a\x55\x15\x8b\xdd\x5f\x0b\x74\x50\xd4\x14\x5e\x98\xe7\x51\x81\x1e\x97\x47\x21\x8c\x75\x5d\x6f\x0b\x23\x37\xe3\x59\x79\x74\x87\xd5\xd2\x39\xf4\xa2\x73\x8f\x38\x38\xe9\xb3\xc1\x15\xdb\x9e\xdb\xde\x9b\x0b\x0f\xe7\x2b\xd0\x1d\xf5\x43\xdb\x29\xcb\x56\xa3\x84\x72\x09\xf1\xa1\x70\xb1\x8c\xd3\x21\xd5\x0f\x66\x2e\xa5\xde\xdb\xe1\x25\x4c\x1c\xe2\xde\xd1\x3c\x5e\x11\x92\x4c\x44\xd3\xf7\x4f\x57\x72\x35\x40\x65\x46\x93\x64\xe7\x52\xc6\xb3\x38\x81\xc2\x79\xc5\x1f\x45\xd5\x54\x54\x00\x57\x50\xea\xc6\x5c\x74\x46\xad\xee\x2e\x79\xb8\x3b\xe9\x9f\x27\x60\xa7\xb1\x7c\x31\xf9\x1b\xa3\x20\x72\x86\x7d\x86\xe4\x45\xec\x52\x9d\x0a\x3d\x6a\x57\x5f\x74\xe3\x79\xe1\xcd\xc6\xcb\x94\xd8\x8b\xc3\x75\x0f\x45\xb2\x4d\x5a\xd8\x80\xa3\x8a\xf1\xa3\x29\xf3\xb7\x8e\x47\x97\x45\xcc\x9b\x11\x6e\xe4\x4f\x82\x60\xf5\xb5\x37\xe3\x9b\x9a\x2a\xd8\xe9\xfb\x4e\x48\x9b\x1a\x0d\x89\x49\x96\x14\x22\x94\xce\x62\xc1\xe2\x0d\x86\xbf\xdc\x32\x68\xb5\xdc\x79\x36\x77\xe6\xb8\x51\x91\x6f\xdc\xa8\x6b\xbe\xb7\xd7\x82\x57\xd7\xd7\x57\x57\x57\x6f\xde\xbc\xf9\xe9\xa7\x9f\x7e\xfe\xf9\xe7\x6b\xda\xc8\xeb\x0e\x7e\x5d\x2c\xd7\xff\x1d\x36\x1e\x54\x53\xcf\x28\xfa\x70\x14\x2f\x21\x42\x6a\x9e\x4e\xc4\xd3\x63\x55\x58\xb8\x62\x94\xc0\x11\x29\x0c\x6e\x72\x49\x9d\x37\x2e\xe9\x20\xfa\x42\x19\x8e\xf7\xa7\xe5\x16\x3c\x9b\x2a\xe0\x79\x2e\x5c\xe4\x5b\xf8\xba\x1d\x17\x3d\x10\xdf\xe8\x1d\x8e\xba\xdc\xc4\x86\x64\xbb\xb5\x5c\xbe\xa0\x8f\xbc\xf8\x15\x2a\x9c\x6a\xc9\x08\xdf\xe8\xc6\x75\xea\xfa\x85\x09\xf4\x0b\x69\xaf\xc9\xed\xb7\xc9\xec\x97\x6f\xd4\xc8\x68\x02\xcc\xef\x67\xab\xc1\x2c\x5a\x85\xc9\xa3\x1b\xe5\x60\x7a\x7b\xa4\xd8\x43\x9e\xf3\x13\x9d\xfc\x39\xee\x72\x36\x04\xec\x6f\x21\xff\x35\xb8\xd9\xf8\xd3\x64\x88\xf7\xec\x0e\xf3\x6f\x60\x2d\xc6\xcb\xd5\x74\x15\xc5\x67\x0b\x48\x33\xbe\xe6\xc6\x89\x23\xae\xfc\x6d\xe4\xd5\xdd\x10\xb4\xe6\xae\x7c\x01\x2b\x16\xc7\x3b\x6d\xda\x9b\x8f\xbf\x54\x62\x7f\x52\x22\x64\xf0\xf5\x0b\x65\xd8\x16\x60\xf8\x29\x24\xde\x89\x51\x1b\x3b\xfe\x85\x61\x83\xbe\x92\xba\x3b\x23\xa1\xe0\xfb\xf7\x24\x45\xf7\xe3\xc7\xb1\x87\x7f\x40\xa5\xb7\x43\xcf\x4e\x7c\xb8\x9c\xbd\xfd\x7b\x1b\x39\xc9\x87\xb7\x7f\xf0\xbe\xcb\xf1\x5b\xbe\xb7\xa7\x5e\xaf\xee\xde\x06\x23\x7d\x90\xd2\x3b\x78\x7f\x3f\x85\x05\xb7\x76\xaf\x4d\x0e\x0b\xa3\xab\xda\x59\xbf\xe9\xf7\xf7\xd3\xf5\xe5\x86\x93\x22\xaa\xdb\xf7\x75\x78\xdf\x0e\x64\x2f\x97\x36\x87\xee\x2e\xa0\x9f\xa3\xad\xf9\x71\xfa\x61\x31\x4e\x53\x62\x48\x1b\x6f\x7f\xe3\x7e\xac\xc3\xcf\xaf\x2e\xfc\x05\x9b\x36\x50\x69\x83\xed\x75\x7b\xc2\xfe\x19\x00\x00\xff\xff\x5e\x84\x9c\x6a\x24\x1b\x00\x00")
+var _vaultedEnv1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x59\x6f\x6f\xdb\x38\x93\x7f\x1d\x7e\x8a\x01\xae\xb8\x26\x80\xa3\x22\xdd\x7d\x95\xbb\x1e\xe0\x4d\xdc\xc6\xd7\x6e\x62\x58\x4e\x8b\xa2\x5e\x14\xb4\x34\xb2\xb9\xa5\x48\x2d\x49\xc5\x35\x82\x7e\xf7\xc3\xf0\x8f\x24\xbb\x4e\xb7\x7b\xc0\xf3\x00\x45\x81\x50\xe4\xcc\x70\xe6\x37\x33\x3f\x8e\xb3\xc5\x0d\x3c\xf0\x56\x3a\x2c\x97\xe7\xa8\x1e\xe0\x82\x65\xf9\x0d\xdc\x8e\x7f\x9f\xb0\x6c\x36\x63\xf1\x1b\xd0\xa7\xe5\x39\xe8\xd6\x35\xad\xb3\x60\x37\x28\x25\x14\xba\xae\xb9\x2a\x2d\xb8\x0d\x77\x20\x35\x2f\xc1\x62\x61\xd0\x59\xa8\xb4\x01\x1e\x24\x83\x50\x4e\x83\xdb\x60\x38\xe5\xe5\xe7\x1f\x6f\xef\x66\xf9\x34\xf7\x3a\x96\xd5\x6f\xcb\xea\x6a\xa0\x69\x59\xcd\x61\x59\x4d\x15\xaf\x71\x59\xcd\xe0\xd3\xb2\x9a\xde\xcd\x16\xd3\xbb\xdb\x7c\x59\xcd\xfe\x60\xd9\xca\x7c\x7f\x06\x96\xe7\xcb\x73\x6e\x6d\x5b\x63\x3c\xce\x8d\x3a\x7a\x3a\xbf\x81\xeb\x49\x7e\x35\x9f\xfa\x45\x6f\xc1\x95\x41\xee\xd0\x02\x07\x8b\xd6\x0a\xad\xa0\xb5\x42\xad\xe1\x81\x1b\xc1\x57\x92\xbe\xa8\xd2\x5f\x61\xfc\x21\x87\x2f\xb8\x03\xeb\xb4\xc1\x12\x84\xf2\xab\xde\x8e\x0c\x16\x1b\x64\x06\x6d\x2b\x1d\x1d\x46\xf5\x20\x8c\x56\x35\x2a\x37\x14\x64\x10\x5a\x8b\x25\x38\x0d\x6b\x54\x68\xb8\xc3\xa3\xee\xdc\x0a\x29\x99\xf7\xa9\x77\x5d\xf4\xab\xf7\x25\x0f\x07\x32\x6f\xfb\x22\x39\x16\x84\x05\xde\x3a\x5d\xa2\xc3\x82\xbc\x52\x19\x5d\xfb\xc3\xc1\x59\xf9\xcd\xe4\xdd\x3b\xf2\xcd\x31\xc3\x46\x20\xaa\x41\x8c\x84\x85\x56\x7d\x51\x7a\xab\x40\x1b\x68\x95\x6d\xb0\x10\x95\xc0\x72\x14\x85\xd9\x0d\x49\x2a\x74\xdd\x70\x27\x56\x12\x7b\xe3\xe9\x82\x58\x0b\xe7\xb0\xcc\x62\x78\xa7\xb7\xda\xe1\x25\x05\x23\xcf\x6f\xc8\x7d\xc1\x9f\xe1\x4e\x50\x89\xe4\x17\xb1\x56\xde\xab\xdb\x0d\xaa\xe4\x1c\xf2\x64\x0c\x0a\x39\x46\x58\xb6\xe5\x3b\x72\xb5\xb0\x74\xe1\xb2\x45\x88\xe8\x12\x8a\xaf\x84\x14\x6e\xe7\x17\x0c\x2f\xbe\xf8\x65\x29\x2a\x74\xa2\x46\xd0\xf1\x82\x41\xd8\x08\xb6\x1b\x51\x6c\x58\x8d\xdc\x0b\x46\x6f\x1b\x5f\x93\x53\x4e\x53\xb4\xbd\x69\x67\xb0\xd5\xad\x2c\x01\xbf\x0a\x4b\x60\x2e\xb1\x12\x4a\x38\x94\xbb\xcc\x83\x29\x82\x8b\x65\x8b\x04\xe5\x27\xa0\xc8\xf2\xe8\xc5\xa0\xaf\x6a\xa5\x84\xf1\xfc\x96\x3c\x6c\x37\xda\x38\x50\xbc\x37\xd3\x68\xe9\x6f\x16\xe4\x64\x90\x23\x92\xf4\x71\x9e\xdf\xff\x3e\xbd\x7d\x03\x63\x98\xdf\xbd\x9b\x90\x4f\x57\x28\xf5\xd6\x27\x5c\x89\x8e\x0b\x69\x41\x2b\xd8\xe8\x2d\xbc\x8f\xd9\x11\x44\x58\x2f\xd2\x66\x2c\x9b\xce\xd8\x9c\xa4\xfb\xf5\xc6\x11\xdc\x6b\xbe\x83\x15\x42\x83\xa6\xd2\xa6\xa6\x10\x08\xb7\xd1\xad\x83\x10\xf8\x1d\x45\x21\xa5\xb3\xd3\x60\x1b\xbe\x55\x1e\x5f\x19\xfb\x40\xc1\x12\xea\x41\x7f\x21\x50\x53\x58\xb6\x7c\x37\x82\xc2\x60\x89\xca\x09\x2e\x43\x6c\xad\x6e\x4d\x91\x40\x59\x62\xe5\x45\x49\x5d\x70\xe7\x23\x7b\x8a\xd9\x3a\x63\x03\x64\x8e\xa0\xd0\xaa\x12\xeb\xd6\xf8\x1d\x21\x14\x23\x10\xca\x3a\xae\x0a\x84\xc6\x68\x5a\x1a\x01\xba\x22\x3b\xcb\x0e\xbc\x4f\xb7\xe0\x8e\xbc\xff\xdf\x1e\xd0\xa3\x4a\xd8\xcd\xc8\x6e\x46\x7f\x5a\xad\x46\xcb\x6a\x5a\xb4\xd6\xe9\x7a\x59\xcd\xfe\x27\x46\x65\x07\x5b\xca\xba\x70\x90\xee\xd8\x5a\x1c\x25\x43\x2d\x2d\x24\xe0\xa3\x94\x24\xd8\xc3\xc7\x67\xe9\x20\xeb\xc2\x2a\xf3\x9b\x06\xb2\x28\x1f\x82\xe3\x83\x10\xb2\x66\x59\xcd\x47\x3e\x0d\x86\x09\xe5\xc5\xd1\xee\x58\x69\xc1\xb6\xc2\x51\x7e\xfa\xf8\xe2\x03\x97\x6d\x70\x47\x5f\x53\x53\x66\x06\xa5\x59\x14\x47\xf7\xdc\x17\x48\x9b\x6b\xde\x10\xbe\x48\x0c\xfa\x3b\xad\x10\x2c\x12\xa8\x81\x27\x73\x5b\x8b\x55\x2b\x41\x28\xa6\xdd\x06\x0d\x39\x7a\x6d\x78\x5d\x1f\x94\x34\x3b\x8a\xc1\x26\x05\x4a\x93\x8c\x42\xb6\x25\x7a\x3d\xdc\x18\xbe\x0b\x9a\x62\xdd\x63\x41\x99\xc1\x5a\x3f\xf8\xc2\x30\x9d\xb1\x69\x40\x7a\xd4\x6b\x9d\xf1\xb9\xde\x36\x8d\x14\x58\x42\xa9\xd1\x7a\xc1\x35\x77\xc5\x06\xb4\xea\x52\xa3\x31\xb8\x3c\xf7\x59\x88\x65\x3c\x6d\x99\x08\x15\x93\x94\x08\xe5\xd0\x34\x06\x03\xf6\x81\x83\xc3\xaf\x0e\x1c\xd6\x8d\xe4\x0e\x63\x69\x5f\x6b\xc9\xd5\xfa\xb9\x85\x55\x2b\xa4\x5b\x9e\x0b\x15\x63\x43\x9b\x5f\xa4\xcd\xe4\xc2\x86\x17\x5f\xf8\x1a\x7d\x79\x27\xef\x98\x5e\x54\xd2\xd8\x19\xcd\xe9\x1a\x2d\xe1\x40\xb8\x0d\x19\xcb\x2a\x81\xb2\xb4\x14\x4e\xe9\xed\xf5\xd9\x9a\xc1\x58\x5a\x0d\xfc\x81\x0b\xe9\xa3\x4b\x19\xc2\x63\xe8\x0c\x36\x92\x17\x5e\x75\xd5\xaa\x22\xa0\x5f\x1b\x58\xdb\x76\x05\x52\x7c\x41\xb6\xc2\x0d\x7f\x10\xd4\x61\x55\x09\xfc\x20\xe2\xdd\x99\x00\x50\x5e\x14\xd8\x38\xeb\xb3\x57\xb6\xe8\x8f\x10\x1e\x68\x85\x7c\xe4\x76\xac\x31\xe4\xb1\x12\xfe\x37\xbf\xbb\x8d\x61\x08\x01\x1a\x5b\xe0\x0a\xf0\x2b\xaf\x1b\xca\x34\xa7\x13\x2a\xff\x6c\xad\xeb\xda\xe1\x30\xd3\x3d\x90\xbc\x9c\x10\x97\x11\x39\xcc\xfb\x21\x24\x5c\xe7\xba\x4b\x38\x4c\x56\x78\xfe\xf8\x08\x74\x09\xc8\xc6\x1f\xf2\x2b\x83\xa5\x85\x6f\xdf\x9e\x2f\xab\x39\xcb\x16\x39\xe3\x52\xae\xf4\xd7\xff\x62\xc5\x0a\xfc\x3f\x26\x41\x82\xfc\xa9\xff\x33\xf6\x9a\x82\x00\xb7\xbc\xc6\x93\xc5\xae\xc1\x13\xea\x47\x96\x5d\x85\x96\x75\x12\xae\x7c\xb2\x48\x35\x39\xb6\x32\xa0\x80\x75\xbd\x3a\x54\xb8\xc4\x95\x22\xda\x09\x48\xda\x57\x50\xcb\x92\xd1\x27\x01\x01\x27\x8b\xe8\x1e\x0a\x80\xb5\x9e\x34\x50\x14\x63\x0b\x12\x5a\x75\x27\xb2\xe9\x75\xb2\x61\x7a\xdd\x6d\xda\x3f\xdb\x6f\xce\x7d\xd7\x4c\x07\xf2\xd8\x43\xff\xe6\xd0\x42\x7f\x41\xd5\x9f\x09\x1c\xc7\xd1\xe2\x13\x47\xe1\xd4\x5f\x3c\xc0\x18\xeb\x46\x1b\x6e\x76\xc3\x50\x9f\xb1\x1c\xdd\x49\xcd\x9b\x4f\x41\xea\x1f\x51\xf8\x38\x15\x99\xe3\xf4\xa7\xaf\x39\x5c\x6a\xb5\xee\xf2\x44\x98\x58\x95\xd8\xbd\xb2\xe8\x4e\x3e\xf5\xf2\xac\x14\x05\xee\x15\x13\xd8\x2b\x26\x3d\xcf\x19\xaa\x5c\x61\xa5\x8d\xd7\xe4\x29\x84\xc2\x6d\x52\x90\x2d\x26\x07\xdd\x42\xe9\xe5\x79\xa4\x05\x04\xb7\x6b\x61\xa3\x9a\x0d\x76\x34\x44\x2b\x5f\x7e\x8e\xb9\xc2\xe7\x94\xd9\xef\xa8\x81\x0c\x36\x68\x6a\xae\xc8\x9c\xe1\xf6\x23\xdc\xb1\xa7\x85\xd4\xe0\x90\x97\xc7\xdb\x74\xc1\xd5\x7e\x9b\xe6\x95\x43\x13\xda\x71\x68\xd1\xa1\xf3\x84\x12\xd7\x53\xbf\x3d\x42\xc2\x12\xbe\x93\xef\x03\x09\x19\xd0\x8e\x9d\x6e\x61\x2b\xec\x66\xc0\x3f\x0e\x3c\x66\xb0\x32\xe8\x5b\x16\xcb\x1d\x37\x0e\xb8\xf7\x70\x74\x62\x90\x4c\x0b\x4f\xfb\x8b\x43\x94\x81\xc4\xac\x1a\x11\x7c\xfc\xbd\x9e\x75\x08\x0a\xb1\xa8\xf4\xc7\x8c\xdd\x3d\xa0\x31\x22\x36\x9b\xb0\x1c\x31\xe1\x7d\x48\x90\x1e\x7f\xc8\x23\x4b\xb4\xe8\xec\x70\x63\x00\xf6\x06\x15\x1b\x50\xcc\xa3\x86\x86\x20\x78\x82\xc3\xd3\x69\x61\x83\x80\xd3\x07\xc1\xe1\x88\xa1\xa3\x41\x50\x85\xb3\x28\xab\x11\xc4\x0c\x43\x55\x48\x4d\x91\x19\xf2\x9c\xe7\x36\x4a\x19\x7f\xc8\x3f\xcf\x27\x6f\xa6\x77\xb7\x74\x5d\x6d\x06\xcb\xd7\x93\xd7\xe3\xfb\x77\x8b\xc1\xe7\x2e\x15\xce\x46\x21\xfa\x58\x0e\x85\xc6\xb6\x3c\x6c\xc9\xc7\x94\xf4\xec\xe3\xa8\x16\xf6\x64\x0a\x0b\x55\x8a\x82\xbb\x20\x99\x17\x4e\x3c\x24\xef\x06\x4a\x4c\xb5\xe4\xed\xe4\xa3\xa7\xff\x9f\x08\x6e\xa8\xdc\x1f\x97\xf0\x1f\x70\xfa\xe1\x66\x72\x0b\xbf\xdf\x5d\x4f\x5f\x7f\x24\x16\xbb\xb8\x99\xe4\x13\xb8\xbe\xbb\xca\x47\x30\x7e\x97\xdf\xc1\xfd\xec\x7a\xbc\x98\x5c\xf6\x6f\xd2\xc0\x6a\x2e\xb2\xba\x24\x73\x59\xb7\x8e\x5f\xb1\xf0\xcb\x67\x5e\x4b\xe2\xba\xad\x45\x0b\x3f\x9f\x76\xc3\x47\x58\x07\x01\x36\x3c\x15\x52\x89\x2e\x94\x2f\xf2\xc0\x01\xfa\x27\xde\x21\xc9\x4d\x94\x35\xb4\x0c\xc9\xad\xaf\xcc\x8c\xf4\x95\xed\xa0\x8a\x74\xfa\x53\xc2\x9c\x0e\x4e\xf6\xc0\xea\x9e\xb7\xa5\x20\x2e\x7b\x16\x1f\x7c\x47\x73\xaa\x6e\xad\xeb\x12\x40\xd0\xb3\xad\x44\xd3\x27\x30\x70\x9f\xda\xdf\xbf\xc8\x56\x58\xf0\xd6\x62\xf7\x58\x18\x32\x5e\xdb\xae\xac\x13\xae\xf5\x77\x3d\xee\x54\xca\x74\x76\x34\x79\x42\x22\x0c\xf7\x52\x59\x69\x8c\x7e\xf0\x89\xab\x3b\x8d\xf4\xf6\xe8\x78\x1e\x73\x1b\x6d\x31\xf0\x88\x08\xec\xe4\xa4\xec\xfb\x40\x53\x58\xac\xe3\xaa\xe4\xa6\x7c\xa2\xe1\x50\x2d\x18\x18\x71\xc9\xb2\x79\x4e\x69\x0d\xcb\xd3\x55\x0b\x2f\x59\x8f\xff\xf1\xd5\xd5\x24\xcf\x3f\xbf\x9d\x7c\xfc\x3c\xbd\xf6\xb4\x63\x65\xd8\x58\x81\xf0\x67\x2b\x81\xa6\xeb\x95\x7d\x9f\xcc\xe0\x5e\x89\xbf\xc2\x23\x14\x79\xb1\xf1\xad\x4d\x57\x03\x6f\x69\x73\xdc\x3f\xd9\x71\x2b\xf2\xc9\xd5\x7c\xb2\x18\x18\x93\x2c\x59\x74\x53\x80\x8e\x93\x58\xb1\x56\x60\xf0\xaf\x16\xad\xb3\xff\x02\x4b\xf2\x7c\x7a\x77\xfb\x79\x71\xf7\x76\xe2\xcb\xc5\x0b\xd8\x33\xf3\x7e\x3e\x5d\x7c\xec\xbe\x7a\x1b\x67\x21\xba\xf1\x05\x1f\xbb\xd0\x51\x95\x3f\x12\x05\xc2\x26\x9c\x94\xcc\xc3\xb0\x69\xb4\x71\x20\x71\xcd\x8b\x1d\xe4\xd7\x6f\xc9\xe4\xf9\x24\x94\x9a\xfd\x07\xf1\xbf\xb1\xe4\x8c\x0f\xde\xe8\xa9\x3b\x77\x2f\x32\x40\xe1\x5f\x50\x1e\xcc\x5e\xca\x73\x7b\xf0\xaa\xa5\x2e\xc2\x8e\x27\xbb\x1f\x0b\x74\xa2\xa8\x28\x3c\xd1\xcf\x23\x03\xdd\x4f\x8f\x4a\x18\xeb\xba\xda\x16\x5a\x6e\xc1\x8b\xcd\xde\x90\x2b\xc1\xd9\xab\x86\x53\x2f\x71\xf0\xd2\x67\x83\x19\xdc\x96\xdb\xde\x9a\x33\x2f\xce\x67\xa0\xdb\xab\x87\xb6\x63\x96\x89\xa3\xf8\x0d\xd1\x3f\xe4\x2e\x56\x70\x29\x63\x63\xe6\x52\xea\xad\x1d\x0e\x65\x62\x13\xf7\x86\x96\x71\x86\x48\x34\x11\x4d\x5f\x3f\xdd\x86\xab\x81\x54\x66\x34\x51\x76\x2e\x65\x7c\x8b\x93\x50\x38\xad\xf9\x57\x51\xb7\x35\x25\xc0\x05\x6c\x74\x6b\xce\x42\xed\x1e\xd6\xa3\x2e\x9b\xbd\xa0\x40\xe1\x18\x37\x9d\xfe\x7d\x86\xda\xd9\xe8\xd9\x1e\xfa\x1c\x6c\x95\x13\x92\x3e\xee\xc2\x0b\x6e\xa5\xdb\xf0\xde\x27\x42\x83\xec\x54\x9b\xbd\x93\xc2\x0e\x48\x8f\x97\x7b\x8c\x51\xc5\x32\xff\x51\xb7\x1e\x51\x5c\x5a\x9d\xc6\x30\xb1\x8a\x87\x41\x11\xd9\x91\xc2\x1d\xae\xee\x28\xd7\xbc\x01\x85\x1f\x68\xee\x8d\x99\x98\xa7\xc9\xc2\x8d\xfc\x1b\x12\xac\xbe\xf4\x6a\x7c\x39\x54\x15\x3b\x3e\x4a\x85\xbc\x6d\xd0\x10\x0d\x65\x59\x25\x42\xd2\xcd\x66\x2c\xce\x3e\xfc\x58\xcc\xa0\xd5\xf2\xa1\x9b\xc2\x79\x75\xdc\xa8\x78\x35\x6e\xd4\x25\xdf\xda\x4b\xc1\xeb\xcb\xcb\x8b\x8b\x8b\x97\x2f\x5f\xfe\xf2\xcb\x2f\xbf\xfe\xfa\xeb\x25\x5d\xe4\x45\x27\x7e\x59\xcd\x97\xff\x19\x2e\x1e\xf8\x56\x8f\x45\xda\x38\x8a\xe3\x8b\x10\xd4\xc3\x5e\x7a\xbc\x21\x0b\x0b\x17\x8c\x42\x3f\x22\x6e\xc2\x4d\x29\xd1\xda\x74\xa4\x13\xd1\xa7\xd8\x90\x18\x1c\x26\x6a\xb0\x6c\xaa\x80\x97\xa5\x70\x11\xa9\x61\x77\x6a\x34\xbd\x20\xbe\xd2\x0f\x38\xea\x62\x13\x4b\x99\xed\xce\x72\xf9\x04\xb3\xf2\x90\x10\x2a\xbc\x87\x3d\xd4\x02\xa0\x22\x2f\x7f\xa2\x77\xbd\x27\xd6\x36\xb9\xfe\x3c\xb9\x7d\xff\x99\x4a\x20\xf5\x8e\xbb\xfb\xdb\xc5\xa0\x8b\x2d\x42\xcf\xd2\xad\x72\x30\xbd\xde\xe3\xfa\x11\xfc\xd9\xcf\xc8\x9d\xdf\x0e\x05\xf6\xf3\xcb\xff\x9f\x38\xfa\x8d\x61\x28\xef\xbb\xe9\xe7\x3f\x90\x35\x1b\xcf\x17\xd3\x45\xa4\xad\x49\x20\xb1\x83\x86\x1b\x27\xf6\xb0\xf2\x8f\x25\x2f\x6e\x86\x42\x1b\xee\x36\x4f\xc8\x8a\xc9\xf1\x5a\x9b\x34\x33\xf9\xa9\x14\xfb\x9b\x14\x21\x85\x2f\x9e\x48\xc3\x94\x80\xe1\x57\x96\x38\x4d\xa3\x02\xb8\xff\xe3\xc5\x0a\xe9\xef\x7e\xda\x24\x14\x3c\x3e\x66\x39\xba\x6f\xdf\xf6\x2d\xfc\x01\x94\x5e\x0d\x2d\x63\xc7\xb0\xf1\xea\x9f\x5d\xe4\x28\x1e\x5e\xfd\xe0\x7b\x17\xe3\x57\x7c\x6b\xd9\xd1\x40\xbd\x0a\x4a\x7a\x27\xe5\x37\xf0\x7e\x3c\x9f\x8e\x7f\x7b\x37\x81\xe9\xed\x62\x32\x9f\xdd\xbd\x1b\x77\x3f\xfb\x2c\x36\x18\x87\x02\x3d\x2b\x37\xc7\x1f\x0a\x54\x89\x0d\x56\x68\x50\x15\x08\x61\x32\xba\x9f\xb8\x11\x40\xcf\x1e\xe9\x1e\xdf\x42\x3d\x83\x79\x3a\x12\xde\x07\xb1\x56\x46\x7e\x34\x68\x0d\xcf\xed\xc1\x4f\x44\x16\xa9\x21\xd3\xeb\x9e\xc5\x9a\x2f\x6c\x82\xda\xd9\x68\xf0\xc0\x4f\xe5\x8a\x2c\x1f\x1d\x94\xa4\x44\xa2\x60\xb5\x4b\xd5\x88\xf9\x41\x3b\x7c\x07\x76\xff\x72\x3d\xf2\x46\x1c\xf5\x2f\xd1\x1f\xd7\x99\xb3\x51\xfa\x8d\x8c\x35\xdc\xa0\x72\xb0\x37\xcc\xf7\xce\xe4\x2e\x3c\x4b\x32\x78\xdf\xd9\xe8\x57\xe9\xc0\x73\xe7\x2f\x3d\x68\x27\x5c\x31\xea\x67\xbb\x10\xa3\xec\x30\xb9\x46\x9e\xa7\x24\xeb\x7e\xbb\xbf\x7a\x3b\x59\x10\x1d\x22\x29\xdd\xb8\x5e\xea\xb5\x5d\x9e\x3f\x7b\xfc\x81\xf1\x14\x2b\x16\xd5\xda\xc3\x93\x43\x18\x93\xf0\x08\x8a\x68\x42\x28\xf5\xc1\xb2\x7b\x9b\xd8\xd9\xb3\x67\x8f\xb4\x35\xd2\x17\xe1\xd0\x70\x99\x3e\x3d\x46\x60\x8c\xd3\x02\xed\xf4\x3e\x10\x96\x5c\x10\xd2\x37\x84\x2c\xec\x78\x8c\x8c\x58\x62\xe5\x80\x5b\x10\x36\x63\x63\x7f\x71\x7f\xcc\xf4\x08\x0b\xe3\x06\x38\x2d\x85\xc1\xc2\xc9\x5d\x98\x3b\x18\xdd\xae\x37\x3d\x62\xed\x99\x47\x92\x02\x34\x46\x9b\xcc\xe7\x40\x9c\xea\xea\x0a\x78\x37\xfc\x24\x05\xa7\x16\xf1\x89\xa7\x68\x22\xbb\x9d\xfa\x72\x04\xab\x36\xde\x82\x85\x09\xbc\x96\x9c\x8e\x04\xb3\xc2\x60\xe0\xcd\xfd\x14\x66\xdc\xda\xad\x36\x25\xcc\x8c\xae\x1b\x67\xbd\xf7\xde\xdc\x4f\x97\xe7\x2b\x6e\xa9\x5f\xa6\xef\x4d\xf8\x9e\x54\x79\xc6\xb5\xda\x75\x23\xbd\x9e\x0e\xa7\xe0\x8e\xf3\xb7\xb3\x71\x9e\x53\x3c\x53\x12\xf8\x1f\xce\xf6\xef\x70\x7a\x71\x96\xc2\x53\x6b\x83\xe9\x57\xb3\x8c\xfd\xdf\x00\xe3\x99\xd9\xd8\x0c\x1f\x00\x00")
 
 func vaultedEnv1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -180,7 +232,7 @@ func vaultedEnv1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedExec1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5f\x6f\xe3\x38\x0e\x7f\xf7\xa7\x20\x70\xc0\x6e\x07\x48\x5c\x74\x76\x9f\x0a\xcc\x43\xae\xc9\xb6\x41\x67\xd2\x20\x4e\x67\x50\xac\x17\x85\x62\xd3\xb1\x50\x5b\xf2\x4a\x72\xd2\x7c\xfb\x03\x29\xdb\x71\x52\xcf\xbf\x3d\xdc\x3d\xb5\x91\xa5\x1f\x29\xfe\xf9\x91\x54\xb8\xbe\x83\x9d\xa8\x0b\x87\x69\x3c\xc6\x57\x4c\xe0\x2a\x08\xa3\x3b\x58\x4c\x3e\xcd\x82\x70\xb9\x0c\x9a\x8f\xc0\xdf\xe2\x31\xff\xad\x1d\x5a\xb0\x39\x16\x05\x24\xba\x2c\x85\x4a\x2d\xec\xa5\xcb\x41\xc0\x56\xee\x50\x79\x44\xd0\x06\x8c\x2e\x90\xf1\xa2\xa7\xc5\xc3\x32\x9a\x47\x8c\x19\x67\xff\x8e\xb3\x9b\x3e\x72\x9c\xad\xe0\xcf\x38\x9b\x3f\x2c\xd7\xf3\x87\x45\x14\x67\xcb\xbf\x20\xce\xe6\x4a\x94\x18\x67\x4b\xfa\xb7\x15\x14\x67\xcb\x20\xdc\x98\x7f\x82\x41\x07\xe2\x71\x3c\xa6\x8d\xff\x05\x62\x0b\x23\xac\xad\x09\x9a\xc1\x84\x51\xdf\x17\x12\xdd\xc1\x74\x16\xdd\xac\xe6\x8c\xc7\xa6\x98\xfd\xb4\x39\x43\xa0\x33\xb4\x73\x23\x15\x5a\x70\x39\x42\x86\xc2\xd5\x06\x6d\x90\x19\x5d\xc2\xe9\x45\x18\x98\xb4\x61\x48\xda\xdd\x08\x69\x5c\x29\xb5\x02\x9d\x9d\x1d\x8a\xc7\x2a\xce\x56\xf1\x2f\x21\x2b\xdd\xdc\x3f\x08\xd7\xad\xef\xbe\x72\xff\x20\xaa\x30\x91\x99\x6c\xd5\xaa\x8b\x02\x26\xab\x05\xa9\x4e\xbf\x49\x7d\x20\x7f\x90\xc0\x6e\xc1\x69\xf0\x50\x21\x44\x88\x24\x60\x12\x45\x8f\x9f\xe6\x8b\x5b\x98\xc0\xea\xe1\xe3\x8c\x0c\xbb\xc1\x42\xef\x21\xd3\x06\x52\x74\x42\x16\x16\xb4\x82\x5c\xef\xe1\x73\xa3\xb1\x87\xb0\x0c\x69\xc3\x20\x9c\x2f\x83\x15\xa1\xf3\x7a\xc5\xb7\x2c\xc5\x01\x36\x08\x15\x9a\x4c\x9b\x12\x53\xb6\x88\xae\x1d\x58\xd6\xfa\x20\xd5\x16\x44\x63\x6c\xa7\xc1\x56\x62\xaf\x80\x2c\x1a\x06\x5f\x72\x54\x20\xd5\x4e\xbf\x60\x0a\x2e\x97\x16\xf6\xe2\x30\x82\xc4\x60\x8a\xca\x49\x51\x58\x10\x06\xc1\xea\xda\x24\x98\xf2\x21\x48\x31\x63\xa8\x42\x27\x82\xe4\x5b\xb8\xc0\x70\x1b\x06\xa8\x76\xd2\x68\x55\xa2\x72\x23\x48\xb4\xca\xe4\xb6\x36\xbc\x03\x32\x59\xa0\x1d\x81\x54\xd6\x09\x95\x20\x54\x46\xd3\xd2\x08\xd0\x25\xe1\xbb\xf0\xcc\x01\x4a\xc7\x63\x8b\xd6\x4a\x4d\xce\x0a\xa6\xd2\x8a\x4d\xd1\x98\x7e\x8b\x0a\x1b\x50\xb2\x35\x96\x95\x36\xc2\x1c\x4e\x35\x56\xa9\xf7\xc0\xd1\x46\x21\xac\x73\x0c\x2a\x34\xa5\x50\xa8\xdc\xc9\x76\xeb\xb4\xc1\x14\xa4\x62\x01\xde\x4c\x74\xe9\xda\xf2\xaa\x75\x28\xd2\x61\xc3\x27\x42\x9d\x1a\x5e\x64\x0e\x8d\x37\xb0\x37\xba\x8f\xfe\xda\xd2\x2f\x42\x1f\x88\xb2\xa0\x0d\xdb\x2e\x8e\x39\xb2\x7a\x81\x74\xd0\x35\xec\xa5\xcd\x7b\x11\x75\x66\x31\x83\x99\x41\x9b\x13\x5a\xe4\x84\x71\x20\x40\xe1\x1e\x1a\x23\x7a\x64\x5a\xf8\xba\xbd\x04\x34\x18\x4c\x11\x95\xf4\x36\x3e\x97\x63\x6d\x1e\x8f\x1b\x17\x60\x3c\x7e\xc1\x03\x89\xbc\x6d\x16\x18\xa8\xd0\x22\x05\xa1\x60\x15\x4d\xe0\x05\x0f\x20\x95\xd3\x7c\x15\xb6\x0a\xe5\xad\x57\xea\x57\x0b\x51\x74\x07\x62\x8b\xca\x0d\x8a\xa9\x8c\x7e\x3d\xc4\x63\xde\x40\x52\x66\xaf\x95\xb6\x4d\x14\xe0\xab\x43\xa3\x44\x71\x84\x80\x61\x29\x83\xc8\x56\x6e\xc9\x3d\xf1\xb8\x36\x44\x20\xc1\x4d\x13\xab\x2d\xb8\x4a\x2b\x2d\x3d\x64\x6d\x91\x93\x93\xe4\xd0\x6d\x9a\xa3\x21\xdc\xd4\xc6\xa0\x72\xc5\x01\xb4\x2a\x0e\x5d\xb8\x63\x1a\x38\x0d\x7b\x6d\x5e\xbc\xd1\xef\x84\xcd\xe5\x8d\x36\x95\xcf\xe7\x0e\xdb\x7e\x47\x31\x8b\xc6\x0e\xa8\xc6\xeb\x9c\xc3\x72\xab\x5a\xa5\x2c\x6b\xb8\xa7\x4c\xee\xa9\x08\xd2\x02\x2a\x4a\x9c\xd4\x93\xdd\xe4\x4b\x04\xf7\xb3\x27\x66\xe7\x3f\x29\xe6\x50\xb9\xbf\xae\xe1\x5f\x70\xf1\xe5\x6e\xb6\x80\x4f\x0f\xd3\xf9\x1f\x4f\x44\x4e\xeb\xbb\x59\x34\x83\xe9\xc3\x4d\x34\x82\xc9\xc7\xe8\x01\x1e\x97\xd3\xc9\x7a\x76\xdd\xab\xa7\x6a\x17\x5e\x85\x25\xf9\x39\x0d\xba\x55\x8e\x75\x5e\x7f\xc7\x42\x5a\x06\xab\xc9\x6b\x3f\x9e\x7a\x4e\xb7\x49\x8e\xc7\x78\x0d\xfa\xa7\x7c\x3a\xd1\x7d\xa2\x75\xc4\x99\x0d\x06\x6d\x5d\x38\x5a\x3e\xa7\xae\xa3\x67\x08\xb9\x10\xd6\x91\xb9\x02\x92\x97\xd6\x3d\x26\xe9\xe4\xb7\x49\x73\xd1\x3b\xb9\x93\xe2\xac\x90\x60\x2a\x29\x2a\x89\xbb\x96\xcb\x60\x3d\x98\x57\x65\x6d\x1d\xf1\x43\x43\x24\xa0\x4d\x8a\xe6\x98\xc4\x94\x73\x54\xf7\x9a\xd6\x61\xbe\xd0\x0e\xaf\x7d\x4d\x48\x04\x05\x5e\x6b\xc0\x86\x70\xbd\xe3\xeb\x8d\x75\xd2\xd5\x7c\xd7\x61\xa3\x52\xe0\x05\x83\x99\x3e\xf2\x65\xb2\xb7\x97\xa8\xa5\x32\x7a\x27\x53\xae\x57\xad\x44\xaa\x28\x4a\x3b\x28\x85\x4b\xf2\xc0\xe5\xda\x22\x5d\x40\x0c\xa4\xd7\xb9\xa3\xc9\x2d\xc4\xf2\xa9\x30\x29\xf4\x4a\x02\xec\x84\x91\x9e\xc7\x29\x5c\x7b\x4a\x5c\x07\xe1\x2a\x22\x7e\x85\xf8\x62\x53\xc3\xfb\x26\x2b\x26\x5f\xa2\xe7\xc9\xcd\xcd\x2c\x8a\x9e\xef\x67\x4f\xcf\xf3\x29\xe5\x03\xf5\x33\x13\x05\x92\xcf\x66\x12\x0d\x83\xd1\xad\x44\x92\xa0\xb5\x94\x01\x21\x3c\x2a\xf9\x77\xcd\x17\x42\x91\xe4\x60\xd1\x91\x8b\x8f\xd6\x22\xff\x0f\xd9\x27\x1c\xd6\x22\x9a\xdd\xac\x66\xeb\x9e\x32\xad\x26\x14\x79\x16\x13\x83\xce\xfb\xb8\x4d\x4c\x83\x7f\xd7\x68\x9d\xfd\x1f\x68\x12\x45\xf3\x87\xc5\xf3\xfa\xe1\x7e\xb6\xa0\x0e\xe5\x12\x4e\xd4\x7c\x5c\xcd\xd7\x4f\xdd\x57\xd6\x71\xe9\xbd\x9b\x7a\x82\x68\x2a\xd1\xa0\xc8\x6f\x41\x11\x9b\x34\x71\xc2\x0c\x67\xeb\xaa\xd2\xc6\x41\x81\x5b\x91\x1c\x20\x9a\xde\x93\xca\xab\x99\x67\x9a\xd3\x36\xe7\xff\xc7\x38\x93\xb3\xc6\xab\x2d\xd0\xb6\xe9\xde\x52\x40\xe9\x72\x34\x3e\x96\x19\xe6\x57\x7b\xd6\xaa\x5c\xec\xa4\x38\x6f\x99\x9b\x5c\xa7\x76\xef\x08\x45\x9c\xf0\x95\x92\x0e\xba\x72\x6f\xb2\x23\x93\xc6\xba\x8e\xda\x7c\xd5\x4d\x44\x92\xd3\xbf\x1d\xe9\xb4\xd1\xec\xa9\xe8\x82\x11\x7b\xed\x5b\xd0\xeb\xfb\xf7\xc2\x1e\xb5\x79\xc7\x70\x75\x5b\x1f\x8f\x74\xd8\x02\x3b\xdd\xb6\x29\x3e\x5b\xbc\x7d\x78\x8c\x49\x44\x51\x10\x8b\x4a\x0b\xa2\x28\xf4\xde\x43\xf4\x0e\x6e\xd0\x2b\x9a\xb2\x7a\x02\x0a\xad\xb6\x68\x8e\xf4\xe9\x72\xa1\x7a\xa8\x81\xd1\xd4\xf1\x8b\xa2\x80\xbd\x2c\x0a\x0f\x0a\x17\xa5\x78\x95\x65\x5d\x52\xfc\x5f\x41\xae\x6b\xf3\xae\x13\x6a\x35\x94\x28\x14\x09\x16\x6e\x50\x3f\x8e\xbf\xae\xcd\xe2\x5c\x72\x92\x29\x94\xda\x9a\x3e\xcd\x48\xdb\x91\x14\x77\xab\xbd\xbb\x78\x7f\x3c\xe9\x9a\xe3\x82\xc5\x36\x1d\x72\x43\xc5\xbe\x87\x27\x4b\xb6\x4e\xf3\x17\x70\x94\x30\x8e\xe2\x3e\x31\xc8\x75\xa9\x3f\x04\xb0\x18\xe9\x46\x50\xc8\x17\x6a\x95\xaf\x59\x0c\x73\x9a\xca\xce\xe7\xcc\x36\x4a\x20\xaa\x2b\x34\xdc\x50\xd2\x62\x3b\xbb\x04\x61\x26\x7d\x1e\x2d\x97\xc1\x3e\x97\x49\x0e\x7b\x5d\x17\x29\x79\x54\x17\x3b\x6c\xdb\x1c\x16\x2e\x8c\x6a\xa2\x4f\x18\x75\x2d\xf6\xf6\x5a\x8a\xf2\xfa\xfa\xea\xea\xea\xfd\xfb\xf7\xbf\xfd\xf6\xdb\xef\xbf\xff\x7e\x4d\xd7\xba\xec\x64\xb5\xb3\xcf\x72\xe9\x3b\xff\x5e\x7c\xd1\x46\x5f\x1f\x5a\x47\x9d\x97\xc7\xe1\x1a\x2b\x2d\x5c\x05\xe4\xce\x11\x18\xdc\x0a\x93\x16\x44\xc3\xcd\x91\x0e\xe2\x98\x36\xfd\x5a\x7f\x9e\x7c\x5e\xb3\xb9\x02\x91\xa6\xd2\x35\xd1\xe7\x77\xb7\xb5\xe3\x08\x24\x36\x7a\x87\xa3\xce\x53\x0d\x3b\xd9\xee\xac\x28\x82\xe1\xf2\xc3\x8d\x99\x54\x14\x4b\x5e\x39\xb1\xa1\x61\xa9\x6d\xb7\xbf\x52\x8e\x3e\x4f\x1e\x3f\xae\x67\xd3\xe7\xd9\xe2\xf3\x33\xb1\x1a\x95\x83\x87\xc7\xc5\xba\x57\x98\xd6\xbe\x0c\xe9\x5a\x39\x98\x4f\x4f\x5a\x78\xef\xf4\x74\x80\xd6\xdf\xe2\xae\x16\x7d\xc0\xe3\xac\xf9\xcf\xe0\x16\x93\x4f\xb3\x3e\xde\x9b\x31\xf5\x27\xb0\x96\x93\xd5\x7a\x4e\x23\x73\x1f\x90\x0a\x7e\x25\x8c\x93\x27\xb1\xf2\xd3\xc8\xeb\xbb\x3e\x68\x25\x5c\xfe\x15\xac\x26\x39\xfe\xd0\x06\xf0\x55\x94\x15\x7b\xec\x07\x12\xee\x3b\x39\x42\x12\x2f\x7f\x24\x29\xdb\x74\x24\x82\x6e\x82\x39\xd3\x44\x71\x94\x19\xc7\x28\xdb\xa0\xa7\x5f\x77\xaa\xdd\x37\xc2\xe8\x43\x5f\xa9\x81\x8d\xab\xc5\x87\x9f\xba\xc3\x5b\x08\x8a\x85\x0f\xdf\xf8\xde\xf9\xf7\x83\xd8\xdb\xa1\xcf\xeb\xbb\x0f\x5e\xc8\xd1\x24\xd1\x1d\xcf\x21\xf7\xb3\x27\x88\xe6\xb7\x8b\xf9\xe2\xd6\x27\x71\xc6\xbd\x65\x2e\x76\x5d\x6b\x46\x65\xf9\xcd\x40\xd4\x3d\x09\x30\xf5\x4b\xdb\xef\xd7\x7b\x83\x57\xd0\x4c\x35\x23\x46\x25\xfa\xee\xf6\xb1\x07\x5a\x16\xe8\x55\x2d\x51\x3b\x4d\x09\x4e\xe5\xc3\x4f\x45\x54\x89\x78\x60\x0a\x44\x9a\xfa\x76\x8d\x6b\x4d\x33\x82\x52\x03\xd1\x6c\x80\xb7\x1b\x7c\x29\xa0\x66\x42\x6e\x55\x57\x0a\xb5\x42\xae\x65\x47\xa6\xf3\x93\x3c\x6f\x0d\x4e\x55\x30\xa8\x70\xcf\x4c\x25\x35\x8f\x01\xc7\x39\x9b\x7f\x29\xeb\x6f\xd3\x94\x40\xaf\x87\x41\x10\xc5\x5e\x1c\x6c\xb0\x13\x85\x4c\xbb\x06\x61\x70\x76\x19\x81\xd5\x5c\x9d\x41\xf8\x22\x7e\x6e\x6d\xa7\x5f\x50\x05\x06\x4b\x21\x95\x05\x46\xf4\x17\xbf\x3f\x1d\x1c\x39\x9f\x93\xba\x10\xa6\x38\x50\x57\x91\xd5\x85\xef\x1f\x9b\xd7\xb9\xe6\xb1\xa2\x9d\x8a\x7b\x8f\x32\x5c\xb3\x02\x36\x40\x37\xc5\x09\x2a\xdd\xdd\x93\xce\x08\xa4\x8a\xc7\x25\x96\xda\x1c\xf8\x34\xb5\x93\xc8\xcf\x4f\xad\xa9\xd9\x06\x6d\xef\xc6\xe6\x0e\xb4\xf2\xb5\xf7\x58\x25\xb2\x5e\xf7\xc5\xa1\xfa\x3c\x99\x4e\x57\xd4\x7d\x0d\xd1\x3e\xf7\x05\xe8\xba\xf0\x69\x87\x1e\x01\x06\x0b\xe1\xe4\xce\x73\x4e\xd0\x15\x1c\x1e\x05\x1b\x8b\x3c\xae\x3e\xb6\x0f\x41\xad\xbd\x7d\x01\x4e\x53\x83\xd6\x1e\xfb\x0a\x9e\xf8\x7c\xcc\x0f\xdb\xfe\x3c\xbc\xfd\x93\x9b\x36\x2f\xa3\xb6\x35\xa5\xb6\xb2\x6d\x40\xe3\x5f\x42\x56\x24\x1e\xf3\x61\xba\x5b\x26\x0b\xf4\x2f\x6f\xbe\x96\x1e\x28\xf8\x72\x5d\x22\xa4\xd2\x60\xe2\xc8\xa8\x1c\x99\x7d\xc3\x74\x03\xc1\x90\x65\x42\x78\xe4\xa1\xa0\xd7\xf3\x42\xa1\xb7\x52\x9d\xbc\xad\x8a\xaa\x32\xba\x32\x92\xfc\xe9\xbb\x5b\xdb\x7a\x48\x04\x56\x12\x0d\xc3\x5e\xb0\x33\x2b\x5d\xd5\x45\xdb\x25\x7d\xf3\x22\x61\xc7\xe4\xa5\x36\xd8\x7f\x01\xa5\xa3\x14\x5d\xfc\x08\xd4\x8f\x8a\x36\xd1\xb1\xac\x0a\x7d\xb0\x23\x0a\x9d\xc6\x5a\xb9\x73\x95\xbd\xbe\xbc\xdc\x4a\x97\xd7\x9b\x30\xd1\xe5\x65\x49\xd3\x57\x51\x88\xcb\xc1\x17\x25\xe2\xae\xdb\xc7\x39\x2c\x85\xb5\x7b\x6d\x52\x58\x1a\x5d\x56\xce\xb2\x56\xb7\x8f\xf3\x78\xbc\x11\x34\xd6\x55\xed\xf7\xca\x7f\x6f\x2f\xce\x33\xdf\xe6\x40\x71\xe5\x4e\x1f\xf8\x5a\xea\x9c\x44\xf7\xcb\x49\x14\x91\xb0\xa3\xb9\x23\xc4\xd3\xc7\x84\x8b\xab\x77\x6c\x91\x33\x3b\x84\xc1\x7f\x02\x00\x00\xff\xff\x0a\x78\xfe\xc0\xc5\x18\x00\x00")
+var _vaultedExec1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x59\x5d\x6f\xdb\xb8\xd2\xbe\xd7\xaf\x18\xe0\x2d\xb6\x09\xe0\xa8\x48\x77\xaf\x02\xf4\xc2\x9b\xb8\x89\x91\xd6\x31\x2c\xa7\x7d\x8b\xf5\x22\xa0\xad\x91\x4d\x84\x22\xb5\x24\x65\xd7\x28\xce\x7f\x3f\x98\xa1\x28\xc9\xae\xdb\x6d\xf7\xe0\x9c\xab\x36\x34\x39\x9f\xcf\x7c\x2a\x9d\xdf\xc1\x56\xd4\xca\x63\xbe\xb8\xc0\xcf\xb8\x82\xcb\x24\xcd\xee\x60\x32\x7c\x3f\x4a\xd2\xe9\x34\x69\x7e\x04\xfe\x6d\x71\xc1\xff\xd6\x1e\x1d\xb8\x0d\x2a\x05\x2b\x53\x96\x42\xe7\x0e\x76\xd2\x6f\x40\xc0\x5a\x6e\x51\x07\x8a\x60\x2c\x58\xa3\x90\xe9\x65\x9f\x26\x0f\xd3\x6c\x9c\x31\xcd\x45\xf1\xfb\xa2\xb8\xee\x53\x5e\x14\x33\xf8\x63\x51\x8c\x1f\xa6\xf3\xf1\xc3\x24\x5b\x14\xd3\x3f\x61\x51\x8c\xb5\x28\x71\x51\x4c\xe9\xbf\x91\xd1\xa2\x98\x26\xe9\xd2\xfe\x13\x1a\xf4\x60\x71\xb1\xb8\xa0\x8b\xff\x01\xc5\x48\x46\x38\x57\x97\xd8\x10\x13\x56\xff\x3d\x93\xec\x0e\x6e\x46\xd9\xf5\x6c\xcc\xf4\xd8\x14\xa3\x9f\x36\x67\x0a\xf4\x86\x6e\x2e\xa5\x46\x07\x7e\x83\x50\xa0\xf0\xb5\x45\x97\x14\xd6\x94\x70\xa8\x08\x13\x26\x69\x98\x24\xdd\x6e\x98\x34\xae\x94\x46\x83\x29\x8e\x1e\x2d\x2e\xf4\xa2\x98\x2d\x7e\x49\x59\xe8\x46\xff\x24\x9d\x4f\x93\x4e\xc3\x13\xfa\x27\x59\x85\x2b\x59\xc8\x28\x56\xad\x14\x0c\x67\x13\x12\x9d\xfe\x26\xf1\x81\xfc\x41\x0c\xdb\x03\x6f\x20\x90\x4a\x21\x43\x24\x06\xc3\x2c\x7b\x7c\x3f\x9e\xdc\xc2\x10\x66\x0f\xef\x46\x64\xd8\x25\x2a\xb3\x83\xc2\x58\xc8\xd1\x0b\xa9\x1c\x18\x0d\x1b\xb3\x83\x0f\x8d\xc4\x81\x84\x63\x92\x2e\x4d\xd2\xf1\x34\x99\x11\x75\x3e\xaf\x58\xcb\x52\xec\x61\x89\x50\xa1\x2d\x8c\x2d\x31\x67\x8b\x98\xda\x83\x63\xa9\xf7\x52\xaf\x41\x34\xc6\xf6\x06\x5c\x25\x76\x1a\xc8\xa2\x69\xf2\x71\x83\x1a\xa4\xde\x9a\x67\xcc\xc1\x6f\xa4\x83\x9d\xd8\x0f\x60\x65\x31\x47\xed\xa5\x50\x0e\x84\x45\x70\xa6\xb6\x2b\xcc\xf9\x11\xe4\x58\x30\x29\x65\x56\x82\xf8\x3b\x38\xc3\x74\x9d\x26\xa8\xb7\xd2\x1a\x5d\xa2\xf6\x03\x58\x19\x5d\xc8\x75\x6d\xf9\x06\x14\x52\xa1\x1b\x80\xd4\xce\x0b\xbd\x42\xa8\xac\xa1\xa3\x01\xa0\x5f\xa5\xe7\xe9\x91\x03\xb4\x59\x5c\x38\x74\x4e\x1a\x72\x56\x72\x23\x9d\x58\xaa\xc6\xf4\x6b\xd4\xd8\x10\x25\x5b\x63\x59\x19\x2b\xec\xfe\x50\x62\x9d\x83\x3d\xb4\x51\x0a\xf3\x0d\x26\x15\xda\x52\x68\xd4\xfe\xe0\xba\xf3\xc6\x62\x0e\x52\x33\x83\x60\x26\x52\xba\x76\x7c\xea\x3c\x8a\xfc\xb4\xe1\x57\x42\x1f\x1a\x5e\x14\x1e\x6d\x30\x70\x30\x7a\x40\x7f\xed\xe8\x2f\xa2\x7e\x02\x65\x49\x84\x6d\x8b\x63\x46\x56\x0f\x48\x7b\x53\xc3\x4e\xba\x4d\x0f\x51\x47\x16\xb3\x58\x58\x74\x1b\xa2\x96\x79\x61\x3d\x08\xd0\xb8\x83\xc6\x88\x81\x32\x1d\x7c\xdb\x5e\x02\x1a\x1a\x9c\x22\x2a\x19\x6c\x7c\xcc\xc7\xb9\xcd\xe2\xa2\x71\x01\x2e\x2e\x9e\x71\x4f\x2c\x6f\x9b\x03\x26\xa4\x8c\xc8\x41\x68\x98\x65\x43\x78\xc6\x3d\x48\xed\x0d\xab\xc2\x56\xc1\x3c\x0a\xf5\xd2\x41\x96\xdd\x81\x58\xa3\xf6\x27\xd9\x54\xd6\x7c\xde\x2f\x2e\xf8\x02\x71\x19\x7d\xae\x8c\x6b\x50\x80\x9f\x3d\x5a\x2d\x54\x47\x02\x4e\x73\x39\x49\xd9\xc9\x35\xb9\x67\x71\x51\x5b\x4a\x20\xc9\x75\x83\xd5\x48\x5c\xe7\x95\x91\x81\x64\xed\x90\x83\x93\xf8\x90\x36\xcd\xd3\x14\xae\x6b\x6b\x51\x7b\xb5\x07\xa3\xd5\xbe\x85\x3b\xe6\x89\x37\xb0\x33\xf6\x39\x18\xfd\x4e\xb8\x8d\xbc\x36\xb6\x0a\xf1\xdc\xd2\x76\x7f\x23\x98\x43\xeb\x4e\x88\xc6\xe7\x1c\xc3\x72\xad\xa3\x50\x8e\x25\xdc\x51\x24\xf7\x44\x04\xe9\x00\x35\x05\x4e\x1e\x92\xdd\xf0\x63\x06\xf7\xa3\x4f\x9c\x9d\xff\x20\xcc\xa1\xf6\x7f\x5e\xc1\xff\xc1\xd9\xc7\xbb\xd1\x04\xde\x3f\xdc\x8c\xdf\x7e\xa2\xe4\x34\xbf\x1b\x65\x23\xb8\x79\xb8\xce\x06\x30\x7c\x97\x3d\xc0\xe3\xf4\x66\x38\x1f\x5d\xf5\xea\xa9\xde\xa6\x97\x69\x49\x7e\xce\x93\xf6\x94\xb1\xce\xe7\xe7\xcc\x24\x66\xb0\x9a\xbc\xf6\xe3\xa1\xe7\x4d\x0c\x72\xec\xf0\x9a\xf4\x5f\x85\x70\x22\x7d\xb2\x79\xc6\x91\x0d\x16\x5d\xad\x3c\x1d\x1f\xa7\xae\xce\x33\x44\x59\x09\xe7\xc9\x5c\x09\xf1\xcb\xeb\x5e\x26\x69\xf9\xc7\xa0\x39\xeb\xbd\xdc\x4a\x71\x54\x48\x30\x97\x84\x4a\xca\x5d\xd3\x69\x32\x3f\x19\x57\x65\xed\x3c\x2c\xdb\x44\x02\xc6\xe6\x68\xbb\x20\x06\x11\xea\x5e\xd3\x3a\x8c\x27\xc6\xe3\x55\xa8\x09\x2b\x41\xc0\x8b\x06\x6c\x12\x6e\x70\x7c\xbd\x74\x5e\xfa\x9a\x75\x3d\x6d\x54\x02\x5e\x72\x32\xd2\x07\xa1\x4c\xf6\xee\x52\x6a\xa9\xac\xd9\xca\x9c\xeb\x55\xe4\x48\x15\x45\x1b\x0f\xa5\xf0\xab\x4d\xe2\x37\xc6\x21\x29\x20\x4e\x84\xd7\xb1\xa3\xc9\x2d\x94\xe5\x73\x61\x73\xe8\x95\x04\xd8\x0a\x2b\x43\x1e\x27\xb8\xf6\x84\xb8\x4a\xd2\x59\x46\xf9\x15\x16\x67\xcb\x1a\x5e\x37\x51\x31\xfc\x98\x3d\x0d\xaf\xaf\x47\x59\xf6\x74\x3f\xfa\xf4\x34\xbe\xa1\x78\xa0\x7e\x66\xa8\x41\xf2\xdb\x42\xa2\x65\x62\xa4\x95\x58\xad\xd0\x39\x8a\x80\x14\x1e\xb5\xfc\xab\x66\x85\x50\xac\x36\xe0\xd0\x93\x8b\x3b\x6b\x19\x7b\xda\x3e\xe9\x69\x29\xb2\xd1\xf5\x6c\x34\xef\x09\x13\x25\x21\xe4\x39\x5c\x59\xf4\xc1\xc7\x31\x30\x2d\xfe\x55\xa3\xf3\xee\xbf\x20\x49\x96\x8d\x1f\x26\x4f\xf3\x87\xfb\xd1\x84\x3a\x94\x57\x70\x20\xe6\xe3\x6c\x3c\xff\xd4\xfe\xca\x32\x4e\x83\x77\xf3\x90\x20\x9a\x4a\x74\x92\xe5\xf7\x48\x81\x74\x11\x27\x9c\xe1\x5c\x5d\x55\xc6\x7a\x50\xb8\x16\xab\x3d\x64\x37\xf7\x24\xf2\x6c\x14\x32\xcd\x61\x9b\xf3\xbf\xcb\x38\xc3\xa3\xc6\x2b\x16\x68\xd7\x74\x6f\x39\xa0\xf4\x1b\xb4\x01\xcb\x4c\xe6\xa5\x3b\x6a\x55\xce\xb6\x52\x24\xa7\x63\x1d\x8c\xed\x91\xa2\x9c\xf0\x8d\x92\x0e\xa6\xf2\x5f\x45\x47\x21\xad\xf3\x6d\x6a\x0b\x55\x77\x25\x56\x1b\xfa\x6f\x9b\x74\x22\x9a\x99\x35\x9c\x31\xc5\x5e\xfb\x96\xf4\xfa\xfe\x9d\x70\x9d\x34\xe7\x4c\xae\x8e\xf5\xb1\x4b\x87\x91\xb0\x37\xb1\x4d\xe1\x0b\x8d\x7d\x78\x8c\x59\x09\xa5\x28\x8b\x4a\x07\x42\x29\xb3\x0b\x24\x7a\x0f\x97\x18\x04\xcd\x59\x3c\x01\xca\xe8\x35\xda\x2e\x7d\xfa\x8d\xd0\x3d\xaa\x89\x35\xd4\xf1\x0b\xa5\x60\x27\x95\x0a\x44\xe1\xac\x14\x9f\x65\x59\x97\x84\xff\x4b\xd8\x98\xda\x9e\x87\xd4\xdd\x4f\x47\x6d\x30\x33\xa1\xd0\xc5\x25\xc2\xb6\xfc\x05\xb1\xee\x1a\xa5\x28\x23\x37\x7c\xc8\x21\x58\x6b\x2f\x15\xfd\xb8\xe7\xec\x2f\x96\xa6\xe6\x92\xc2\x3d\x0d\x26\x67\xc6\x1e\xbc\x94\xae\xd7\xf7\x30\xdd\x53\x4d\x55\x93\xe5\x3f\x99\x9a\x11\x25\x94\x33\xb1\xb7\x06\xd1\xeb\xfe\x49\x8e\xe8\xee\xa0\xba\xa7\x50\xf3\x14\x31\x2b\x8b\x5c\xd1\xfa\xe3\x03\xb7\xd3\xd2\x0f\x40\xc9\x67\x04\x67\xae\x98\x0d\x67\x43\x5d\x1c\x4f\xa8\x11\x5f\x90\xd5\x15\x5a\x6e\x45\xe9\x30\x4e\x3d\x49\x5a\xc8\x10\x81\xd3\x69\xb2\xdb\xc8\xd5\x06\x76\xa6\x56\x64\x18\x67\xd4\x16\x63\x83\xc4\xcc\x85\xd5\x8d\xa2\xc2\xea\x2b\xb1\x73\x57\x52\x94\x57\x57\x97\x97\x97\xaf\x5f\xbf\xfe\xf5\xd7\x5f\x7f\xfb\xed\xb7\x2b\x52\xeb\x55\xcb\x2b\x4e\x4d\xd3\x69\x98\x19\x7a\xc8\xa4\x8b\xa1\xb2\x44\x17\x1f\x17\xd6\xd3\xd5\x59\x3a\xb8\x4c\x08\x08\x03\xb0\xb8\x16\x36\x57\xe8\x5c\x7c\xd2\x92\xe8\x02\xae\xdf\x25\x1c\x87\x6d\x90\x6c\xac\x41\xe4\xb9\xf4\x0d\x6e\xc3\xed\x58\x75\x3a\x42\x62\x69\xb6\x38\x68\x3d\xd5\xe4\x35\xd7\xbe\x15\x2a\x39\x5d\xb8\x18\x20\x52\x53\x14\x05\xe1\x1a\x78\x35\x8d\xfa\x37\x0a\xd9\x87\xe1\xe3\xbb\xf9\xe8\xe6\x69\x34\xf9\xf0\x44\xf9\x90\x0a\xc9\xc3\xe3\x64\xde\x2b\x69\xf3\x50\xc0\x4c\xad\x3d\x8c\x6f\x0e\x9a\xff\x26\x14\xd2\x1f\xa1\x3b\x9b\xf4\x09\x76\x53\xea\x3f\x23\x47\xab\x92\x3e\xbd\xaf\x06\xdc\x9f\xa0\x35\x1d\xce\xe6\x63\x1a\xb6\xfb\x04\xa9\x55\xa8\x84\xf5\xf2\x00\x2b\x3f\x4d\x79\x7e\xd7\x27\x5a\x09\xbf\xf9\x06\xad\x26\x38\xde\x1a\x0b\xf8\x59\x94\x95\xc2\x1f\x0b\xb8\xbf\x89\x11\xe2\xf8\xea\x47\x82\x32\x86\x23\xa5\xf6\x06\xcc\x85\xa1\xe4\x48\x91\xd1\xa1\x6c\x89\x21\x71\xfb\x43\xe9\xbe\x03\xa3\x37\x7d\xa1\x92\x53\xb8\x78\xf3\x53\x3a\x24\x27\xb1\xf0\xe6\x3b\xbf\xb7\xfe\x7d\x23\x76\x2e\x39\xe9\xa4\x37\x81\x49\x67\x92\xec\x0e\x3e\x0c\x67\xe3\xe1\xef\xef\x46\x30\x9e\xcc\x47\xb3\xe9\xc3\xbb\x61\xbb\x40\x9a\x73\xf0\xaa\x1a\x5d\xd7\x9e\xdb\xd3\x13\x03\xe5\x64\x8b\x05\x5a\xd4\x2b\x04\xc3\x15\xfe\x30\x68\x1b\xf0\xbc\xf8\x42\x7a\xfc\x2b\xe4\x32\x98\xc5\x27\x61\x50\x68\xf2\x64\xd3\x28\xf5\x8a\xc4\x4b\xd7\xa3\x46\x37\x1d\x52\x69\xa6\x51\x3f\x69\xb2\xbf\x74\x11\x66\xe7\x83\xde\xb4\x1f\x53\x15\x49\x3e\x38\x4a\x47\xb1\x9b\x82\xe5\x3e\x66\xa2\x84\xf7\x28\xf0\x15\xd0\x17\xc5\x6c\xd0\x6b\xce\x66\xa3\xdb\x10\x48\x03\x30\x16\x7e\x24\xc7\x9c\x0f\xb8\x34\x79\x5a\x81\x08\x1a\x5b\xe1\x60\x57\xc3\xc6\x14\x3e\xcc\x27\x29\x7c\x68\x65\xe4\x53\x7a\xf0\xd2\xb3\xd2\xbd\x52\x22\x74\x42\x95\x6d\x1f\x7c\x94\x1e\x07\xd6\x80\x3b\x96\x28\xdd\xef\x8f\xd7\xf7\xa3\x39\x35\x46\x44\xc5\x9b\xe6\x58\x99\xb5\x5b\x5c\xbc\xf8\xf2\x1d\xe1\xc9\x57\x49\xc3\xd6\x1d\xbf\xec\xc3\x98\x88\x37\xa0\x68\x44\x08\x69\x3e\x48\xf6\xe8\x62\x9f\xf6\xe2\xc5\x17\xba\xda\x34\x32\xd2\xa3\x15\x2a\xfe\xf4\xa5\x01\xc6\x30\x1e\xd0\x4d\xb6\x81\x74\x64\x82\x10\xac\xc1\x65\xe1\xc6\x97\xa6\x35\x56\x58\x78\x10\x0e\xa4\x4b\x93\x21\x2b\xce\xcf\x6c\x87\x30\xe9\x1d\xaa\x02\xce\x72\x69\x71\xc5\x6b\x03\xba\x63\x4d\xbd\xde\x74\x88\x75\xe7\x8c\x24\x0d\x68\xad\xb1\x29\xc7\x80\xa9\x7d\x55\xf3\xd4\x20\xda\x05\x27\x31\x38\x73\x88\xdf\x98\x49\x63\xdb\xdb\xb2\xcf\x07\xb0\xac\x1b\x2d\x12\xa9\x3d\xda\xca\x28\x41\x4f\x82\x58\x61\x41\x10\xc6\x1c\x78\x3b\x7e\x37\xca\xda\x10\x6c\xc6\x1b\x5e\xe0\x9d\x0c\xbe\xef\xc9\x41\xc1\xb2\xb3\xd2\x7b\xe4\x7a\xcc\x4b\xa9\x24\x58\xc0\xd8\x7d\x03\x3a\x5a\x9f\xec\x63\x67\x15\x86\xb8\x25\x16\xc6\xe2\xc1\x4e\x57\x12\x77\x61\x3d\xe6\x61\x95\xd7\x51\x91\xae\xe9\xad\x58\xb0\xc6\x73\xff\x7f\x73\xfb\x34\x7b\x9c\xcc\xc7\xef\x47\x4f\x37\xe3\x19\xb9\xe9\xac\x76\xb5\x50\x6a\x0f\x02\x7c\x59\x15\xee\x7c\x00\xb2\x00\xe9\x99\x34\x7a\x0a\xa6\xa4\xd1\xcc\xed\x9d\xc7\xf2\xa5\xeb\x0d\x4a\x1d\x3f\xf6\xd6\x4e\x3a\x4c\xe1\xad\x6c\xa3\xa4\x6b\x07\x63\x88\x27\x1b\xb1\xc5\xf0\x63\x3c\x8a\xf0\xf7\x47\x75\x8a\xac\xdb\xeb\xad\x0e\x77\xd9\xd2\xc7\xb9\xdd\x68\xcf\x33\x63\xf7\x88\x9b\x28\xb3\x45\x1b\xad\x1c\xc3\xfc\xc0\x3c\x16\x4b\xb3\x25\xc3\x65\x72\xad\x85\xa2\x83\x15\xca\xed\x41\xee\x69\x52\xa0\x12\xfb\x30\xc7\xf6\x64\x18\x80\x33\x49\xc7\x91\x2e\x72\x07\xdc\x90\xed\xf2\x65\xcf\x57\x0c\x32\x5b\x57\x3e\x6e\x9f\x68\x57\x75\x3f\xfa\x04\xd9\xf8\x76\x32\x9e\xdc\x86\x76\xad\x60\xc7\xb3\x9d\x1a\xcf\x33\x4a\x8e\x97\x66\xed\xda\xb8\x89\xc5\xfe\x4e\xa7\xb7\x9c\x4b\x9a\xcd\xd7\xa0\x85\x53\x7b\x8f\xc5\x8b\x9a\xf6\x26\x1b\x51\x7b\x43\xad\xdc\x8a\x81\x41\xef\x41\x28\x45\xc4\x5c\x22\xf2\xbc\x33\x45\x5c\x53\xd2\x90\xd9\x5c\x80\xaf\x2f\x84\xa6\x9f\x06\x4e\xb9\xd6\xed\xb8\x64\x34\xf2\xbc\xd3\xf5\xb4\x61\xdb\xcb\x57\x93\x43\x11\x2c\x6a\xdc\x71\x4f\x2a\x8d\x6d\xe7\x16\x11\x3b\x5a\xd4\x2e\x68\x23\x42\xd7\x19\xe4\x60\x87\xec\xc4\xde\x25\x5b\xa1\x64\xde\x4e\x51\x27\xf7\x5b\xe4\x4d\x9e\xe0\x40\x84\x41\xef\xd8\xda\xde\x3c\xa3\x4e\x2c\x96\x42\x6a\x2a\x5d\x4a\xe6\x41\xf1\xfb\xc3\xe5\x22\x77\x6e\xab\x5a\x09\xab\xf6\x50\x3b\x2c\x6a\x15\xa0\xd0\x7c\xc1\x69\xe6\xa9\xc6\x39\xfd\xc5\x3d\x4f\x27\x09\x1b\x60\xdd\xae\x8e\x69\x3c\x6d\xd7\xfe\x54\x93\x16\x17\x25\x96\x84\x60\x7a\xed\x0d\x38\xc4\xbc\x67\x6a\xb6\x41\x9c\xef\xd9\xdc\x89\xd1\x21\x13\x74\xf3\x40\xd1\x9b\xd0\xb9\xca\x3c\x0d\x6f\x6e\x38\x1b\x9c\x6a\xf0\x9b\x4c\xd0\xc2\x27\x2e\xc6\x04\x07\x86\x97\xdb\x10\xb5\x49\x3b\x5a\x90\xb9\xa2\x45\x1e\x67\xef\xe2\xc7\x82\x36\x42\x79\xd4\xca\x73\x8b\xce\x75\x13\x24\x6f\x05\x03\xe6\x4f\xdb\xfe\x18\xde\xe1\xb3\x8c\xb1\xcf\x83\xb8\xbe\xd8\x4a\x01\x71\x49\xb1\xf8\x25\x65\x41\x16\x17\xfc\x98\x8b\x9b\x54\x18\xbe\xce\x84\x9c\xb8\x27\xf0\x6d\x4c\x89\xfd\x2c\x66\x41\x1c\x18\xa6\x5d\x1a\x9d\xb2\x4c\x0a\x8f\xdc\xd4\xf4\xf2\x3c\x28\xb3\x96\xfa\xe0\xfb\x9b\xa8\x2a\x6b\x2a\x2b\xc9\x9f\x61\x03\xe2\xa2\x87\x44\xe2\x24\x17\xe5\x9d\x60\x67\x56\xa6\xaa\x55\x9c\x87\xbf\xab\x48\xd7\x5a\x94\x54\x13\x7a\x5f\xc9\xe8\x29\xa1\x8b\x3f\x14\xf4\x51\x11\x03\x1d\xcb\x4a\x99\xbd\x1b\x80\x0b\x9f\xdf\x16\xc5\xf5\xc6\xfb\xca\x5d\xbd\x7a\xb5\x96\x7e\x53\x2f\xd3\x95\x29\x5f\x95\xb4\xa1\x53\x4a\xbc\x3a\xf9\xd5\x81\x72\xd7\xed\xe3\x18\xa6\xc2\xb9\x9d\xb1\x39\x4c\xad\x29\x2b\xef\x58\xaa\xdb\xc7\xf1\xe2\x62\x29\x1c\x05\x6c\xfc\xbd\x0a\xbf\x47\xc5\x79\x29\xb1\xdc\x83\x43\xef\x0f\x3f\x02\xc5\xae\x67\x98\xdd\x4f\x87\x59\x46\xcc\x3a\x73\x67\xc7\x45\xf5\xec\xf2\x3c\xf6\x2d\x7d\x3b\xa4\xc9\xbf\x07\x00\x8f\x82\x1f\x76\xe9\x1e\x00\x00")
 
 func vaultedExec1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -200,7 +252,87 @@ func vaultedExec1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedLoad1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xce\x5f\x6a\x03\x21\x18\x04\xf0\x77\x4f\x31\x17\x88\xd0\x23\xb4\x69\x20\x16\xea\xca\x9a\x97\x82\x2f\xb2\x7e\x12\x61\xab\x41\xbf\xdd\x5e\xbf\x54\xfb\x8f\xbc\x0d\x0c\xc3\x6f\xe4\xe5\x8c\xdd\x6f\x2b\x53\x70\x87\xb5\xf8\x80\x07\x21\xed\x19\xfa\xf1\xf5\x24\xa4\x31\xe2\xbb\x44\xef\xdc\x01\x5b\xa3\x86\x17\x3b\x69\xdc\x6a\xd9\x53\xa0\x00\x2e\x68\x1c\x52\xfe\x0a\x4b\x25\xcf\x84\x52\x51\xe9\xb6\xfa\x85\xc0\x57\xc2\x52\x32\x53\x66\x94\x08\x3f\xb8\x8e\xd8\x37\x3d\x19\xab\x6c\x87\x5c\x7c\x72\xf1\xf8\x9f\x73\x71\x86\x8b\x2a\xfb\x77\x72\xd1\xf4\xc5\xf3\xc9\x1e\x67\x65\x2e\x6a\xd2\x7d\x34\x0f\xa4\xdd\x2b\x7f\x33\x7c\x24\xbe\x8e\xc3\x3f\xfd\xef\xf1\x3d\xf9\xf1\x5c\x8a\xcf\x00\x00\x00\xff\xff\x29\xac\xab\x44\x08\x01\x00\x00")
+var _vaultedExport1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x53\x4d\x6f\xdb\x30\x0c\xbd\xeb\x57\xf0\xd4\x0f\xa0\xd1\xd0\x9f\xd0\x76\x01\x9a\xc3\x12\x23\x0e\x36\x0c\xd0\x45\xb1\xe9\x58\xa8\x2c\xb9\x12\x9d\x34\xff\x7e\xa0\x24\x77\x69\x8b\x5d\x76\x93\xc9\x47\xf2\xbd\x47\x5a\xee\x9e\xe1\xa8\x27\x4b\xd8\xaa\x05\xbe\x8d\x3e\x10\xdc\x0b\x59\x3f\xc3\xfa\xe1\xc7\x52\xc8\xaa\x12\x25\x0d\x25\xab\x16\xe5\x15\x73\x61\x04\xf2\xa0\x1d\xa0\x6b\xc2\x79\x64\xe0\x7e\x72\xad\xc5\xd4\xa4\xfe\xbd\xde\x54\xf5\xaa\x4e\x8d\x54\xf7\xa8\xba\xa7\x8f\xed\x54\xb7\x85\x1c\x57\x0b\x9f\x3f\x56\xb9\x5e\x75\x15\x7f\x38\x3d\xf0\x53\x5d\x49\x29\x85\xdc\x87\xff\xea\x92\x33\x6a\xa1\xad\x55\xdd\x36\x31\xfb\xbe\xac\x9f\xb6\xab\x6a\xb7\xda\xac\x13\xb9\xcd\x88\x2e\x02\xea\xa6\x07\xdf\x01\xf5\x38\xab\x8b\x23\x36\xa6\x33\xac\xeb\x7c\x41\x08\x6e\x7c\x00\x6d\x6d\x81\xdd\xc1\xc9\x50\xff\x65\xd4\x2d\x68\xd7\x8a\x53\x30\x84\x91\x9b\x9a\x00\x8d\x77\x84\x8e\x38\x01\x03\x92\x6e\x35\xe9\x64\x21\x44\xe3\x0e\x16\x8b\x7d\xd0\x19\x8b\x77\x17\xae\xa6\xfe\x5a\x38\x3c\xcd\x88\x51\xc7\x78\xf2\xa1\x95\xf0\x98\x02\x11\x1a\xed\x60\x8f\x10\x30\x92\x0f\x73\xcd\xfb\x7a\xcd\xc0\x5e\xdd\xdc\xdf\xde\x01\xca\x83\x04\xf2\x62\xf0\x47\xfc\xb0\x46\x4f\x3d\x06\x18\x74\xd3\x1b\x87\xe0\x03\x47\xf7\xba\x79\x61\xf2\x03\x4c\xa3\x4c\x66\xcd\xf3\x5a\xef\xae\x09\x02\x92\x36\x8e\x11\xf0\x82\x67\xe6\x0d\x01\x5f\x27\x13\x70\x40\xc7\xd6\x04\x6c\xcc\x68\xf2\xdb\x07\xe8\x0d\xd3\x3b\x17\x9f\x45\x5e\x21\xb6\x85\x47\x9e\xf0\xb3\x70\xea\x35\xb1\xaa\x6b\x62\x5d\x7e\x44\x87\x2d\xe8\xc0\x03\x4a\x11\xdb\x68\xb1\x23\xf0\x13\xcd\x9b\xcb\xfe\x48\xd8\xf5\x08\xf8\x66\x48\x34\xbe\x45\x30\x11\xf0\x75\xd2\x96\x25\x31\xca\x4d\xc3\x1e\x03\xd7\x1c\x2f\x87\xf9\xc9\xb6\xe0\x7c\x1a\x38\x53\x93\xe9\x64\x36\xe9\x5a\x6a\x21\x77\x95\xf8\xbc\x68\xb1\x2c\xff\xc4\xdf\x93\x90\x9f\x80\x7e\xa2\x71\xa2\x2f\xd7\xf9\xed\xdf\x97\x2b\x7e\xbd\x1f\xce\xbc\xf3\xc2\x3d\x99\x7c\x09\x55\x57\x12\x1e\x1c\xab\x8d\x64\xdc\x21\x03\x4c\x64\x9f\xac\x6e\x58\xc1\x9f\x01\x00\x96\xc1\x11\x5f\xea\x03\x00\x00")
+
+func vaultedExport1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedExport1,
+		"vaulted-export.1",
+	)
+}
+
+func vaultedExport1() (*asset, error) {
+	bytes, err := vaultedExport1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-export.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedFixPermissions1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x92\x4f\x6f\xda\x40\x10\xc5\xef\xfe\x14\xef\xd4\x3f\x12\x58\xca\x29\x67\x0a\xae\x62\x89\x00\xb2\x49\xda\x4a\x96\xd0\xb2\x9e\x8d\x57\x59\x76\xd1\xec\x1a\xc2\xb7\xaf\x76\x0d\x2a\xb4\xa9\x72\xb2\x6c\xcf\xbc\xf7\x9b\x99\x97\xaf\x1f\x70\x10\xbd\x09\xd4\x36\x63\xa5\xdf\x9a\xf1\x9e\x78\xa7\xbd\xd7\xce\x7a\xdc\x65\x79\xfd\x80\xc5\xe4\xb1\xc8\xf2\xd5\x2a\x3b\x17\xe2\x9f\xba\x66\x0c\xe9\x98\x49\x06\x0f\x6d\x3d\xc9\x9e\x09\xd7\x05\xce\x0e\x2e\x50\xda\x90\x4f\xaa\xf5\xaf\xc5\x72\x55\x97\x75\x52\x6e\xd4\xb7\x46\x4d\xff\xa7\xdf\xa8\x2a\xb5\xcc\x8a\x7a\x5a\x95\xab\x75\xb9\x5c\xa4\xae\x69\x47\xf2\xd5\x23\x74\x7f\x99\x29\x08\x63\xce\x86\xc2\xb6\xf0\x94\xfe\x40\x0a\xd9\xd1\x80\x30\x82\xf0\x38\x92\x31\xf1\x19\x3a\xca\x5a\x1d\xf1\x1d\x6b\x4a\xef\x27\x08\x26\xbc\xd2\x3e\x40\xdb\x51\x52\x61\xda\xb9\x03\x79\xbc\xb0\xeb\xf7\xe9\x8b\x0b\x1d\x31\x8e\xac\xc3\x0d\x40\xf6\x45\xd8\x76\x04\xe5\xf8\x7d\x67\x26\xd1\x5e\xd7\x7f\xc5\xb1\x23\xa6\x3f\xb6\x2f\x2c\x6c\xa0\x36\x4f\x43\x16\x42\x76\x97\xed\xa6\xdd\x18\x82\x63\x5c\x78\x4f\xd0\x1e\x4c\x7b\xc7\xb1\x03\xdf\xa3\x45\x82\xbb\x1d\x48\x84\xa8\x9c\x59\x17\xe0\x8e\x96\x5a\x6c\x4f\x69\x6f\xb2\x67\x26\x1b\xd0\x7b\x62\x48\x61\x3f\x07\x6c\xe9\xca\x6e\x7b\xc2\xc7\xb7\x89\x76\x91\xfb\x82\x11\x33\x10\x48\x9c\x07\x78\x3e\x77\x32\xa9\xde\x47\x18\x07\xb7\xa7\x73\x20\x3c\x8e\x3a\x74\xef\x87\xa6\xb7\x86\xfc\x70\x9d\x81\xe1\x79\xf2\x34\x5f\x17\xb3\xcd\x64\x3e\x5f\xfe\xd8\x94\x8b\xba\x98\x3e\x55\xc5\x66\x55\x54\x8f\x65\x5d\x97\xcb\x45\xdd\xa8\x0a\x64\x0f\x9a\x9d\xdd\xc5\xb1\x0e\x82\xb5\xd8\x1a\x82\xf6\xf0\x14\xa2\xf7\x20\x75\xd7\xa8\xaa\xf9\x94\xa7\x58\x15\x3f\xcb\x35\xa6\xcb\x59\x31\x64\xb1\x54\x10\xf6\x74\x43\x22\x5d\x6f\x5a\x58\x77\xbb\x9c\x51\xda\x20\xbd\xe9\x00\xe9\xda\xe4\x71\x7f\x9f\x67\xbf\x07\x00\x52\x96\x19\xc2\x53\x03\x00\x00")
+
+func vaultedFixPermissions1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedFixPermissions1,
+		"vaulted-fix-permissions.1",
+	)
+}
+
+func vaultedFixPermissions1() (*asset, error) {
+	bytes, err := vaultedFixPermissions1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-fix-permissions.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedHistory1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x92\xdd\x6e\xe2\x30\x10\x85\xef\xfd\x14\x73\xb7\x20\x51\xab\x3c\x02\xdb\x46\x22\x12\x04\x94\x64\x59\x55\x8a\x54\x99\x78\xdc\x58\x35\x76\x64\x9b\x64\xf3\xf6\x2b\x3b\x09\xa5\xf4\x0e\xe6\xe7\x9c\x2f\xc7\x43\xcb\x2d\x74\xec\xaa\x3c\xf2\xea\xa9\x91\xce\x1b\x3b\xc0\x9a\xd0\x62\x0b\xd9\x66\x9f\x10\x7a\x3c\x92\xa9\x0f\x73\xbb\x7a\x02\x25\x9d\x77\xe0\x1b\x84\xd6\x62\x27\xcd\xd5\xa9\x01\x1c\x32\x85\x1c\x3a\xb4\x4e\x1a\xed\xc0\x08\x60\xa3\x78\xd4\x2b\xde\xb2\xc3\xb1\x48\x8b\xa8\x59\x89\xdf\x95\x78\x79\x50\xae\x44\x0e\x95\x48\x35\xbb\x60\x25\x8e\x71\xe9\x35\x29\x5e\xf2\xf4\x58\xa6\x87\x2c\xee\xed\x6e\xc6\xf7\x36\xf1\x7f\xd0\x02\xd7\x62\x2d\x85\x44\x0e\xe7\xe1\x4e\x0b\x7c\xc3\x3c\x34\xac\x43\x38\x23\x6a\xf8\xc4\xd6\x83\xd4\x20\xbd\x23\x93\xf9\x0a\x34\xf6\xe8\x3c\x08\x69\x9d\xa7\x90\xb0\xba\x01\x25\x35\x82\x6b\x4c\xff\xcd\x13\xf4\xf5\x72\x46\x0b\x4c\xf3\x58\xf6\xf2\x82\xd1\x81\xcc\x03\x3d\x73\x53\x1c\x34\x62\xff\x6d\x50\x63\x17\x56\x26\x4e\x39\xf7\x61\x81\xf4\x83\x8e\xb4\xf7\x91\x20\x97\xbe\x12\xf9\xea\xa1\xac\x0c\xe3\xb1\x6c\xec\x43\x86\x2d\x73\xae\x0f\xbd\xe5\xea\x1b\xeb\x19\xa5\xfe\x00\x8b\xad\x62\x35\xf2\x60\x3c\x7f\xfc\x2d\xb5\x5f\x6e\x7e\x01\x4a\x4e\x73\xac\xcc\xe2\x38\x89\xba\xb6\x43\x1b\x99\xfe\xb1\xda\xab\x01\x58\x4c\x63\x80\x1e\x2d\x4e\x9f\xb1\x02\x67\xc0\x62\x10\x09\x76\x6c\xb6\x27\x4c\xdd\x1a\x38\x5d\x4c\x00\x35\x96\x83\xf4\x77\x39\x41\x2f\x7d\x33\x86\xb5\xb9\xb1\xd7\x2c\xf0\xcf\xeb\xe3\xcc\xd7\xb9\x4e\xe5\xc5\x7a\x49\xe3\xad\x24\xd9\x29\xcd\x0f\xd9\x3e\xc9\x4a\x42\xcb\xf9\xc6\x4e\x9b\x3f\xbb\x32\x79\x7d\xdf\xa6\x45\x79\xc8\xdf\xde\x77\xe9\x3e\x2d\x2b\x91\x93\xb2\xc1\xf9\x21\x8d\xf8\x3a\x27\x6f\xe0\x13\xb1\x05\x61\x2c\x20\xab\x27\x3f\x58\x70\x14\xe1\x47\x1c\x58\x3f\x2f\x29\x1c\x14\x47\x4b\xba\xfb\xbc\xb8\x74\x35\xb3\x3c\x90\x36\xa8\x7f\x3e\x36\x85\x02\xbd\x0f\x01\x85\x20\x94\xbc\x48\x1f\xe4\x46\xd0\xe7\x00\xc5\xa5\x63\x67\x85\x2e\x32\x84\x41\x8d\xfd\x8d\x8d\x92\xff\x03\x00\x64\xb2\x70\x5c\xaf\x03\x00\x00")
+
+func vaultedHistory1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedHistory1,
+		"vaulted-history.1",
+	)
+}
+
+func vaultedHistory1() (*asset, error) {
+	bytes, err := vaultedHistory1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-history.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedImport1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x93\xcf\x6e\xdb\x30\x0c\xc6\xef\x7e\x0a\x9e\xb6\x04\x48\x0c\xf4\x11\xb6\xae\x40\x73\x58\x63\xd4\xb9\x0c\x53\x0f\x8c\x45\xd5\xc2\x1c\xca\x95\xe4\xfc\x79\xfb\x81\x92\xdc\x24\x3b\xec\x66\x98\xd4\x4f\xdf\xf7\x91\xaa\x77\xcf\x70\xc4\x69\x88\xa4\xd5\xda\x1e\x46\xe7\x23\x3c\x54\x75\xfb\x0c\x2f\xdf\x7e\x3e\x55\x75\xd3\x54\xa5\x0c\xa5\xaa\xd6\xe5\x2b\xe4\x83\x01\x8c\x77\x07\x40\x06\xe2\xce\x5f\x46\x69\xdd\x4f\xac\x07\x4a\x98\xf6\xd7\xcb\xb6\x69\x37\x6d\x42\x29\xf3\x5d\x99\xc7\x7b\xa0\x32\xaf\xf0\x5b\x99\xcd\xb6\xd9\x6d\xb6\x2f\xad\x32\xcd\x1b\x28\xb3\xc9\x08\x65\x9a\x54\x64\x3c\xc8\xb7\xfa\x52\xd7\xf5\x5b\xe2\xfe\x78\x6a\x1f\x5f\x37\xe9\x4c\x42\x6f\x47\xe2\x00\xb1\xa7\x72\x39\x18\x3b\xd0\x3d\x68\xd1\x79\x42\xb9\xf8\x64\x63\x7f\x75\x4d\x67\x91\xb1\x78\x58\x2e\x01\x59\x43\x6e\x0a\x80\xd9\x38\x18\xe7\x81\xb0\xeb\xc1\x99\x84\x2f\xa6\x6d\x84\xce\x71\x44\xcb\x01\x16\xce\x83\xe3\xe1\x72\x5b\x0f\x23\x75\xd6\x58\x09\xe3\x52\x5d\x1d\x2c\x6b\x78\x12\x58\x66\xdb\x00\x81\x70\x98\x25\x21\x30\x9d\x60\xc4\x10\x4e\xce\xeb\x15\x9c\x7a\xdb\xf5\xd2\xe4\xe9\x63\xa2\x20\xd2\x67\x35\x59\xdc\x0a\x26\x1e\x28\x04\xc8\xc1\xaa\xb5\x5a\x17\xbb\xeb\x99\x22\xf1\xda\x1b\x39\x75\x4a\x6b\xd7\x13\xd0\x39\x79\xd0\x24\x75\xfa\x98\x70\x80\xe8\x92\x05\x9e\x0e\x7b\xf2\x62\xb8\x98\x89\x3d\x4a\xeb\x34\x68\x60\x17\x61\x4f\x65\x76\x09\xd7\x3e\x43\x99\x5d\x55\xef\x9a\xea\xbf\x52\xaa\x96\x70\xc8\x63\x9a\x09\xf3\x1d\x29\x81\xeb\xfc\xbe\x86\x9b\x20\x2c\x87\x48\xa8\x45\x51\x89\xc2\xf2\x7b\x75\x1f\xd7\x75\x50\x09\x58\xff\x23\xc6\xb1\x5a\x77\x8e\xcd\x60\xbb\xb4\x72\xca\x6c\xb0\x8b\xd6\xb1\x32\x4d\xd5\x96\x74\x02\x9c\xc4\xa9\x0d\xa0\x1d\x53\x96\x94\xe5\xe8\xbb\x28\x7a\x3c\x52\xd2\x1a\xf0\x40\x20\xa3\x05\x0c\x80\x5c\xd1\xd9\x26\x6d\x45\xc3\xdd\x2d\x70\xc0\x8b\x44\x97\x35\x85\x3f\x76\x14\x1d\x0b\xc1\x68\x32\xd2\xbf\x94\x01\x0c\x34\xc3\x67\x58\x59\xc4\x89\xbb\x1e\xf9\x9d\xf4\xaa\x20\x3c\xe5\xa5\x7a\x95\x63\xe5\x75\x7e\x6e\x20\x4c\xac\xc9\x97\x95\x4a\x0a\x17\xfb\x0b\xe0\x38\x12\xeb\x12\x5e\x9e\xb2\x9c\x8d\x21\xb5\x2c\x57\xe0\x7c\x81\xbb\x23\xf9\x93\xb7\x71\xe6\x7b\x1a\x07\xec\xb2\x30\x59\x7c\xe2\x38\xbf\x88\x7b\x9d\x35\xc8\x72\x8d\x9e\x8e\xd6\x4d\xe1\xb6\x77\x46\x46\xe2\x39\xcd\x0e\x59\x22\xf1\x14\xa2\xf3\xe5\x19\x54\x9f\x2f\xb3\xfc\x5e\x3c\x2c\xeb\xea\xef\x00\xfc\xb2\xa0\xc8\xa9\x04\x00\x00")
+
+func vaultedImport1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedImport1,
+		"vaulted-import.1",
+	)
+}
+
+func vaultedImport1() (*asset, error) {
+	bytes, err := vaultedImport1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-import.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedLoad1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\xce\x5f\x6a\x03\x21\x18\x04\xf0\x77\x4f\x31\x17\x88\xd0\x23\xb4\x69\x20\x16\xea\xca\x9a\x97\x82\x2f\xb2\x7e\x12\x61\xab\x41\xbf\xdd\x5e\xbf\x54\xfb\x8f\xbc\x0d\x0c\xc3\x6f\xe4\xe5\x8c\xdd\x6f\x2b\x53\x70\x87\xb5\xf8\x80\x07\x21\xed\x19\xfa\xf1\xf5\x24\xa4\x31\xe2\xbb\x44\xef\xdc\x01\x5b\xa3\x86\x17\x3b\x69\xdc\x6a\xd9\x53\xa0\x00\x2e\x68\x1c\x52\xfe\x0a\x4b\x25\xcf\x84\x52\x51\xe9\xb6\xfa\x85\xc0\x57\xc2\x52\x32\x53\x66\x94\x08\x3f\xb8\x8e\xd8\x37\x3d\x19\xab\x6c\x87\x5c\x7c\x72\xf1\xf8\x9f\x73\x71\x86\x8b\x2a\xfb\x77\x72\xd1\xf4\xc5\xf3\xc9\x1e\x67\x65\x2e\x6a\xd2\x7d\x34\x0f\xa4\xdd\x2b\x7f\x33\x7c\x24\xbe\x8e\xc3\x3f\xfd\xef\xf1\x3d\xf9\xf1\x5c\x8a\xcf\x01\x00\x29\xac\xab\x44\x08\x01\x00\x00")
 
 func vaultedLoad1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -220,7 +352,7 @@ func vaultedLoad1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedLs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x41\x0a\xc2\x30\x10\x45\xf7\x39\xc5\x3f\x40\x1b\xf0\x08\x5a\x0b\x2d\x68\x1b\x3a\xdd\x08\xd9\x14\x9a\x40\x60\x68\xc4\x99\x7a\x7e\x21\x0a\x82\xb8\xfd\xef\x3d\xbe\x9d\x3b\x3c\x97\x9d\x35\xac\xbe\x66\xc1\xc1\x58\xea\x30\x1c\xaf\xad\xb1\xce\x99\x0f\x02\x0b\x7c\x0d\x4e\xa2\x82\x85\xf9\x9d\x48\x71\xe9\x36\x8c\x8e\x7a\x2a\xbe\x8f\x27\x1f\x9b\x6f\xe5\xe3\xf4\x6f\x4f\xa2\x85\x50\x87\x73\x4b\xcd\xd4\xbb\xb9\x1f\x87\x62\x5e\x7e\x3e\x2a\xe4\x2d\xe0\x1e\x1e\xe0\xb4\x85\x0a\x9a\x21\xba\xe6\x5d\xad\x79\x05\x00\x00\xff\xff\x8d\xa8\x1b\x0a\xbc\x00\x00\x00")
+var _vaultedLs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x92\xc1\x6e\xdb\x3c\x10\x84\xef\x7a\x8a\x39\x05\x09\x20\x0b\xc8\xf5\xbf\xe5\x4f\x02\x58\x40\x63\x0b\x96\xd1\xa2\x28\x7b\x60\xc4\xa5\xc5\x82\x26\x05\x71\x65\xd7\x6f\x5f\x90\xa2\x9c\xd6\xc8\x4d\xe2\xce\xee\xcc\x7e\x64\xb5\x5f\xe3\x24\x27\xcb\xa4\xc4\xca\x06\x3c\x16\x55\xbb\xc6\xe6\xe9\xed\xb5\xa8\x9a\xa6\xc8\x25\xd8\x00\xb1\x82\x35\x81\x03\xa4\xb5\x73\x4b\x48\xda\xf6\xfb\x66\xdb\xb4\x75\x9b\xf4\x42\xff\x2f\xf4\xf3\x47\x97\xd0\x3b\xfc\x10\xba\xde\x36\xfb\x7a\xbb\x69\x85\x6e\x7e\x7e\xa6\x33\x81\x3f\x55\xb6\x6b\xbc\xbc\xb6\xcf\xbb\x3a\x1d\xa6\xce\x2f\x37\x19\x4a\x78\x47\x18\x68\x84\x35\x8e\x4a\xb0\x47\x60\xe5\x27\xae\x92\xfc\x6b\x12\xa1\x93\x0e\xef\x04\x45\xa1\x1b\xcd\x3b\x29\x9c\x0d\xf7\x38\x12\x4b\x25\x59\xe2\x3e\x10\x7d\x60\x20\x65\xf8\xfe\xf1\xe1\xa1\xc4\xb9\x37\x5d\x0f\x13\x10\xd8\x8f\xa4\x8a\xc9\x91\xeb\xc6\xcb\x10\x43\x07\x0f\xee\x25\xc3\xf0\x32\x3d\xae\x91\x47\xfb\x89\x21\xdd\x05\x83\x0c\xe1\xec\x47\x15\x2a\xec\x7b\xba\x1a\x16\x59\x6a\x02\xbc\xb3\x17\x9c\x68\x34\xda\xc4\xde\x9e\x1c\xb8\xcf\x61\x52\x7d\x20\x47\xaa\x4a\x2c\x32\x9b\xa2\xda\x2f\x04\xc5\x4a\xac\xac\x77\x07\xa1\x77\x25\x96\x23\x2b\xf4\x2e\x73\x8a\xa3\xfc\xd9\xd1\x58\xe2\xe9\x5b\x0b\xd9\x75\x7e\x72\x8c\xfa\xa5\x04\xcb\x43\x28\xc1\xe6\x48\xb0\x32\x30\x8e\x5e\xa5\x0c\x25\xa4\x53\x99\xd4\xc0\xc6\x3b\x78\x5d\x90\xec\xfa\x39\x53\x75\xe3\xce\x32\x9a\x43\xe8\x3a\x7d\x35\xc5\x36\x2e\x34\xbf\x94\xf9\x86\xa2\xd3\x61\x41\x7e\xd5\x89\xbb\x0a\x6f\xf2\x12\xb9\x85\x81\xba\x79\xfd\xe3\x64\xd9\x0c\x96\x52\xac\x10\xef\x32\xe2\x49\xb4\xae\xc3\x22\xf3\x5e\x9e\x28\xbd\x01\xaf\xd3\x8a\x71\x97\xdb\x60\xda\x8f\x47\xc9\x39\xdb\xf2\xd3\x14\x2d\x59\xea\x16\x32\x13\x0f\x13\x63\x2e\xfe\x97\xf9\x31\xfd\x4e\x5d\xf7\x51\xa1\x48\x47\xdb\x07\xf8\x31\x97\x7f\x05\xef\x84\xde\x89\xbb\xf9\x46\xff\x39\xcc\x93\x0a\xe3\x3a\x3b\x29\x9a\x4d\xae\x8f\xcc\x6b\xfc\x8d\xf1\xcf\x00\x68\x0b\x7d\x8b\x7a\x03\x00\x00")
 
 func vaultedLs1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -240,7 +372,7 @@ func vaultedLs1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedPasswd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x5d\x6a\xeb\x30\x10\x85\xdf\xbd\x8a\x59\x40\x62\xb8\x4b\xc8\x4d\x0c\x31\xb4\x8e\xb0\xdc\x86\x82\xa0\x28\xd6\xa8\x16\xd8\x92\xd1\x8f\x4d\x77\x5f\x24\xbb\x69\x43\x69\x5f\xda\x57\xcd\x99\xef\x7c\x9a\xbc\x39\xc2\xc4\x43\xef\x51\xb0\xed\xc8\x9d\x9b\x05\xfc\xcb\x72\x7a\x84\x6a\x77\x5f\x64\x39\x21\xd9\x3a\x86\x75\xca\xb6\xd0\x76\x5c\xbf\xa0\x03\xdf\xe1\xf2\x6a\xac\x00\x23\x81\x2f\xa8\xb4\x4e\x9f\xaa\x13\xa1\x25\x4d\x08\x26\xff\x33\xb9\xbf\x05\x31\x59\x03\x93\xa5\xe6\x03\x32\x49\xbe\x8b\x19\xfb\x25\x48\x8f\x70\x28\xe8\xbe\x2e\x49\x53\x9e\xaa\xb4\xb8\x37\xda\xa3\xf6\xa0\x74\x72\xfa\x48\x2f\x42\xa0\x1c\x04\xed\x4d\x68\x3b\x14\x1b\x30\xba\x7f\xbd\x75\x57\x6e\xfd\x93\xc8\x13\xaf\x94\x2b\x27\xfa\x3c\xee\x1e\xee\x9a\xe2\xf0\x4c\x76\x94\x9e\x4f\xf5\x21\xfa\xa0\x9e\x94\x35\x7a\x88\xa5\x13\xb7\x8a\x5f\x7a\x8c\x14\x87\x7e\x03\xca\xc3\xac\xfa\x1e\x2e\x08\xc1\xa1\x00\x9e\x2e\x95\xb5\xc1\xda\x98\xbf\xb6\x4a\x63\x3f\xa9\x6e\xc0\xf8\x0e\xed\xac\x1c\xa6\xf2\xe0\xd0\x5e\x39\xa3\x35\xc3\x18\x8f\x12\x77\x22\xec\x1d\xf2\x83\x6f\x55\x9c\x7f\xe3\x9c\x45\xa2\xc6\xf9\xaf\x7d\xdf\x02\x00\x00\xff\xff\xf1\x83\xc8\xb9\x72\x02\x00\x00")
+var _vaultedPasswd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x58\x5b\x6f\xe3\xba\x11\x7e\xe7\xaf\x98\x87\xe2\x6c\x5c\xd8\x3a\x9b\x14\xdb\xa7\xa2\x40\x36\xf1\x36\x46\xb3\x1b\x23\xce\x76\x7b\x50\x15\x8b\xb1\x34\xb2\x08\x4b\xa4\x0e\x49\xd9\xf1\xbf\x2f\x86\xa4\x6e\x4e\xb2\xa7\x45\xdb\xa7\xc0\x14\xe7\xf6\xcd\x37\x17\x26\x79\xba\x83\x03\xb6\x95\xa3\x3c\x5d\x34\x68\xed\x31\x87\x4b\x91\x6c\xee\xe0\xcb\xf5\xe7\xa5\x48\xd6\x6b\x11\x3f\x43\xfc\x9a\x2e\x20\x2b\x51\xed\xc8\x82\x2b\x29\x9c\x6a\x93\x83\x2e\x00\x83\x2a\x2f\xbe\xf9\xe5\xcb\xc3\x7a\xb3\xda\x78\x15\x69\xf1\x31\x2d\x6e\xa6\x8a\xd2\xe2\x11\xd2\x62\xa5\xb0\xa6\xb4\x58\xc3\x3f\xd2\x62\xf5\xb0\x7e\x5a\x3d\x7c\xd9\xa4\xc5\xfa\x9f\x22\xd9\x9a\x7f\x43\x2c\xfd\x29\x49\x92\xff\x50\x96\xcf\xd3\x45\xba\xc0\xaa\xe2\x83\x17\xc2\xaf\xfb\xab\xcd\x6f\x7a\xbc\xb9\x83\xdb\xe5\xe6\xe6\x71\xe5\x0f\xbd\xa2\x1b\xad\x1c\x29\x07\x52\x79\xb0\x46\xd2\x5e\x39\x48\x0b\xad\x72\xba\xcd\x4a\xca\xe7\xa0\x55\x75\x9a\x82\x2a\x6d\x04\x3b\x4f\xbc\xbe\x55\x11\xf5\xb0\x7f\x7f\xbb\xfe\x7a\xff\xb4\xbc\xfd\xbe\xbe\xde\x6c\xbe\x3d\x3c\xde\xb2\x7f\xa4\x0e\xd2\x68\x55\xb3\xd1\x03\x1a\x89\xdb\x8a\x58\x8b\x25\x37\x07\xe9\xe0\x28\xab\x0a\xb6\x04\xad\xa5\x1c\xd0\xa7\x50\x64\xad\x31\x7c\xbf\xb7\x5a\x68\x33\x72\x75\x0e\xda\x95\x64\x8e\xd2\x92\x37\xde\x5a\x32\xbd\x9e\xc6\xe8\xba\x71\x14\x64\x58\x59\xa7\xe4\x07\xfe\x7e\x59\x7e\xfb\x6f\x7c\x16\xac\x51\xd1\xf1\xff\xe1\xef\x0d\x72\x26\xc0\x92\xb5\x52\x2b\x0b\x17\x96\x68\xa8\x8f\x78\x7c\x71\x39\x9b\x81\xa1\x1a\xa5\x82\x03\x56\x32\x07\x2c\x1c\x99\xf3\xcc\x89\x21\x73\x9b\x3b\xf8\xfc\xf5\xfe\x69\xb5\xbe\x5f\x82\x47\x61\xd3\xa1\x53\x6b\xc3\x5e\xa2\x02\xad\x26\xfc\xb8\xd0\x26\xc2\x36\x70\x75\xe6\x51\x69\x28\x93\x85\x64\xbe\x9c\xd7\x1f\x61\x56\xf2\x5f\x8e\xcb\x3b\x6d\x47\x04\x02\xa7\x01\xc1\x4a\xb5\xab\xa6\xf0\x25\x63\xb3\x35\x9e\x00\x2b\xab\x19\x2b\x84\x5d\xa5\xb7\xd0\xa0\x73\x64\x94\xb8\xa0\x64\x97\x44\x9f\xde\x35\x46\xe7\xe9\xe2\xf7\xef\xbc\x57\x35\xba\xac\x94\x6a\xe7\x1d\x62\x4d\x96\xbd\xb0\x74\x20\x83\x55\x80\xcf\x06\x7c\x97\xec\x61\x4f\x7d\xdd\x90\xe2\x54\x48\x63\xdd\x1c\x5a\xdb\xa9\xe8\x63\x72\x25\x3a\xa8\xd0\x3a\xb0\x6d\x96\x91\xb5\x45\x5b\x55\xa7\x28\x27\x18\xb1\x10\x6d\xc8\x78\xb4\x04\x5b\x2a\xb4\xe9\x52\xcd\x3a\x39\xd7\xd2\xd9\x51\xc4\x0f\x2a\x0b\xe4\x88\x22\x68\x48\x04\xad\x01\xd5\x09\xbd\xa4\x05\x43\xbf\xb6\x64\x1d\xe5\xa0\x59\x12\x55\x1e\xc0\xee\x43\xb1\x84\x15\xe5\x70\x94\xae\x04\xe9\x42\xb0\x4f\x25\x81\x21\x1b\x6f\x18\x6a\xb4\xe9\x98\x37\x08\xcf\xbd\x32\xb6\x49\xcf\xd2\x41\xa6\x73\xcf\x7d\xfa\xb5\xc5\x8a\x53\xc6\xb9\x54\x6d\xbd\x25\xc3\xb1\x46\x77\x8f\xa5\xb6\x23\x98\x32\xdd\x56\x39\x28\xed\x38\x6b\x13\xd6\xc5\xfe\x24\x92\xa7\xb5\x38\x67\x93\xb8\x79\xab\x8d\x57\xa3\xa4\x4d\x04\xf7\x79\xc1\x05\xfb\xa7\x66\xbb\xcf\x8b\xab\x74\x61\x4b\xfc\x70\x79\x35\x47\xb3\xd3\xea\x4a\xe6\x7f\x16\x9b\x48\xce\xa0\x74\x4f\x27\xc8\xc9\xc8\x03\x3a\xa9\x15\xd4\xe4\x4a\x9d\x87\x32\x76\x3a\x7c\x09\x59\x20\x95\x99\x53\xe3\x2f\xb1\x4c\x61\x74\x2d\xc6\x5e\x25\xb0\x2a\x40\xd7\xd2\xb9\x2e\x41\xde\xbf\x77\x96\x41\xb3\x3e\xc7\x51\xb9\x07\xda\xa1\x54\x8c\x40\xf0\xe6\xc4\x9f\xb1\xbb\x50\xcb\x9d\x41\x47\x76\xd0\x12\x50\x46\x17\x6f\x24\x22\x59\x75\x31\x77\x81\x71\xd0\xd2\x7a\x25\xb5\x36\xa7\x74\x51\xa2\xc9\x3b\x8d\x5e\x96\x29\x20\x77\x4a\x16\x32\x43\xe5\xaa\x53\x28\x6c\x43\x56\x5a\x87\x8a\x6d\x88\xad\x69\x1d\x71\xf6\x99\x40\xce\x61\xb6\xb7\xa1\xf2\x83\xad\x29\xa6\x69\xf1\x98\xfe\x74\x0e\x7f\x26\x9b\x92\x8c\xcf\x80\xa5\xcc\x90\xdb\xea\xe7\xf9\x73\x56\x62\x56\xe2\xd5\xfb\x74\xd1\xe8\xea\x74\xf9\x87\xf7\x1f\xe6\x48\x36\x5d\x5c\x7d\xf8\x63\xba\xd8\x65\xf5\x79\x56\x46\x60\x9f\x65\x24\x7e\x19\x41\x73\xc1\xe4\xe4\xd2\x89\xad\x4f\x64\xdc\x20\x67\xff\xc3\x7c\x18\x4a\x17\xd1\xee\x38\x27\xbe\x90\xde\xc8\xca\x2b\x11\x33\x26\xec\x6a\x4c\xdb\x38\x7c\xff\xc9\x10\x5c\x2f\xaf\x6f\xa3\x32\x1b\x34\x63\x9e\x4b\x86\x01\xab\xea\x24\xb0\x75\x25\x29\x27\x33\x74\x34\x89\xa8\x26\x87\x39\x3a\x4c\xde\x52\xce\x2d\x73\x4b\xbe\x45\x48\x43\x39\x48\x25\x46\x13\x2d\xda\xaa\x51\xe5\xac\xf9\xd3\x6a\xbd\x49\x17\xd8\x34\x46\x1f\x28\x07\xac\x76\xda\x48\x57\xd6\x2f\x4a\x2d\xc3\x4a\x6e\x99\xa9\xbe\x52\xbb\x1f\xaf\xd6\x55\x83\x06\x6b\x72\x64\x6c\xa0\xb2\xb4\x50\x23\x37\x64\x9a\x83\x8d\xdc\xf6\xd7\xa5\xda\x89\x4e\xde\xe1\x9e\x2c\xe0\x56\xb7\x6e\x34\xa2\xd9\xb2\x43\xb3\x23\xc7\x91\x39\x59\x53\x18\x81\x37\xd7\xf7\xab\x8f\x8f\xd7\xdc\x4c\x66\x09\x7c\x93\xae\xd4\xad\x7b\xc3\xdb\x37\x08\x31\xf2\x12\x0d\xf5\xac\xf0\x83\x8e\x05\x72\x2a\x58\x44\x9c\xdd\x63\x6e\xce\x41\x16\x03\x63\xc6\x33\x30\xf0\xe4\xbc\x41\xcd\xce\xc1\x1c\x42\x4a\x8b\x55\xde\x1a\x8f\x5b\x5a\xac\xc5\x9d\x3e\x42\xa5\xd5\xae\xc7\xa7\xc7\xd7\x96\xbe\xa9\x32\x4c\x1d\x19\x09\xfa\x30\xf3\x71\x38\x7e\x30\x46\x6b\x97\xd6\xdb\x87\xdb\x10\x8d\xcf\x48\xf8\xf2\xe1\xfd\xfb\xda\x86\xb2\x86\xc7\xc0\x15\x0b\xaf\x23\xf8\xb2\xf2\x31\xcf\xd3\xc5\x9e\x4e\x85\xac\x28\x86\xd1\xff\x5a\x8b\x91\xba\xd1\x31\x5c\x48\xd5\x73\x3c\x8e\x92\xbe\xa3\xce\xf8\x80\x27\xde\x90\x2c\x9e\x8a\xd5\x09\x50\x14\x52\xed\xc8\x34\x46\x2a\xd7\xcd\xd7\xa8\xd5\x63\xef\x74\x60\xf9\x20\x19\x69\x36\x5c\xab\x5b\xcb\xb3\x48\xec\xa9\x71\x01\x1e\xd0\x0a\x10\xbe\x6e\x3e\x82\x75\x32\xdb\xcf\xfc\x14\x44\x1b\xc0\xc7\x71\xe5\x9b\x2e\x18\xe9\xce\x51\x30\x54\xeb\x03\x8d\x81\x10\x8f\xfe\xc8\x4e\xac\x47\x0d\x35\x0d\xfe\x87\x00\xfd\x48\x1c\x51\xb9\x9f\xd2\x91\x7b\x3f\x28\x2c\x34\xa1\x2b\x58\xac\x89\x63\xa1\x03\x99\xd3\x59\x99\xd1\x49\xc4\x5e\x50\xb7\x59\x09\x47\xc2\xbd\xdf\x0a\x91\x43\x2f\xd0\xba\xee\x3e\x64\x7c\x54\x14\x71\xf9\x7a\x8d\x02\xb0\x25\x95\x95\x35\x9a\xbd\x15\x6f\x8f\x52\xad\x26\xe5\xee\x5b\x60\x23\xc3\x60\xa1\x49\x5f\x28\xd1\x79\x2e\x7b\x65\xa1\x1c\x7c\x79\x27\xf0\x54\xd2\x79\xa0\x8a\xa3\x9b\xf8\x3f\x2a\x50\xcb\xd1\xfa\x65\xa9\x2b\x97\x90\x37\x0e\x9d\x2d\xf8\x94\x8e\xe5\xa2\x35\x4f\x00\x5b\xe9\x63\xe7\x6d\x58\x92\x3e\xf5\x7b\xee\x8b\x01\xe8\xa5\x87\xdd\x47\x3a\x0a\x85\x1b\xb6\xda\xbe\x14\x13\x18\x74\x8c\x06\xb6\x98\x4a\x33\xf1\xe9\x4c\x12\x2e\xf8\x4e\x98\xe9\x01\x3b\x34\x58\x55\x54\x49\x5b\x7b\x20\x3c\x7f\xd1\xb1\x2a\x69\x7a\x00\x66\xc3\x7a\xf7\x7a\x43\x60\xd1\x58\x26\x7d\xeb\x18\x2d\x7b\x7d\xeb\x3b\x96\xa4\x40\x3a\x2b\xc6\xab\xa6\xa2\x67\xd7\x2f\x71\x30\xda\x21\x23\x95\x6b\x6d\x1d\x18\xca\x48\xb9\xde\x3c\x53\x22\xee\xff\x62\x3c\x78\x33\x6d\xf2\x50\xab\x01\x9e\xdf\xfd\xfd\xf6\x2f\xdf\x6f\xae\x6f\xee\x96\xdf\xef\x1e\x3e\x2f\x7f\x8e\x2f\x9b\x9f\x93\x91\x22\xdf\xc1\x51\xe5\xa2\x7f\xf6\x1c\xc8\xc8\xe2\x74\x71\x39\x83\x23\x1a\xd5\x0d\x8d\xb3\x55\x74\x12\xfc\x91\xfc\x6b\x8b\x93\xdd\x2d\xc3\xdf\x38\x56\x1f\xd6\x8b\x2d\xff\xc5\x5b\x61\xee\x23\x19\xf5\x7b\xff\x30\x1e\xb0\x16\xbc\x84\x87\x6a\xfe\xeb\xf2\x97\x4f\xab\xfb\xe5\x66\xfc\xda\x3c\xeb\x23\xd8\x35\x86\xf9\xa4\x4b\xbc\x3d\x43\x86\x36\xcb\xb3\xaf\xc3\xc1\xbf\xfa\xe2\xac\x9a\xbe\x67\xa3\x0f\x3f\x7a\xca\x86\x29\xd6\xbf\x4b\xc5\x6f\xbe\x4b\xa1\xd2\x59\x9f\xd7\x91\xdb\x03\xf5\x5e\xeb\x76\xd2\x9e\x91\xeb\xcd\x7f\x26\xfc\x6b\x00\xca\x93\xee\x72\xf8\x11\x00\x00")
 
 func vaultedPasswd1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -260,7 +392,47 @@ func vaultedPasswd1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedRm1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xcd\x6a\xc4\x20\x14\x85\xf7\x79\x8a\xb3\x9a\x55\x47\xe8\x23\xb4\xd3\x81\x64\xd1\x8c\xc4\x6c\x0a\x6e\x4c\xbc\x36\x42\xa2\x53\x35\x43\xe7\xed\x4b\x1c\xa1\x3f\x94\xd9\x09\x9e\xef\x7c\xf7\xb0\xbe\xc6\x45\xad\x73\x22\x2d\xf7\x61\xc1\x63\xc5\x44\x8d\xf6\xe9\xf5\x58\x31\xce\xab\xf2\x85\xb0\x40\xee\x11\x68\xf1\x17\x8a\xa0\x4f\x1b\x93\x75\xef\x37\x32\x66\x44\xbc\xb5\x27\x2e\x1a\x91\x31\x69\x9e\xa5\x39\x7c\xc3\xd2\x74\x90\xa6\x71\x6a\x21\x69\xf8\xf6\x94\x3b\xc6\x98\x34\xfc\x9f\xb8\xa6\x99\x12\xdd\x43\x86\xf0\xd7\x90\x0f\xbb\x87\x88\x1a\x2f\x47\x71\xe8\x1a\xde\x37\xa7\x36\x5b\xbb\xb2\x26\x4d\x54\x86\x20\x9e\x69\xb4\xc6\x92\xc6\x70\xfd\x51\x25\x77\x0c\xfd\x44\xdb\xee\x84\xd1\x6b\x82\x8d\xa0\x8f\x55\xcd\x48\x3e\xf3\x6e\x5d\x06\x0a\xf0\xa6\x2a\x4d\x69\x52\x5b\x74\x9d\x35\x9c\x4f\x18\xa8\xdc\xa8\x59\x76\x37\x06\xea\x26\xc5\xa8\xdc\xef\xc4\x43\x6e\xa4\x10\x7c\xd8\x3c\xda\xc6\xf3\xac\xae\xa4\xe1\x1d\x62\xd2\x7e\x4d\xac\xfa\x0a\x00\x00\xff\xff\xe6\x20\x08\x4c\xb7\x01\x00\x00")
+var _vaultedRecipients1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x54\x5f\x6f\xdb\x36\x10\x7f\xe7\xa7\xb8\x87\x61\x69\x01\x87\x41\x07\xf4\x61\x8f\x59\xe2\x35\x02\x5a\xc7\x88\xbd\xae\x05\x04\x04\x27\xf1\x14\x11\x93\x48\x8d\x47\xd9\xd3\xcb\x3e\xfb\x40\x4a\x96\xe9\x24\x75\x07\xf4\xcd\xb4\x8e\xc7\xdf\xbf\x3b\xb9\xbd\x83\x1d\xf6\x8d\x27\x95\x5f\x3a\x2a\x75\xa7\xc9\x78\x86\x77\x42\x6e\xee\x60\x75\xfd\x69\x29\xe4\x7a\x2d\xa6\x12\x48\x2a\xf2\x4b\x68\xd1\xe0\x13\x31\xf8\x9a\xa0\xeb\x8b\x46\x97\xf0\x17\x0d\xe1\x8c\x1e\x4a\x34\x60\x3b\x32\x80\xe3\x03\xb1\xe1\xe6\xeb\xea\x7e\xbd\xc9\x36\xb1\x69\x5e\xfd\x96\x57\x37\xaf\xb4\x6e\x38\xaf\x1e\x20\xaf\x32\x83\x2d\xe5\xd5\x5a\xc8\xc2\x9d\xa9\xd6\xec\x9f\xd7\x9f\xeb\x8e\x4a\x9d\x96\x87\x9f\xf3\x77\x29\xe5\x77\x3b\xb8\xf6\x7f\x34\x38\x07\xd9\x51\x6b\x77\xf4\x83\x28\xb4\x22\xe3\xb5\x1f\xf2\xea\x21\x6a\x7b\xbb\xdc\xdc\x3c\x64\xeb\x6d\x76\xbf\x8a\x17\x33\x13\xa8\x6a\xaf\xad\x01\x6f\x41\x7b\x86\x0e\x99\xf7\xd6\xa9\xc5\xc1\x94\xe8\x52\x41\xd1\x28\x52\x50\x0c\x80\x66\x00\xd3\xb7\x05\x39\xb0\x95\x38\x3e\x27\x61\x89\x65\x7d\x7c\x1f\x34\x03\x1a\xf8\xf2\xcb\xfb\xf7\xef\x7e\x4d\xdc\x97\xb0\xad\x69\x6c\x7e\xc1\x50\x5a\xe3\xc7\x62\x41\xa6\x74\x43\x17\x38\xec\xb5\xaf\x01\xc1\xa1\x51\xb6\x05\x85\x1e\xc3\xc5\x31\x35\x9a\x61\xef\xb0\xeb\x48\x41\x65\xdd\x98\xac\x09\x34\xa0\x19\xff\x24\x2c\xeb\x23\x32\x19\xc9\xfe\x59\xd3\x18\x37\x6d\x9e\x0e\xe4\x16\xf0\x79\x52\xad\xd2\x8e\x3d\x78\xa7\xa7\xb0\x1e\xa4\x03\x5b\xc5\x73\xd9\x3b\x17\x60\xf6\x4c\x2e\x3c\x23\xac\x69\x06\xe8\x9c\x6d\x3b\xcf\x2f\x81\xe8\xea\xb4\x8b\x66\x30\xd6\x07\x4a\xb3\x3a\x53\xe3\x88\x43\x0a\xb9\x3d\xf8\x38\x46\xfb\x0a\xa6\xd3\x18\x5d\xf1\x51\xb3\x1f\x91\x25\xfe\xa6\x2d\x92\x9c\xe4\x3f\xa7\xfd\xc6\x30\x8b\x6b\xa5\x38\xbd\xeb\xed\xb7\xee\x42\xf6\x92\xf2\x05\x9f\x70\x41\xf1\x3a\x8f\xc5\x33\x15\x46\xd6\x8e\xfe\xee\xb5\x23\x25\xe1\x5a\xa9\xa0\xfe\x29\x0c\x1c\x77\x07\x28\x4b\x87\xfa\xfc\x72\xca\x42\xcc\xe4\x14\x91\x05\xf4\xa6\x21\xe6\x04\xf7\x1e\x19\x98\xb0\x39\x04\x53\xd8\x46\x91\x83\x1d\x39\x0e\x99\xb6\xd5\xec\xef\x9b\x10\x29\xdb\x7b\xc0\x39\x4e\x6f\x53\x95\x5c\x9b\xaa\x3e\x4f\x9f\x78\x88\xbf\x4e\x94\xab\x9c\x6d\xbf\xa9\xdd\x1c\xed\x40\x3e\x21\x32\x87\x5a\x18\xda\xcf\x10\x16\xc0\xf6\x54\xb2\x13\x4b\x83\xd2\xcd\x1e\x07\x3e\x4a\x98\x40\x4e\xa7\x7b\xed\xb4\xf1\xcf\xd7\x2c\xd8\xb3\x46\x2e\xe0\x89\x0c\x39\xf4\xc1\x12\x5f\x93\x38\x3a\x5c\x81\x1e\xfd\x30\x17\x1e\xe8\x1f\xcd\x1e\x06\xf2\x12\x36\x35\x3a\x02\x5f\x6b\x4e\x9f\x89\xc4\xac\xaf\xc9\xc5\x27\x82\xa9\xa2\xa0\xb0\x5a\x48\x01\xf2\x2b\xa9\xd7\x6e\x64\xc8\x32\xee\xa5\xec\x76\xb9\xda\x66\xdb\xaf\x71\x4e\xb7\xdf\x9b\x3e\xcd\xc0\xde\x3a\x52\xa0\xcd\x24\xc5\x4f\x5f\x6e\x3f\x3c\xde\xdc\xaf\x7e\xcf\x3e\x3c\xde\xdd\x7f\x5a\x5e\x4d\xcb\xf0\x2a\xd1\x08\xde\xf8\xa1\xd3\x25\x36\xcd\x30\xb9\xfc\xef\x95\x2c\xad\xa9\xf4\xd3\x6b\xe5\x6f\xa5\x98\xe6\x60\x2c\xfe\x7c\xfd\xc7\xc7\xed\xf2\xf6\xf1\x80\x35\x74\x24\xb3\xd3\xce\x9a\x36\x40\xdb\xa1\xd3\x58\x34\x14\xe1\xd1\x34\x09\xe9\xcc\x38\x42\x15\xb3\x23\xe2\x1a\xab\x74\xa8\x35\xec\x09\x95\x7c\x49\x3c\x7e\x0e\xc1\x47\x6d\x26\x5f\x9d\xde\xa1\xa7\xd4\xd8\xb9\x1a\x8d\x02\xae\x6d\xdf\xa8\x38\x40\x05\x09\x0e\x4e\x29\x29\xfe\x1b\x00\x5c\x9f\x09\x5e\xbe\x07\x00\x00")
+
+func vaultedRecipients1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedRecipients1,
+		"vaulted-recipients.1",
+	)
+}
+
+func vaultedRecipients1() (*asset, error) {
+	bytes, err := vaultedRecipients1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-recipients.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedRestore1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x90\xcd\x6e\xea\x30\x10\x85\xf7\x79\x8a\xb3\xbb\x20\x41\x24\x1e\xe1\x5e\x2e\x12\x59\x34\x44\x24\x9b\x4a\xde\x98\x78\xdc\x58\x0d\x71\xea\x71\xa0\x79\xfb\x2a\xce\x0f\xd0\x9d\xe5\x19\x7d\xe7\xcc\x17\x17\x47\xdc\x64\x57\x7b\x52\x62\xeb\x88\xbd\x75\x84\x5d\x14\xe7\x47\xa4\x7f\xdf\x0e\x51\x9c\x65\xd1\x34\xc7\x3c\x16\xdb\xf9\xc9\x90\x68\x1d\xdd\x8c\xed\xb8\xee\xc1\x24\x6b\x52\xb8\x91\x63\x63\x1b\x58\x0d\x39\xc2\x03\x2f\x7f\x4f\x4f\x59\x9e\xe4\x81\x29\xf4\x3f\xa1\xf7\xbf\xc8\x42\x9f\x21\x74\xd2\xc8\x2b\x09\x9d\x0d\xcf\x09\x25\x74\x16\x10\xff\x0f\xf9\xfe\x9c\x64\x45\x72\x4a\x03\xe5\x4c\x6d\x2d\x4b\x62\xf8\x8a\xc6\x24\x70\x4b\xa5\xd1\x86\x14\x2e\xfd\x33\xec\x6e\x7c\xf5\x4a\x84\x76\xf6\x0a\xe3\x19\x95\x19\xe2\x7b\xac\x98\x28\x5a\x6c\x4c\xbf\xab\xdd\x7a\x1d\x23\xb5\x68\x25\xf3\xdd\x3a\x05\xc3\x70\xf4\xd5\x19\x47\x6a\x03\x39\x85\x4f\x37\x87\x59\x38\x46\x45\xf4\x2d\x4b\x5f\xf7\xc3\x8a\xf1\xb8\x4b\x9e\x04\xc5\x28\x96\xba\xd7\x8e\x3d\x2e\x04\xdb\x52\x43\x6a\x6c\x39\xf0\x96\xb0\x57\x09\x0f\x48\x58\x8d\x83\x85\xe2\x29\xff\x42\xa6\xf9\x80\x1b\xbd\x84\xaa\xc6\x33\xd5\x1a\x9f\xd4\x7a\x98\xe6\x61\xea\xcf\x72\xf7\x06\x6c\x21\xe7\xde\x51\x29\x07\x0c\xba\x46\xd9\x86\x06\x8b\xe3\x60\xe0\xfa\x4a\xfa\x39\x2a\x8e\x7e\x06\x00\xba\x1f\x2a\x1c\x3c\x02\x00\x00")
+
+func vaultedRestore1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedRestore1,
+		"vaulted-restore.1",
+	)
+}
+
+func vaultedRestore1() (*asset, error) {
+	bytes, err := vaultedRestore1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-restore.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedRm1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x92\xcf\x8e\xdb\x20\x10\xc6\xef\x7e\x8a\x39\xad\x12\x29\x41\xca\x23\xb4\xdb\x95\xe2\x43\x13\xcb\xce\xa5\x2a\x3d\x10\x33\x14\x54\xfe\xa4\x80\x93\xfa\xed\x2b\xfe\xa4\xf1\x6e\xf7\xb2\x37\x04\xf3\x7d\xf3\xfb\x86\x21\xa7\x3d\x5c\xd9\xa4\x23\x72\xba\xf5\x06\x76\x0d\x19\xf6\x70\xf8\xf4\xf5\xa5\x21\x5d\xd7\xd4\x27\xf0\x06\xe8\x16\x3c\x1a\x77\xc5\x00\xf8\x47\x85\xa8\xec\xcf\xa2\x0c\x59\x32\x7c\x3b\x1c\xbb\xa1\x1d\xb2\x8c\x8a\xcf\x54\x3c\x3f\xc4\x54\xf4\x40\x45\x6b\x99\x41\x2a\xba\x74\xa4\x4f\x84\x90\x74\xfe\x4e\x45\x7b\xec\x4e\xed\xf1\x30\x50\xd1\xfd\x78\x47\xce\x51\x63\xc4\x8f\x58\x9c\xfd\x5b\x82\x0c\xfe\x11\x8b\x61\x0f\x5f\x5e\x86\xe7\xbe\xcd\x97\x99\xaa\xaf\xe9\xa3\xc4\x1a\x1c\xc2\x05\x47\x25\x14\x72\x38\xcf\x0b\x6b\xfa\x44\xe0\x24\x31\xcd\x29\xc2\xe8\x38\x82\x0a\x80\xbf\x27\xa6\x21\xba\xac\xb7\x93\x39\xa3\x07\x27\x9a\xea\x14\x25\x4b\xa5\x93\xe6\x60\x5d\x84\x33\x56\x66\x4e\x16\xbd\xf9\xbd\x2f\xf3\x08\xe5\xa2\xfa\x45\xcf\x82\xdc\x80\xf0\xce\xc0\x4d\xaa\x51\xa6\xdb\x19\x46\x66\x8b\x55\x88\xce\x23\x87\x9b\x8a\xf2\xcd\x68\xb2\xf2\x5e\x41\x45\x9f\xd8\x07\xc4\xc7\x52\xe4\x82\xd5\x6e\x5d\x40\x5a\x01\xac\xbc\x25\xf3\xd7\xa8\x9b\x8c\x82\xde\x3b\x9f\x02\x73\x15\x2e\x9a\xcd\xc8\xc1\x59\x08\x91\xbb\x29\x16\x8f\x34\x1a\xa9\x52\xc3\x19\xdc\xc3\xef\xff\x40\xc9\xe5\x17\x5e\xe2\x06\x82\x03\x95\x3b\x02\xd3\xc1\x95\x9e\xa3\xbb\xa2\x47\xde\xa4\x50\x8b\x1d\x2e\x49\x0a\xf0\xb0\x87\xfa\xab\x0d\x39\xdd\xf7\x8a\x6e\xe9\xf6\x82\xde\x30\x8b\x36\x52\xd1\xbf\xf7\xb1\xff\xde\xf5\x0c\xca\x86\x88\x8c\x27\x54\xe3\xae\x69\xed\xa3\x44\xf3\x0a\x34\x7f\x77\xb3\xc8\xb4\xb0\x5a\x85\xe5\x34\x6b\xcd\x6a\xb7\x5e\xa7\x74\x75\x72\xc0\x02\xdc\x50\x6b\xd2\xfc\x1d\x00\x63\xbe\x4b\x8b\x90\x03\x00\x00")
 
 func vaultedRm1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -280,7 +452,27 @@ func vaultedRm1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedShell1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5d\x6f\xdb\xb8\xd2\xbe\xd7\xaf\x18\xe0\x05\x76\x13\xc0\x56\x90\xb6\x57\x79\xd1\x0b\x9f\xd8\x4d\x8c\xa4\xb6\x61\x39\x2d\x82\xd5\x22\xa0\xa5\x91\x45\x84\x22\xb5\x24\x65\xc7\xff\xfe\x60\x48\x7d\x39\x51\xb2\xdb\x03\x9c\x73\x67\x53\xe4\xcc\x70\x3e\x9e\x79\x86\xe1\xe6\x16\xf6\xac\x12\x16\xd3\x78\x6c\x72\x14\x02\x2e\x83\x30\xba\x85\xc5\xe4\xfb\x2c\x08\x57\xab\xa0\xfe\x0a\xfe\x63\x3c\x06\x63\x99\xb6\x06\x98\x04\x2e\x2d\x6a\x96\x58\xbe\xc7\xfa\xf3\x81\xdb\x1c\x6c\x8e\x60\x30\xd1\x68\x0d\x64\x4a\xbb\xff\x4e\x0a\x08\xc5\x52\x4c\xe9\x9c\xf2\xbb\xe8\x90\x53\x17\x3d\x2e\x96\xab\x68\x1e\x39\x95\x71\xf6\xaf\x38\xbb\x3e\x51\x1c\x67\x6b\x88\xb3\xb9\x64\x05\xc6\xd9\x0a\xfe\x88\xb3\xf9\x72\xb5\x99\x2f\x17\x51\x9c\xad\xfe\x0c\xc2\xad\x1e\x3a\x05\xf1\x38\x1e\x33\x63\x2a\x3a\xe5\x04\x30\x2d\x07\xcf\x47\xb7\x30\x9d\x45\xd7\xeb\xb9\x5b\x74\x56\x44\x1f\xdc\xf3\xac\x32\x68\xdc\x15\xbc\xd6\xe8\x76\x76\x7f\x4f\x2a\x50\xee\xb9\x56\xb2\x40\x69\x61\xcf\x34\x67\x5b\x81\x23\xe0\x19\x18\xb4\xff\x1f\x28\x9b\xa3\x3e\x70\x83\x90\x62\x46\x86\x1a\xb0\xaa\x16\x71\xb1\xe5\xf2\xc2\xe4\x71\xb6\x3e\x0f\x9d\x3d\xb5\x7d\x41\xb8\x69\x3c\xf2\xce\x6d\x82\xa8\xc4\x84\x67\xbc\xb6\x28\xab\x84\x80\xc9\x7a\x01\xb5\xeb\xb5\x12\x08\xe4\x38\x50\x59\xb7\x60\x15\x78\x51\x21\x44\x88\xa4\x60\x12\x45\x0f\xdf\xe7\x8b\x1b\x98\xc0\x7a\x79\x3f\x23\x37\x6d\x51\xa8\x83\x8b\x61\x8a\x96\x71\x61\x40\x49\xc8\xd5\x01\x7e\xd4\x5e\xf6\x22\x8c\x13\x69\xc2\x20\x9c\xaf\x82\x35\x49\x77\xeb\xa5\xe5\x4a\x42\xc1\x8e\xb0\x45\x28\x51\x67\x4a\x17\x98\xba\x1c\x51\x95\x05\xe3\xac\x3e\x72\xb9\x03\x56\xe7\x87\x55\x60\x4a\x76\x90\x90\x69\x55\x84\xc1\xcf\x1c\xc9\xf9\x7b\xf5\x8c\x29\xd8\x9c\x1b\x38\xb0\xe3\x08\x12\x8d\x29\x4a\xcb\x99\x30\xc0\x34\x82\x51\x95\x4e\x30\x75\x87\x1a\xc7\x82\x50\x09\x23\xfd\x06\xce\x30\xdc\x85\x41\x2f\x30\x23\x48\x94\xcc\xf8\xae\xd2\x6e\x07\x64\x5c\xa0\x19\x01\x97\xc6\x32\x99\x20\x94\x5a\xd1\xd2\x08\xd0\x26\x21\x05\xe3\x24\x00\x52\xc5\x63\x83\xc6\x70\x25\xe3\x6c\x1d\x4c\xb9\xa1\x18\x7b\xd7\xef\x50\x62\x2d\x94\x7c\x8d\x45\xa9\x34\xd3\xc7\x53\x8b\x65\xea\x23\xd0\xf9\x28\x84\x4d\x8e\x41\x89\xba\x60\x92\x12\xa7\xbf\xdd\x58\xa5\x5d\xc9\xf4\xca\x88\x2e\x5d\x19\xb7\x6a\x2c\xb2\x74\xd8\xf1\x09\x93\xa7\x8e\x67\x99\x45\xed\x1d\xec\x9d\xee\x73\xb9\x32\xf4\xaf\xcb\xe5\x93\x2c\x0b\x12\x55\x14\x64\x72\x5b\xd9\x2e\xb3\x7a\x89\x74\x54\x15\x1c\xb8\xc9\x7b\x19\xf5\xca\x63\x1a\x33\x8d\x2e\xb3\x7d\x4d\x01\x03\x89\x07\xa8\x9d\xe8\x25\xd3\xc2\xfb\xfe\x62\x50\xcb\xc0\x14\xf0\xa5\xe4\xde\xc7\x6f\xf5\xec\x7c\x50\xa8\x34\x9a\x3f\xab\x60\xb9\x47\xad\x79\x8a\xde\x64\xb7\x4c\xb6\x6e\x6b\x1f\x52\x76\x4f\x7e\x46\x14\x03\x6e\xa8\x4c\x4d\x7f\xa3\xdb\x72\xc8\x51\x06\x4d\x6c\xc9\x57\x43\x86\xfa\x20\xb8\x94\x65\xcd\x69\x6e\xbc\x80\xb3\x3d\x67\x30\x60\xe8\xa8\x17\x54\x6e\x0d\x8a\x6c\xd4\x54\x2d\xca\x44\x28\x8a\x4c\x3f\x73\x7f\x37\xb5\x94\xc9\xcf\xe8\x69\x3d\xbb\x99\x2f\x17\x74\x5d\xa5\x7b\xcb\xd3\xd9\xb7\xc9\xc3\xfd\xa6\xf7\xb9\xc1\x21\x73\x3e\xf2\xd1\xc7\xb4\x2f\xd4\xc0\x81\x0b\x01\x5c\x26\xa2\xaa\xbd\x34\xa4\x84\xe2\xf0\x81\x96\x60\x08\xf9\x1c\xbc\x71\x99\xf2\x84\x59\x2f\xb9\x46\x51\xef\x81\xd7\x01\x34\x26\x8f\xc7\xb5\x9f\x31\x1e\x3f\xe3\x91\x04\xdf\xd4\x0b\xce\x02\xea\x20\x04\xc9\xeb\x68\x02\xcf\x78\xec\xb5\x12\x7f\xb1\x26\xab\x7e\x37\x10\x45\xb7\xc0\x76\x28\xed\xa0\x9a\x52\xab\x97\x63\x3c\x76\x1b\x48\xcb\xec\xa5\x54\x0d\xa6\xe3\x8b\x45\x2d\x99\xe8\x44\xc0\xb0\x96\x41\xc9\x86\xef\xa8\xbe\xe2\x71\xa5\xa9\x6d\x05\xd7\x35\xd8\x34\xc2\x65\x5a\x2a\xee\x45\x56\x06\x5d\xfe\x91\x1e\xba\x4d\x7d\x34\x84\xeb\x4a\x6b\x94\x56\x1c\x41\x49\x71\x6c\xf1\x0a\xd3\xc0\x2a\x38\x28\xfd\xec\xab\xe6\x96\x99\x9c\x5f\x2b\x5d\x7a\x40\x6e\x65\x9b\xbf\x31\xcc\xa0\x36\x03\xa6\xb9\x75\x07\xc2\x7c\x27\x1b\xa3\x7c\x0f\xa7\x12\xe8\x9b\x48\xa9\x8d\x92\x62\x9c\xfa\x6e\x35\xf9\x19\xc1\xdd\xec\xd1\x75\xce\x3f\x08\x34\x50\xda\x3f\xaf\xe0\xff\xe0\xec\xe7\xed\x6c\x01\xdf\x97\xd3\xf9\xb7\x47\xea\x2e\x9b\xdb\x59\x34\x83\xe9\xf2\x3a\x1a\xc1\xe4\x3e\x5a\xc2\xc3\x6a\x3a\xd9\xcc\xae\x3a\x1a\x82\x72\x1f\x5e\x86\x05\xc5\x39\x0d\xba\xd5\x17\x4c\xdc\xf2\xb9\xd3\xd1\x74\x20\xd7\x88\xff\x39\x74\x5a\xd5\x80\x34\x76\x65\x1c\xf4\x4f\x79\x38\xa4\xeb\x44\x1b\x87\x0a\x94\xad\xa6\x12\xae\xf2\x5f\xb7\x9e\x2e\x30\x24\x59\x30\x63\xc9\x5b\x01\xe9\x4b\xab\x5e\x27\x68\xf5\x37\xa0\x77\xd6\x3b\xd9\x81\x43\x43\x5e\x30\xe5\xb6\x26\x02\xab\x55\xb0\x19\xc4\xc5\xa2\x32\xb6\x05\x31\x2e\x41\xe9\x14\x75\x07\xc2\x04\x42\x4a\x60\x58\x13\xaa\xf9\x42\x59\xbc\xf2\x3d\x3d\x61\x94\x77\x8d\x03\xfb\x4c\xc4\x54\x5b\x63\xb9\xad\xdc\x5d\x87\x9d\x4a\x79\x17\x0c\x02\xa0\x07\xb3\xfe\x5e\x6a\x0d\xa5\x56\x7b\x07\xbe\xaa\xd5\x48\x8c\x40\x2a\x0b\x05\xb3\x49\x1e\xd8\x5c\x19\xa4\x0b\xb0\x81\xea\x7a\x1d\x68\x0a\x0b\x75\xe9\x94\xe9\x74\x90\x6b\xf9\x6c\xed\x19\x71\x15\x84\xeb\x88\xa0\x19\xe2\xb3\x6d\x05\x9f\x82\x0e\xc3\x26\xd7\xd7\xb3\x28\x7a\xba\x9b\x3d\x3e\xcd\xa7\x54\x0e\xc4\x22\x27\x12\xb8\x3b\x9b\x71\xd4\x2d\x7d\x65\x49\x82\xc6\x50\x01\x84\xf0\x20\xf9\x5f\x95\xbb\x10\xb2\x24\xa7\x8e\x41\x21\xee\xbc\x45\xf1\x7f\xb7\x41\xbc\xb5\x22\x9a\x5d\xaf\x67\x9b\x9e\x31\x8d\x25\x9b\x96\x46\xfb\x18\x37\x75\xa9\xf1\xaf\x0a\x8d\x35\xff\x05\x4b\xa2\x68\xbe\x5c\x3c\x6d\x96\x77\x33\x07\xf9\x17\x70\x62\xe6\xc3\x7a\xbe\x79\x6c\xbf\x3a\x1b\x57\x3e\xba\xbe\x45\x36\x4c\x62\x50\xe5\x47\xa2\x08\x4c\xea\x3c\x71\x00\x67\xaa\xb2\x54\xda\x82\xc0\x1d\x4b\x8e\x10\x4d\xef\xc8\xe4\xf5\xcc\x03\xcd\x29\x4d\xfd\x9f\x01\xce\xe4\x15\x6f\x6e\xf8\x95\xa9\xc9\x77\x0a\xc8\x89\xde\xfb\x54\x76\x52\x7e\x37\xaf\x98\x26\xf1\x80\x60\xb8\xd4\xa9\x89\x77\xa2\x08\x12\xde\x61\x64\xa0\x4a\xfb\xa6\x38\x32\xae\x8d\x6d\x91\xcd\x93\xa6\x84\x25\x39\xfd\x6c\x31\xe7\x74\x16\x3b\x73\x12\x7b\xec\x3b\xe8\xcd\x57\x07\x66\x3a\x6b\xce\x9d\xb8\x76\xe2\xe9\xd0\xb0\x11\x6c\x55\xc3\x32\x7d\xb1\x78\xff\x90\xbb\x82\x84\x09\x51\x53\x2b\x26\x84\x3a\x98\x7a\x3a\x6c\x0f\x6e\xd1\x1b\xea\x89\x18\x03\xa1\xe4\x0e\x75\x87\x9e\x36\x67\xb2\x27\x35\xd0\x4a\x08\x20\xa9\x9e\xb5\x38\xa1\x70\x56\xb0\x17\x5e\x54\x05\xa5\xff\x25\xe4\xaa\xd2\xe7\xad\x52\xa3\xa0\x40\x26\x49\x31\xb3\x83\xf6\xb9\xf4\x6b\x59\xb2\x2b\x25\xcb\x1d\x82\x12\x2b\xed\xa3\x0c\x11\xc4\x1a\xa3\xdc\xb0\xd1\xbb\x8b\x8f\xc7\xa3\xaa\x5c\x5e\x38\xb5\xf5\x80\x53\x23\xb1\x1f\xc1\xc8\x93\x4d\xd0\xfc\x05\x2c\xd5\x8b\xa5\xb4\x4f\x34\x36\x34\xa9\x9d\xe1\x9c\x1a\x6e\x47\x20\xf8\x33\x4d\x3a\x57\x4e\x8d\x83\x34\x99\xbd\x99\xcd\x9b\x34\x81\xa8\x2a\x51\xd3\x40\x10\x84\x19\xf7\xa5\xb3\x5a\x05\x87\x9c\x27\x39\x1c\x54\x25\x52\x8a\xa2\x12\x7b\x6c\x88\x8d\x53\xc8\xb4\xac\x33\x8e\x69\x79\xc5\x0e\xe6\x8a\xb3\xe2\xea\xea\xf2\xf2\xf2\xd3\xa7\x4f\x9f\x3f\x7f\xfe\xf2\xe5\xcb\x15\x5d\xe5\xa2\x15\x1f\x67\xeb\xf8\x37\x7f\x75\xcf\x7c\xbb\x9c\xa2\x8d\xbe\x25\x34\xc1\x79\xdd\x11\x87\xdb\x2a\x37\x70\x19\x50\x08\x47\xc4\x12\x99\x4e\x05\x21\x6f\x7d\xa4\x15\xd1\x95\x4a\xbf\xbd\xbf\x2e\x38\x6f\xd9\x5c\x02\x4b\x53\x6e\xeb\x8c\xf3\xbb\x9b\x76\xd1\x09\x62\x5b\xb5\xc7\x51\x1b\x9d\x1a\x90\x4c\x7b\x96\x89\x77\x38\xae\xa3\x62\x5c\x52\xfe\x78\xe3\xd8\x96\xe6\xdb\x66\x42\x7a\xa7\x03\xfd\x20\xfe\x3c\x9b\x3e\xcd\x16\x3f\x9e\x08\xc8\xa8\x03\x2c\x1f\x16\x9b\x5e\x2f\xda\xf8\xce\xa3\x2a\x69\x61\x3e\x3d\x99\xba\x7c\x9c\xd3\x01\x24\x7f\x2b\x77\xbd\xe8\x0b\xec\x9e\x07\xfe\x33\x71\x8b\xc9\xf7\x59\x5f\xde\x9b\x97\x85\x5f\x90\xb5\x9a\xac\x37\xf3\x4d\x3d\x40\x34\x02\xa9\xc7\x97\x4c\x5b\x7e\x92\x2b\xbf\x2c\x79\x73\xdb\x17\x5a\x32\x9b\xbf\x23\xab\x2e\x8e\x6f\x4a\x03\xbe\xb0\xa2\x74\x11\xfb\x27\x45\xf6\x37\x45\x42\x2a\x2f\xde\x29\xc4\xa6\x04\xdd\xe0\xe7\x13\x38\x53\x04\x65\x54\x0d\x5d\x66\x6d\xd1\xc3\xac\x3d\xb5\xe8\x83\xd4\xf9\xda\xb7\x63\x60\xe3\x7a\xf1\xf5\xd7\xcc\x1e\x8c\xff\xd7\x0f\xbe\xb7\x31\xfd\xca\x0e\x66\xe8\xf3\xe6\xf6\xab\x57\xd2\xb9\x24\xba\x75\xd3\xc6\xdd\xec\x11\xa2\xf9\xcd\x62\xbe\xb8\xf1\x85\x9b\x39\x0a\x99\xb3\x7d\xcb\xc0\xa8\xfd\xbe\x19\x7b\xda\x97\x1b\x07\xf1\xdc\xf4\x69\x79\x6f\xbc\x0a\xea\xd9\x65\xe4\xa4\x12\x4c\xb7\xfb\x5c\x04\x9a\xca\xef\x75\x27\x56\x59\x45\x45\x4d\x6d\xc2\xcf\x3e\xd4\x71\xdc\x58\x14\xb0\x34\xf5\xac\xcc\xf5\x94\x7a\xd0\x24\xa2\x50\x6f\x80\xb7\x1b\x3c\xe4\x13\x69\xe0\x3b\xd9\xb6\x3c\x25\xd1\xf5\xac\x0e\xdd\xfc\x83\x8b\xdb\x1a\x9c\x9a\xa0\x51\xe2\xc1\xa1\x13\x57\x8e\xed\x77\xcf\x21\xee\x9f\x34\xfe\x36\x75\xab\xf3\x76\x68\x04\x26\x0e\xec\x68\x82\x3d\x13\x3c\x6d\x89\xc0\xe0\x88\x32\x02\xa3\x5c\x17\x06\xe6\x9b\xf5\x6b\x6f\x5b\xf5\x8c\x32\xd0\x58\x30\x2e\x0d\x38\x89\xfe\xe2\x77\xa7\xe3\xa1\xab\xe1\xa4\x12\x4c\x8b\x23\xb1\x87\xac\x12\x9e\x26\x26\xaa\xd8\x72\x59\xbf\x04\xb6\xb3\x6f\xef\xed\xcc\xf5\xa9\xc0\x39\xa0\x1d\xd6\x18\xb5\xe8\xf6\xe5\x6d\x04\x5c\xc6\xe3\x02\x0b\xa5\x8f\xee\x34\xb1\x46\x74\xaf\x84\x8d\xab\x9d\x0f\x1a\x8e\xe6\xdc\x1d\x28\xe9\x7b\x6c\xd7\x19\xb2\x1e\xcb\x72\xa9\xfa\x34\x99\x4e\xd7\xef\x3d\xe4\x82\x7f\x20\x6a\xd3\xa7\x99\x6d\x18\x68\x14\xcc\x3d\x6c\x50\x62\x07\x6d\x93\x71\x13\x5f\xed\x91\x87\xf5\x7d\xf3\x5e\xd7\xf8\xdb\x37\xdd\x34\xd5\x68\x4c\xc7\x1f\xdc\x60\xe7\x73\x7e\xd8\xf7\xaf\xd3\xdb\xbf\x8c\x2a\xfd\x3c\x6a\x28\x28\xd1\xc7\x86\x68\xc6\xbf\x85\xce\x90\x78\xec\x0e\xd3\xdd\x32\x2e\xd0\x3f\x90\xfa\xfe\x79\xa4\xe4\xcb\x55\x81\x90\x72\x8d\x89\x25\xa7\xba\xcc\xec\x3b\xa6\xe5\xfd\x43\x9e\x09\xe1\xc1\x71\xff\x1e\xb7\x05\xa1\x76\xdc\xa9\x6b\x9f\x0e\x59\x59\x6a\x55\x6a\x4e\xf1\xf4\x2c\xd6\x34\x11\x62\x81\xe1\x04\xbd\x70\x60\x2e\x98\xa5\x2a\x2b\xd1\xb0\xa1\x0f\x2f\x12\xb6\xe8\x5d\x28\x8d\xfd\x87\x6a\x3a\x4a\xd9\xe5\x9e\x7a\xfa\x59\xd1\x14\x3a\x16\xa5\x50\x47\x33\xa2\xd4\xa9\xbd\x95\x5b\x5b\x9a\xab\x8b\x8b\x1d\xb7\x79\xb5\x0d\x13\x55\x5c\x14\x34\x64\x09\xc1\x2e\x06\xdf\x8d\x08\xbb\x6e\x1e\xe6\xb0\x62\xc6\x1c\x94\x4e\x61\xa5\x55\x51\x5a\xe3\xac\xba\x79\x98\xc7\xe3\x2d\xa3\xe9\xad\x6c\xbe\x97\xfe\x7b\x73\x71\x37\xda\x6d\x8f\x94\x57\xf6\xf4\x1d\xb6\x81\xce\x49\x74\xb7\x9a\x44\x11\x29\xeb\xdc\x1d\x21\x9e\xbe\x19\x9c\x5d\x9e\x3b\x8f\xbc\xf2\x43\x18\xfc\x3b\x00\x00\xff\xff\x6d\x01\xaf\x0a\xe2\x19\x00\x00")
+var _vaultedSession1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x55\xcd\x8e\xdb\x36\x10\xbe\xeb\x29\xe6\x94\xd8\x80\xcd\x76\x51\xf4\xd2\xdb\x76\x63\x60\x0d\x24\xb6\x61\xb9\x5b\x14\x55\xb1\x18\x4b\x23\x8b\x88\x44\x0a\x1c\xca\x8a\xdf\xbe\xe0\x8f\xd6\xb4\xd3\x6d\x7b\x15\x87\xdf\xcf\xcc\x37\x94\x38\x3c\xc3\x19\x87\xd6\x52\x55\x2c\x99\x98\xa5\x56\xf0\x90\x89\xfc\x19\x36\x8f\x5f\x56\x99\xd8\xed\xb2\x78\x0e\xd3\x71\xb1\x84\x0e\x15\x9e\x88\xa1\xc4\xb2\xb9\x9e\xb0\xbf\x97\xff\xb1\xd9\xee\xf2\x75\xee\xef\x16\xf5\xaf\x45\xfd\x74\x8f\xd0\x72\x51\xef\xe1\xcf\xa2\x5e\x2b\xec\xa8\xa8\x77\x7f\x65\xe2\x68\xde\x2b\x96\x6c\xbf\x2f\x7f\x17\xdb\x74\xae\xf8\x5a\x9b\xde\x2b\x3e\x08\x21\xfe\x8d\xcb\x50\xa7\xcf\xf4\xdf\x00\xef\xb2\xf7\x83\x39\xd1\xa4\x76\xbb\x3b\xac\xb7\x9b\x3c\x08\xce\x9f\xe1\xd3\x2a\x7f\xda\xaf\xfd\x47\x0f\x91\xc7\xb6\x01\xf7\x38\x2a\xaa\xa0\x36\xba\x03\x0c\x03\x81\x19\x89\x93\x80\xe3\x05\xee\x98\x1a\x6a\xdb\xa2\xde\xcf\x01\x0d\xc5\x01\x2c\x00\x5b\xad\x4e\x30\x4a\xdb\x64\xb6\x21\x28\x0d\x55\xa4\xac\xc4\x96\x41\xd7\x80\xea\x02\x46\xb7\xc4\x80\xcc\x43\x47\x95\xaf\x04\xdb\x50\xb7\x00\xd6\x60\x1b\xb4\xa0\x68\x04\x4b\x5d\xaf\x0d\x9a\x4b\x96\x22\xa0\x21\xf5\xd1\x3a\x50\x74\x0a\x08\xcb\x06\xac\xec\x48\x40\x74\x10\x64\xf8\x42\x20\x55\x9a\x4b\x6f\x27\x0e\x84\xaf\x74\xc9\x2a\x32\xf2\x3c\x19\x74\x02\xbd\x9b\x8f\xec\x0e\x45\x26\x0e\x53\x3b\x43\x30\x7e\x88\x96\xe3\xe4\xb3\xdf\xa5\x6d\xf4\x60\x93\x99\x2c\x7c\x2a\xf8\x0a\xc5\xc1\x43\x83\x67\x02\x04\x4e\x65\x09\x78\x0a\xea\x74\x1d\x6b\xb3\xe0\x57\x83\x6b\x1a\x19\xa0\x6f\x92\xad\x17\xdf\xa1\xf9\x4a\x15\x20\x83\x36\x7d\x83\x8a\x2a\x91\x89\xf5\xce\x2b\xb8\xa1\xd7\x3d\xa9\x84\x1e\x50\x55\x51\x92\xb4\xdf\xad\x85\x80\x95\x6b\x59\x2b\x15\x01\x37\x7a\x64\x3f\x23\x2c\xad\x3c\x93\x9f\x0b\xcc\x82\xe1\x62\xe9\xec\xd7\xda\xdc\xf4\x48\x8f\x2a\x1d\xe8\x7c\x01\x63\x43\xca\x97\x44\x86\x8c\xbe\xf5\xd2\x10\x2f\xbc\x8e\xb1\x21\xdb\x90\x49\x0b\x60\xd4\x43\x5b\xc1\x91\xc0\xd0\xc0\x54\xfd\xe2\x5d\x05\xd2\xf0\xc5\x67\x7e\x79\x73\x47\x72\xac\x0e\x7c\x3e\xa4\x52\x9d\xae\xda\x44\xb2\x49\x5e\x81\x54\xa7\x7f\xc0\x89\xe2\xc0\x6a\x0d\xac\xb5\x02\xab\xaf\x4a\x52\x0c\xb6\xd8\x52\x02\x10\x5a\xdb\x20\x43\xd9\xa0\x3a\xb9\x8e\x4a\x55\xd2\xad\x31\xe4\x29\x98\x69\x90\x4c\x97\x06\xe9\x7f\xae\xf5\xde\x97\xf1\x0d\x7e\xf9\x96\x9d\x24\x6b\xdc\x53\x29\x6b\x49\x95\x80\xc3\xf5\x6b\xa5\xdd\x96\x28\xa2\x0a\xac\xce\x8e\xe4\x43\x42\x15\xcc\xb4\x01\x3a\x93\x0a\x39\x9b\x0b\x78\x09\xf5\x63\x8c\xf5\x5d\x5e\x7d\x10\x0d\xf5\xda\x58\xb7\xd9\xc7\xc1\x66\xd3\x02\xea\x41\xd9\x10\xcf\x1a\x65\x3b\x18\xe2\xd4\xf3\xf4\xf6\xbc\xf9\xc0\xb6\xbd\xf3\x21\xfc\x3b\x14\xdf\xa5\xe4\x6a\xb1\x2c\x96\x53\xe2\x1d\xc2\x56\xb5\x97\xf0\x96\xbd\xd3\x8c\x74\xe9\xee\x16\x29\x70\xac\x36\x2f\xeb\xfd\x76\xf3\x65\xb5\x39\x24\x3c\x2f\x8f\xbf\x7d\x3e\xac\x3e\xbd\xe6\xab\x3c\x5f\x6f\x37\xaf\x87\xed\xe7\xd5\xfe\x71\xf3\xb4\x72\xa4\xcf\x7a\xf4\x40\x70\xa4\x5a\x1b\x02\xbc\xdb\xa3\x6b\x90\x1c\xab\xb4\xc0\x56\xf7\x0c\x47\x72\xa1\x0c\x69\x5a\x00\x32\x60\x56\x0d\x06\xad\xbb\x11\xde\xd0\xc0\xfd\xd3\x8f\x2e\x13\x73\x01\xdb\x33\x19\x23\xab\x38\xe8\x70\x18\x29\x5e\xad\x6e\xc9\xa0\x2a\x7d\x58\x98\xac\x75\xd8\x33\x26\xca\xde\xfe\x92\xa5\x56\xb5\x3c\xcd\x1e\xe6\x7e\x11\x65\xd9\x40\x45\x75\x6c\x87\x8e\x70\x0f\x3f\x3b\xae\xe2\x83\xc8\xfe\x1e\x00\x4d\x58\xee\x56\x61\x07\x00\x00")
+
+func vaultedSession1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedSession1,
+		"vaulted-session.1",
+	)
+}
+
+func vaultedSession1() (*asset, error) {
+	bytes, err := vaultedSession1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-session.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedShell1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x59\x5b\x6f\xe3\xb8\x92\x7e\xd7\xaf\x28\x60\x07\xa7\x13\xc0\x51\x90\x99\x79\xca\xa2\x1f\x3c\x89\x3b\x31\x92\x76\x0c\xcb\xe9\xde\xc6\xf1\x41\x40\x4b\x25\x9b\x68\x8a\xd4\x21\x29\x3b\x46\x63\xff\xfb\xa2\x78\x91\x64\xb7\x92\x99\x59\x60\xf7\x2d\xa1\xc9\xba\x5f\xbe\x2a\xa5\xcb\x7b\xd8\xb1\x46\x58\x2c\x56\x17\x66\x8b\x42\xc0\x55\x92\x66\xf7\x30\x1b\x7f\x9e\x24\xe9\x7c\x9e\x84\x5f\xc1\xff\xb8\xba\x00\x63\x99\xb6\x06\x98\x04\x2e\x2d\x6a\x96\x5b\xbe\xc3\xf0\xf3\x9e\xdb\x2d\xd8\x2d\x82\xc1\x5c\xa3\x35\x50\x2a\xed\xfe\x77\x54\x40\x28\x56\x60\x41\xef\x94\xbf\x45\x8f\x1c\xbb\xec\xdb\xec\x69\x9e\x4d\x33\xc7\x72\x55\xfe\xb1\x2a\x6f\x8e\x18\xaf\xca\x05\xac\xca\xa9\x64\x15\xae\xca\x39\xfc\x73\x55\x4e\x9f\xe6\xcb\xe9\xd3\x2c\x5b\x95\xf3\x7f\x25\xe9\x5a\x0f\xbd\x82\xd5\xc5\xea\x82\x19\xd3\x54\x18\x08\x30\x2d\x07\xdf\x67\xf7\x70\x3b\xc9\x6e\x16\x53\x77\xe8\xa4\xc8\xde\xd1\xf3\xac\x31\x68\x9c\x0a\x9e\x6b\x2b\xa3\x41\x6b\xb9\xdc\x1c\xeb\x3d\x02\x83\x98\xb4\x66\xce\x95\x2c\xf9\xe6\xec\xea\x7c\x04\xbc\x04\xff\x5f\xa3\xb1\xf8\x4f\x50\x76\x8b\x7a\xcf\x0d\xf6\x48\x67\xf7\x93\xc7\x47\x22\x8d\x72\xc7\xb5\x92\x15\x4a\x0b\x3b\xa6\x39\x5b\x0b\x1c\x25\xbc\x24\x9e\x23\x50\x3a\xdc\xbf\x5c\x73\x79\x69\xb6\xab\x72\x71\x9e\x3a\xbd\x82\x9e\x49\xba\x8c\x96\x7d\xc3\x2a\x49\x56\x63\xce\x4b\x1e\x34\x2b\x1b\x21\x60\xbc\x98\x41\x50\x45\x2b\x81\x40\x0e\x00\x55\x76\x07\x56\x81\x27\x95\x42\x86\x48\x0c\xc6\x59\xf6\xfc\x79\x3a\xbb\x83\x31\x2c\x9e\x1e\x27\x64\xee\x35\x0a\xb5\x77\x36\x29\xd0\x32\x2e\x0c\x28\x09\x5b\xb5\x87\x2f\xc1\x5b\x9e\x84\x71\x24\x4d\x9a\xa4\xd3\x79\xb2\x20\xea\xee\xbc\xb6\x5c\x49\xa8\xd8\x01\xd6\x08\x35\xea\x52\xe9\x0a\x0b\x17\x6b\xaa\xb1\x60\x9c\xd4\x07\xb2\x3a\x0b\x71\x66\x15\x98\x9a\xed\x25\x94\x5a\x55\x69\xf2\x75\x8b\xe4\xc4\x9d\xfa\x8e\x05\xd8\x2d\x37\xb0\x67\x87\x11\xe4\x1a\x0b\x94\x96\x33\x61\x80\x69\x04\xa3\x1a\x9d\x63\xe1\x1e\x41\x81\x65\x08\xd9\x9c\x11\x7f\x03\x67\x98\x6e\xd2\xa4\xe7\x85\x51\xeb\x3b\x77\x03\x4a\x2e\xd0\x8c\x80\x4b\x63\x99\xcc\x11\x6a\xad\xe8\x68\x04\x68\xf3\xf4\x3c\x3d\x71\x80\x54\xab\x0b\x83\xc6\x70\x25\x57\xe5\x22\xb9\xe5\x86\x1c\xea\x4d\xbf\x41\x89\x81\x28\xd9\x1a\xab\x5a\x69\xa6\x0f\xc7\x12\xcb\x02\xf4\xb1\x8d\x52\x58\x6e\x31\xa9\x51\x57\x4c\x52\x94\xf4\xaf\x1b\xab\xb4\x4b\xbd\x5e\x3a\x92\xd2\x8d\x71\xa7\xc6\x22\x2b\x86\x0d\x9f\x33\x79\x6c\x78\x56\x5a\xd4\xde\xc0\xde\xe8\x3e\x27\x1a\x43\xff\x75\x81\x7b\x14\x65\x49\xae\xaa\x8a\x49\xef\x35\x77\xc9\x45\x56\x2f\x90\x0e\xaa\x81\x3d\x37\xdb\x5e\x44\x9d\x58\x4c\x63\xa9\xd1\x45\xb6\xcf\x4d\x60\x20\x71\x0f\xc1\x88\x9e\x32\x1d\xbc\x6d\x2f\x06\x81\x06\x16\x80\xaf\x35\xf7\x36\xfe\x99\xcf\xc6\x3b\x85\x52\x23\xfe\x33\x4f\x9e\x76\xa8\x35\x2f\x7c\x6e\xfa\x63\x92\x75\x1d\x6c\x48\xd1\x3d\xfe\x9a\x91\x0f\xb8\x01\x83\xd6\xf4\x2f\xba\x2b\xfb\x2d\xca\x24\xfa\x56\x6e\x86\x05\xf5\x4e\x70\x21\xcb\xe2\x6b\x6e\x3c\x81\xb3\x1d\x67\x30\x20\xe8\xa8\xe7\x54\x6e\x0d\x8a\x72\x14\xb3\x16\x65\x2e\x14\x79\xa6\x1f\xb9\x1f\x4c\xa0\x32\xfe\x9a\xbd\x2c\x26\x77\xd3\xa7\x19\xa9\xab\x74\xef\xf8\x76\xf2\x69\xfc\xfc\xb8\xec\xfd\x1c\x8b\x8e\x39\x1f\x79\xef\x63\xd1\x27\x6a\x60\xcf\x85\x00\x2e\x73\xd1\x14\xfd\x0a\x76\xcc\x84\xfc\xf0\x0e\x97\x64\xa8\xcc\x19\x32\x34\x97\x05\xcf\x99\xf5\x94\x43\x35\xf6\x16\x38\x75\xa0\x31\xdb\xd5\x45\xb0\x33\xae\x2e\xbe\xe3\x81\x08\xdf\x85\x03\x27\x01\x75\x22\x60\x12\x16\xd9\x18\xbe\xe3\xa1\xd7\x92\xbc\x62\x31\xaa\x3e\x18\xc8\xb2\x7b\x60\x1b\x94\x76\x90\x4d\xad\xd5\xeb\x61\x75\xe1\x2e\x10\x97\xc9\x6b\xad\x62\x6f\xc0\x57\x8b\x5a\x32\xd1\x91\x80\x61\x2e\x83\x94\x0d\xdf\x50\x7e\xad\x2e\x1a\x4d\xad\x25\xb9\x89\x8d\x22\x10\x97\x45\xad\xb8\x27\xd9\x18\x74\xf1\x47\x7c\x48\x9b\xf0\x34\x85\x9b\x46\x6b\x94\x56\x1c\x40\x49\x71\xe8\xf5\x9a\xc4\x2a\xd8\x2b\xfd\xdd\x67\xcd\x3d\x33\x5b\x7e\xa3\x74\xed\x0b\x72\x4b\xdb\xfc\x89\x60\x06\xb5\x19\x10\xcd\x9d\xbb\x22\xcc\x37\x32\x0a\xe5\xb1\x00\xa5\x40\x5f\x44\xe0\x06\x50\x92\x8f\x0b\xdf\xad\xc6\x5f\x33\x78\x98\x7c\x73\x1d\xf8\x9f\x54\x34\x50\xda\x7f\x5d\xc3\x7f\xc0\xd9\xd7\xfb\xc9\x0c\x3e\x3f\xdd\x4e\x3f\x7d\xa3\xee\xb2\xbc\x9f\x64\x13\xb8\x7d\xba\xc9\x46\x30\x7e\xcc\x9e\xe0\x79\x7e\x3b\x5e\x4e\xae\x3b\x38\x83\x72\x97\x5e\xa5\x15\xf9\xb9\xe8\xba\x2f\xbe\x62\xee\x8e\xcf\x1d\x8f\xd8\x81\x5c\x43\xff\xeb\xa5\xd3\xaa\x58\xa4\xb1\x4b\xe3\xa4\xff\xca\x97\x43\x52\x27\x5b\xba\xaa\x40\xd1\x6a\x1a\xe1\x32\xff\xb4\xf5\x74\x8e\x21\xca\x82\x19\x4b\xd6\x4a\x88\x5f\xd1\xf4\x3a\x41\xcb\x3f\x16\xbd\xb3\xde\xcb\xae\x38\x04\x65\x01\x0b\x6e\x03\x10\x98\xcf\x93\xe5\x60\x5d\xac\x1a\x63\xdb\x22\xc6\x25\x28\x5d\xa0\xee\x8a\x30\x30\x57\x9e\xd3\x00\xcc\xa6\x33\x65\xf1\xda\xf7\xf4\x9c\x51\xdc\x45\x03\x86\x86\xe9\xfd\xde\xac\x8d\xe5\xb6\x71\xba\x0e\x1b\x95\xe2\x2e\x19\x2c\x80\xbe\x98\xf5\xef\x52\x6b\xa8\xb5\xda\xb9\xe2\xab\x5a\x8e\x84\x08\xa4\xb2\x50\x31\x9b\x6f\x13\xbb\x55\x06\x49\x01\x36\x90\x5d\xa7\x8e\x26\xb7\x50\x97\x2e\x98\x2e\x60\xb8\xe2\x50\xb4\xf6\x84\xb8\x4e\xd2\x45\x46\xa5\x19\x56\x67\xeb\x06\x7e\x4d\xba\x1a\x36\xbe\xb9\x99\x64\xd9\xcb\xc3\xe4\xdb\xcb\xf4\x96\xd2\x81\xd0\xe8\x58\x02\x77\x6f\x4b\x8e\xba\x85\x83\x2c\xcf\xd1\x18\x4a\x80\x14\x9e\x25\xff\x77\xe3\x14\x42\x96\x6f\xc1\xa0\x25\x17\x77\xd6\x52\x7a\xd8\x3e\xe9\xb0\x14\xd9\xe4\x66\x31\x59\xf6\x84\x89\x92\x2c\x5b\x38\xee\x7d\x1c\xf3\x52\xe3\xbf\x1b\x34\xd6\xfc\x1f\x48\x92\x65\xd3\xa7\xd9\xcb\xf2\xe9\x61\xe2\x4a\xfe\x25\x1c\x89\xf9\xbc\x98\x2e\xbf\xb5\xbf\x3a\x19\xe7\xde\xbb\xbe\x45\x46\x24\x31\xc8\xf2\x3d\x52\xc0\x4d\x8c\x13\x57\xe0\x4c\x53\xd7\x4a\x5b\x10\xb8\x61\xf9\x01\xb2\xdb\x07\x12\x79\x31\xf1\x85\xe6\x18\xa6\xfe\xbf\x15\x9c\xf1\x09\x6e\x8e\xf8\xca\x04\xf0\x5d\x00\x72\x1a\x03\x7c\x28\x3b\x2a\x1f\xcc\x09\xd2\x24\x1c\x90\x0c\xa7\x3a\x28\xdd\x23\x45\x25\xe1\x0d\x44\x06\xaa\xb6\x3f\x25\x47\xc9\xb5\xb1\x6d\x65\xf3\xa0\x29\x67\xf9\x96\xfe\x6c\x6b\xce\xf1\x4c\x77\xe6\x28\xf6\xd0\x77\xd2\x9b\xd3\xf6\xcc\x74\xd2\x9c\x3b\x72\xed\xe4\xd4\x55\xc3\x48\xd8\xaa\x88\x32\xdd\x85\x60\x1f\x32\x57\x92\x33\x21\x02\xb4\x62\x42\xa8\xbd\x09\x53\x66\xfb\x70\x8d\x5e\x50\x0f\xc4\x18\x08\x25\x37\xa8\xbb\xea\x69\xb7\x4c\xf6\xa8\x26\x5a\x09\x01\x44\xd5\xa3\x16\x47\x14\xce\x2a\xf6\xca\xab\xa6\xa2\xf0\xbf\x82\xad\x6a\xf4\xb9\xaf\xdc\xfd\x6a\xd4\xe6\xb2\x23\xe4\x41\x78\xc2\x74\xcb\x9f\x11\xeb\xfe\x24\xec\x65\x74\x78\x1d\x5d\x06\x36\xd2\x72\x41\x3f\x1e\x5c\xf1\x67\x6b\x1a\x63\xac\xf2\x90\x14\x93\x33\xa5\x8f\x5e\x72\xd3\x83\xad\x8e\xee\x10\x26\x0e\x45\xfe\x9b\x6a\x5c\x44\x31\x61\x54\x1c\x8d\x80\xf5\x86\x37\x92\x23\xba\xdb\xab\x6e\x29\xd3\x2c\x25\x4c\xae\x31\x02\xac\x76\xfa\x73\xd3\x10\xb7\x23\x10\xfc\x3b\x82\x51\xd7\x8e\x8d\x2b\x86\xb2\x4c\xde\x1a\xb7\x21\x6b\x6a\xd4\x34\x4a\x24\x69\xc9\x7d\xd2\xcd\xe7\xc9\x7e\xcb\xf3\x2d\xec\x55\x23\xc8\x18\x46\x89\x1d\x46\x48\xe4\x18\x32\x2d\x83\x72\x4c\xcb\x6b\xb6\x37\xd7\x9c\x55\xd7\xd7\x57\x57\x57\xbf\xfe\xfa\xeb\x6f\xbf\xfd\xf6\xfb\xef\xbf\x5f\x93\x2a\x97\x2d\xf9\x55\xb9\x58\xfd\xc3\xab\xee\x31\x73\x17\x8d\x74\xd1\x37\x93\xe8\xd6\xd3\x5e\x3a\xdc\x90\xb9\x81\xab\x84\x9c\x3f\x22\x7c\xc9\x74\x21\xd0\x98\xf8\xa4\x25\xd1\x25\x59\x1f\x18\x9c\xa6\xaa\x97\x6c\x2a\x81\x15\x05\xb7\x21\x56\xfd\xed\xd8\x68\x3a\x42\x6c\xad\x76\x38\x6a\xbd\x13\x4a\x99\x69\xdf\x32\xf1\x06\x3a\x76\x41\xc1\x25\x65\x8e\x17\x2e\x84\x54\x98\xad\xde\xe8\x5d\x5f\x08\x79\x4f\x6e\x5f\x26\xb3\x2f\x2f\x54\x02\xa9\x77\x3c\x3d\xcf\x96\xbd\x2e\xb6\xf4\x3d\x4b\x35\xd2\xc2\xf4\xf6\x68\x5e\x0b\xe1\x9f\xfe\x15\xba\x8b\x59\x9f\x60\xb7\x58\xf8\xdf\x91\xa3\xd5\x54\x9f\xde\x4f\x3b\x89\xbf\x41\x6b\x3e\x5e\x2c\xa7\xcb\x30\x7a\x44\x82\x84\x0e\x6a\xa6\x2d\x3f\x8a\x95\xbf\x4d\x79\x79\xdf\x27\x5a\x33\xbb\x7d\x83\x56\x48\x8e\x4f\x4a\x03\xbe\xb2\xaa\x16\xf8\x17\x93\xec\x4f\x92\x84\x58\x5e\xbe\x91\x88\x31\x05\xdd\xc8\xe8\x03\xb8\x54\x54\x04\x29\x1b\xba\xc8\x5a\xa3\x2f\xd0\xf6\x58\xa2\x77\x42\xe7\x63\x5f\x8e\x64\x28\x16\x3e\xfe\x3d\xb1\x07\xfd\xff\xf1\x9d\xdf\x5b\x9f\x7e\x64\x7b\x93\x0c\x3a\xe6\xa3\x67\xd2\x99\x24\xbb\x87\x2f\xe3\xc5\x74\xfc\xc7\xe3\x04\xa6\xb3\xe5\x64\x31\x7f\x7a\x1c\xb7\xab\xc0\xa5\x4b\x58\xd1\xa0\xe9\x50\xb8\x1e\x1e\x0c\xa8\xf6\x6a\x2c\x51\xa3\xcc\xd1\x2f\xf4\x4e\x12\x35\x04\xcc\x2f\x3f\x48\x8f\xff\xf6\xf5\x0b\x16\xf1\x89\x9f\x07\x42\x6d\x0c\x78\xa8\xd7\x0c\x3e\x98\x1e\x35\xba\x69\x90\x5a\x70\x69\x51\x27\xa1\xca\x73\x13\x43\xeb\x7c\xd4\x5b\xca\xc4\xf2\x44\x92\x8f\x4e\x4a\x50\x04\x4d\xb0\x3e\xc4\xea\x93\xb8\x75\x17\xfc\x14\xdc\x6e\xdb\x30\x30\xd7\xf7\x36\x90\xef\xd7\x95\xf3\x91\x6b\x41\x96\x36\x55\x8c\x86\x53\x38\x5a\xa9\x39\x63\x32\xeb\xc7\x90\x14\xbe\xb4\x32\xba\x53\x7a\xf0\xc1\x3a\xa5\x7b\xed\x83\xc9\x84\x3a\xd8\xc1\xfb\x28\x3d\x4d\xa6\x91\x43\x26\x51\xba\x3f\x9e\x6f\x1e\x26\xcb\xb0\xab\xa5\xd7\xfe\x58\xa8\x8d\x59\x5d\xfc\xf2\xe3\x1d\xe1\xc9\x57\x49\x60\x6b\x4e\x5f\xf6\xc3\x98\x88\x87\xa0\x08\x22\xf8\xd2\xee\x25\x7b\x36\x11\x8f\xfd\xf2\xcb\x0f\xba\x1a\x00\x0b\xb7\xa8\x99\x88\x3f\xfd\x08\x81\x31\x8e\x07\x74\xd3\xd9\x80\x1b\x32\x81\x4f\x56\xef\x32\x7f\xe3\x47\x40\xc0\x02\x4b\x0b\xcc\x00\x37\x69\x32\x76\x8a\xbb\x67\xba\x8b\x30\xbf\x22\x82\xb3\x82\x6b\xcc\xdd\x72\x80\xee\x68\xd5\x6c\xb6\x5d\xc4\x9a\x73\x17\x49\x12\x50\x6b\xa5\x53\x97\x03\xaa\xb1\x75\xe3\x86\x03\x06\x71\xa1\x47\x0c\xce\x0c\xe2\x1b\xa3\x67\x84\xb7\x2d\xfb\x62\x04\xeb\x26\x68\x91\xb8\xcd\x7a\xad\x04\xa3\x27\x5e\x2c\xbf\x06\xf0\xd3\x0c\x7c\x9a\x3e\x4e\xb2\x36\x05\xc3\x14\xe3\xf6\xac\x83\xc9\xf7\x9e\x1c\x94\x2c\x7b\xcd\xad\x45\xd7\x83\xdd\xee\x30\xf1\x16\x50\xfa\x10\x82\x8e\x96\x24\x87\x88\xa0\xfc\xac\xb6\xc6\x52\x69\xec\x3e\x57\x90\x55\xdc\x27\x10\x2c\xfc\xbe\xb5\xa3\xc1\x4d\x40\x50\x4e\xac\xe0\xb7\xff\xba\xbd\x7b\x59\x3c\xcf\x96\xd3\xcf\x93\x97\xdb\xe9\x82\x9c\x74\xd6\x98\x86\x09\x71\x00\x06\xb6\xaa\x4b\xe3\x3f\x04\x70\x0b\x7e\x71\x48\xa9\x94\x04\xbd\xcc\xc1\x58\xac\x3e\x98\xde\x34\xd4\xf1\x6b\x3f\x17\xa4\xf0\x89\xb7\x39\xd2\x81\xbe\x98\xe0\xc9\x96\xed\xd0\xff\x18\x8f\x62\xf0\xdb\x93\xce\x44\xb6\xed\xa1\xa9\x4e\x6b\x7c\xe5\x36\x8e\xe6\x4a\x5a\x37\x16\x76\x4f\x1c\x68\x52\x3b\xd4\xd1\xc2\x31\xc5\x8f\x8c\xa3\xb1\x52\x3b\x32\x5b\xc6\x37\x92\x09\x3a\xc8\x91\xef\x8e\xea\x4e\x28\x7f\x82\x1d\xfc\xa8\xda\x4a\x30\x02\xa3\x92\x8e\x1f\x5d\x73\x18\x37\x10\xed\x55\xca\xe8\x25\x17\x5c\xba\xa9\x6d\xdc\x2d\xd1\x26\xea\x61\xf2\x0d\xb2\xe9\xdd\x6c\x3a\xbb\xf3\xd0\xac\x74\x0e\x77\x16\x0a\x1e\x77\xd1\x71\xba\x12\x6b\xb7\xfa\x21\x07\xfb\x2b\x9b\xde\xea\x2d\x09\x7b\xad\x51\x1b\x46\xed\x3d\x27\x5c\xd4\xb2\x37\xb9\xb0\xc6\x2a\x82\x6d\xb9\x0b\x09\x7a\x0f\x4c\x08\x22\x66\x12\x56\x14\x9d\x19\xe2\x12\x92\x86\xc8\x70\x01\x7e\xbe\xe0\x41\x3d\x0d\x94\x7c\x23\xdb\x71\x48\x49\x74\xf3\x4c\x87\x5f\xfd\x32\xde\x5d\x4d\x8e\x45\xd0\x28\x71\xef\xf0\x27\x57\xba\x9d\x4b\x58\x44\xaf\x28\x8d\xd7\x86\x79\x84\xe9\xe5\x70\xee\xd8\xb3\x83\x49\x76\x4c\xf0\xa2\x9d\x92\x06\xd7\x57\xe4\x4b\x37\xa1\x01\xf3\x83\xdc\xa9\xb5\xad\xfa\x8e\x32\xd1\x58\x31\x2e\xa9\x65\x09\x5e\x78\xc5\x1f\x8e\x57\x87\x0e\xa5\xe5\x8d\x60\x5a\x1c\xa0\x31\x58\x36\xc2\x07\x42\xae\xaa\x35\x97\x71\x5e\x0a\xce\xe9\x7f\x57\x71\x93\x48\xe2\x0c\xb0\x69\x17\xc3\x34\x7e\xb6\x5f\x65\xa8\x17\xad\x2e\x2a\xac\x28\x7a\xe9\xb5\x55\x60\x10\x8b\x9e\xa9\x9d\x0d\xe2\xfc\xee\xcc\x9d\x28\xe9\x6b\x40\x87\xfd\xcb\xde\x04\xee\xba\xcb\xcb\xf8\xf6\x76\xf1\xd6\x17\xbd\x50\x03\xda\xf0\x89\x7b\x2f\xe6\x92\xc2\x2d\xbd\x29\x5f\x93\x76\x8c\x20\x73\x45\x8b\x3c\x2f\x1e\xe3\xb7\x9c\x36\x3b\xdd\x58\x55\x14\x1a\x8d\xe9\x26\x44\xb7\xf4\xf3\x31\x3f\x6c\xfb\xd3\xf0\xf6\x5f\xcd\x94\xfe\x3e\x8a\xeb\x89\x1d\x67\x10\x97\x10\xab\x7f\xa4\x4e\x90\xd5\x85\x7b\xec\x9a\x1a\x17\xe8\x3f\x9e\xf9\x6a\x78\xa0\xe0\xdb\xaa\x0a\xfb\xf5\x4b\x03\x3b\x32\x4c\xbb\x13\x1a\xb2\x4c\x0a\xcf\x0e\xcc\xf4\xea\x3b\x08\xb5\xe1\x8e\x5d\x3b\x6e\xb3\xba\xd6\xaa\xd6\x9c\xfc\xe9\x37\x1c\x26\x7a\x88\x25\x86\xbb\x66\xbc\x67\xce\x99\xb5\xaa\x1b\x11\xe7\xdd\x77\x15\xe9\x20\x45\x45\xbd\xa0\xf7\x11\x93\x9e\x52\x74\xb9\xcf\x00\xfd\xa8\x88\x89\x8e\x55\x2d\xd4\xc1\xf8\x4f\xc0\x9e\xc9\xd6\xda\xda\x5c\x5f\x5e\x6e\xb8\xdd\x36\xeb\x34\x57\xd5\x65\x45\x0b\x38\x21\xd8\xe5\xe0\x37\x05\xaa\x5d\x77\xcf\x53\x98\x33\x63\xf6\x4a\x17\x30\xd7\xaa\xaa\xad\x71\x52\xdd\x3d\x4f\x57\x17\x6b\x66\x28\x61\xe3\xef\xb5\xff\x3d\x2a\xee\x96\x0e\xeb\x43\xfb\x71\xfa\x24\x1e\x27\xb7\x2f\xe3\xec\x61\x3e\xce\x32\x62\xd6\x99\x3b\x3b\x6d\xa6\x67\x57\xe7\x11\xaf\xf4\xed\x90\x26\xff\x33\x00\x62\x7a\xe9\x72\x46\x20\x00\x00")
 
 func vaultedShell1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -300,7 +492,47 @@ func vaultedShell1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedUpgrade1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x4d\x6e\xc3\x20\x10\x85\xf7\x9c\x62\x2e\x10\xa4\x1e\xa1\x4d\x23\xc5\x8b\x3a\x96\xf1\xa6\x12\x9b\x89\x67\x88\x23\xd9\x90\xf2\x93\xb6\xb7\xaf\xc0\xa1\x0b\x2f\xb2\x43\xbc\xf7\xbe\x4f\x20\x87\x23\xdc\x31\xcd\x91\x49\xef\xd2\xed\xe2\x91\x18\x5e\x84\x54\x47\x68\x5f\x3f\x0e\x42\x76\x9d\x78\xe4\x50\x63\xbd\xab\xc7\x00\x33\x5f\x70\xfc\x5d\x11\x01\xa2\x83\x38\x31\x8c\xc9\x7b\xb6\x71\xbd\x05\xe3\xfc\x82\xb1\x20\xd5\x67\x7b\xea\x54\xa3\x0a\x56\x9b\x37\x6d\xf6\x1b\xb8\x36\x7d\x69\xbe\x1f\xd4\xbe\x6f\xba\xa1\x39\xb5\xa5\xdc\x33\xd2\xd6\x86\x96\x60\x74\xf6\xce\x3e\xab\x27\x5e\x9e\xf9\x25\x0c\x13\x43\xc0\x85\xc5\x0d\x43\xf8\x76\x9e\xe0\x1a\x20\x05\xa6\xdc\x58\x77\x2b\x8c\xe9\x61\x90\x45\x9d\x77\xfc\x73\x8d\x30\x3a\xe2\xbc\xe1\xaf\x84\x73\x75\xd9\xb4\x9c\xd9\x83\x33\xff\x7f\x30\x61\xae\xa6\x99\xc0\xba\x08\x67\xae\x4f\x23\x29\xfe\x02\x00\x00\xff\xff\x93\xa5\x62\x52\x6e\x01\x00\x00")
+var _vaultedSync1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x55\xc1\x8e\xe3\x36\x0c\xbd\xeb\x2b\x78\x28\xda\x19\x20\xf1\x62\x7b\xed\x29\x9d\x04\x9d\x00\xdd\x49\x90\x64\x17\x2d\xea\x62\xc0\xd8\x54\x2c\x8c\x2d\xb9\xa2\x9c\x20\xfd\xfa\x82\x92\x9c\x4c\xd2\xdd\xc3\x9e\x62\x5b\x22\xdf\xe3\xe3\x23\x53\xec\x9e\xe1\x88\x43\x1b\xa8\x2e\xa7\x7c\xb6\x15\x7c\x54\xc5\xf6\x19\x5e\x66\x9f\x16\xaa\x58\xaf\x55\x3e\x84\x78\x56\x4e\xe3\x2f\xa7\x10\x86\x93\x09\x0d\x20\x1c\x4c\x00\x4f\xbd\x63\x13\x9c\x3f\xc7\xf8\xed\x9f\x2f\xab\xf5\x76\xb9\x8d\x39\x4a\xfd\x6b\xa9\x9f\xde\x67\x2a\xf5\x06\xfe\x2a\xf5\x72\xb5\xde\x2d\x57\x2f\xdb\x52\xaf\xff\x8e\x61\xf3\xc5\xf6\x69\xb3\x8c\x1f\x63\xe4\x36\xa2\x85\x86\x12\x22\xd4\xc6\x53\x25\x20\x23\xb4\xa7\xce\x05\xba\x63\x30\x01\x6c\x5b\x77\x32\xf6\x30\x12\x0d\x0e\xf6\xa4\xb8\x41\x4f\x35\x3c\x50\x71\x28\x62\x02\x63\x01\x21\x10\x76\x8f\x05\xec\xbe\x02\x62\x18\x12\xf7\x1f\xfe\x98\xff\xf6\x3a\x9f\xed\x66\xaf\xcf\xab\x4f\x8b\x0f\xb9\x94\x0f\xa5\xde\x4c\x94\xf3\x91\xe0\x35\x8a\x7b\xaa\x8c\x36\x54\xc3\xfe\x9c\xe3\xcb\x69\x39\xad\x8d\x97\xb2\x9d\xcf\xdf\xbe\xcc\x3e\xff\xbe\x5b\xcc\x5f\xe7\xcb\x8d\x7c\x7f\x60\xa2\xb1\x15\x0f\x1f\x1f\x85\x91\x4b\x8c\xc1\xd9\xf6\x0c\xec\xba\x7c\xce\x13\x78\x23\xea\x05\xb5\x83\x58\x02\x53\x8f\x1e\xc3\x0d\x09\x6a\xa9\x12\xb9\xa3\x50\xf7\x2c\xd4\x37\x58\x94\x3f\x16\x51\x77\x11\x43\x1b\xcf\x21\xf5\xbd\x1b\x38\xe4\xb2\xce\xb1\xd8\xab\xd6\x22\x6d\xbc\x13\x71\x06\x16\xd1\xaf\x68\xa9\x3d\x22\x13\x9c\x1a\x53\x35\xca\x58\x13\x0c\xb6\xe6\x5f\xfa\x7a\x5b\x91\xb3\x9f\x4e\xce\xbf\x49\xae\xe0\x89\x0a\x98\xe9\x40\xfe\x84\xbe\xe6\x09\x10\x56\x0d\x54\x0d\xda\x03\xa9\xe0\x00\x73\x8e\xd4\x55\x26\x6c\x25\xcc\xf9\x68\x8d\xa3\x3c\x9b\xf0\x08\x86\xa1\x72\x5d\x67\xc2\x45\x12\x84\x8e\x98\xf1\x40\x60\xb1\x8b\x48\x0d\x25\xb7\x03\xda\x5a\xde\xc0\xf5\xe4\x31\x18\x67\x93\x28\x0b\x01\x8e\xb5\xa6\x54\x0c\x68\xcf\xe0\x86\xc0\x01\x6d\x2d\x29\x12\x2b\x9e\x40\x3f\xb4\x2d\x8f\xaf\xa0\xbd\xeb\xb2\x6c\x22\xc7\x44\x00\x54\x68\xc8\x42\x3f\x70\x93\x95\xf0\xc4\x82\xbd\xc7\xea\x4d\x34\xbd\x5e\x2f\xe0\x4b\x32\xf1\xd0\xd7\x28\xf4\xef\xd2\x01\x7a\x52\xad\x61\x39\x72\x16\x38\xd4\x6e\x08\x05\xac\xc4\x34\xd9\xfe\x62\x22\x21\x4e\xf5\x2f\xd0\x18\x4e\x33\x12\x3c\x72\x13\xb9\x00\x13\xb3\x71\x16\x2a\xac\x1a\x52\xda\xb4\xc4\x92\x1c\x8d\x85\xd6\x55\xd8\xa6\xfa\x97\xfa\x22\xf6\x09\xc7\xea\x6a\xd8\xbb\xd0\xa4\x6b\xed\x39\x66\x4b\xb4\x5a\x81\x68\x12\x2e\x18\x06\xdc\x3b\x3f\x8a\xef\x86\xa0\x62\xb8\x88\x86\xf6\x1c\x9a\xf4\x90\x64\xaf\x9c\xd5\xad\xa9\xc2\xbb\xf9\x95\x02\x52\x89\x05\x7c\x66\x7a\xe7\x30\x99\x84\x72\x2a\x1c\x64\x88\x82\x53\xe3\x68\x24\x4a\x70\x24\x1f\x4b\x73\x3a\x1b\xe7\x3e\x79\x12\x00\x8f\xf4\x5e\xd2\x1c\xa5\xa2\x1d\x2d\x9d\x46\x8f\x59\xec\xa8\x06\x14\x37\x5e\xed\x3b\x81\x68\xbd\xc4\x49\x6e\x94\xd3\x9f\x4b\xbd\xc9\x3b\xe5\x36\x23\x18\x56\xae\x27\x2b\x6b\xc8\xd3\x3f\x03\x71\x48\x16\x65\xe8\x91\xf9\xe4\x7c\x3d\x01\xa3\xc1\x12\xd5\x54\x3f\x02\x3b\x30\x01\x2a\xb4\xb0\xa7\x68\x6e\xaa\x61\xb0\x75\x86\xb7\x74\x52\x02\x98\x90\xae\x3c\x45\x83\xd1\x55\x95\xe9\x0d\xd9\xc0\xa2\xc0\xff\x2b\x8c\x6b\x47\x5d\xfe\x01\xae\xd7\xd3\x0a\x5a\xea\x0c\xff\x53\x10\x02\x89\xf9\x77\xf6\xb5\x88\x9b\x3d\x6f\x7a\x55\xec\xd6\xea\xda\xbd\xcb\x7e\x80\x52\x2f\x07\xdf\x96\x7a\xad\x9e\x9c\xd5\xe6\x30\xf8\x3c\x17\xb7\xab\xfd\x66\xdd\x14\x77\xd9\x6e\xbc\xa0\x36\xc4\xae\x3d\x12\xdf\xb4\x7c\x1c\xc9\xfd\x39\x8a\x94\xe7\xfe\x1b\x5e\xb9\xf8\x43\x31\x1e\xc7\xab\x77\xfa\xdd\x3a\xa4\x50\xff\x0d\x00\xea\x27\x75\x4d\x51\x07\x00\x00")
+
+func vaultedSync1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedSync1,
+		"vaulted-sync.1",
+	)
+}
+
+func vaultedSync1() (*asset, error) {
+	bytes, err := vaultedSync1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-sync.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedTrash1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x53\x4d\x8f\xda\x30\x10\xbd\xfb\x57\xcc\xa9\x02\x69\x71\x4b\x2f\x3d\xef\x6e\x57\x5a\xa4\x16\x22\x82\x2a\x55\x75\x0f\x03\x19\xc7\x56\x13\x1b\x8d\x0d\x34\xff\xbe\x8a\xe3\xf0\xb1\x65\x8f\xf1\xcc\xbc\x37\xef\xcd\x8b\xdc\xbc\xc2\x11\x0f\x4d\xa4\x4a\xcd\x22\x63\x30\x30\x17\xb2\x7c\x85\xe5\xe3\xf7\x17\x21\x8b\x42\xe4\x2a\x0c\x45\x35\x83\x16\x1d\xd6\x14\x86\xb1\x00\xd1\x60\x04\x83\x47\x82\x2d\x91\x03\xa6\xd6\x1f\xa9\x4a\x18\xe5\xcf\xe5\xaa\x28\x17\x65\xc2\x51\xfa\x49\xe9\xe7\x5b\xb4\x26\x28\xbd\x16\x72\xcb\xf7\xab\x36\xc4\x54\x7f\x67\x9a\x29\x44\xcf\xa4\xf4\x1a\x94\x5e\x38\x6c\x49\xe9\xe2\xdd\xee\xfd\x81\xeb\xd4\xfb\x4b\xe9\xc5\xaa\xd8\x2c\x56\xcb\x52\xe9\xe2\x77\xda\xf4\xeb\x4b\xf9\xbc\x5e\xa4\xc7\x04\xf0\x63\xd0\x96\xc5\xc0\xc9\x46\x73\xb1\x89\xdb\xc9\x7c\x0a\xc8\x04\x43\x35\x7a\x88\x86\x32\x8d\x75\x21\x12\x56\xe0\x35\x6c\xc9\xba\x5a\x8c\x18\x7b\xe2\x16\x1d\xb9\xd8\x74\x12\x32\xbe\x75\x57\x93\x4c\x2d\x5a\x07\xe4\x76\xdc\xed\xe3\xc8\xda\xd7\xf7\x18\xc2\xc9\x73\x25\xa2\xa1\x0e\x4e\xc4\x04\x81\xb0\xc9\x2d\x52\xc8\xcd\x28\x39\x19\x0a\x1f\x21\x7f\x65\x03\xbf\xd9\x90\x0e\x45\x70\xbc\xc3\x8b\x8d\x77\xf5\x85\x2c\xda\x96\x80\x70\x67\xe0\x84\x67\x07\x1e\xa0\xf5\x21\x0a\xa6\x5d\x12\x30\x3e\x83\xb6\x1c\xe2\xf5\x02\xf7\x6f\xb2\x1e\x5e\x87\x1d\x7a\x24\xf8\x0f\x29\x6d\x06\xfd\x40\x75\x35\xa9\x3e\x48\x78\xcc\xb5\x1d\x3a\xe7\x23\x6c\x49\x64\x92\x0a\xfc\x91\x18\xd0\x01\xfd\xb5\x21\x5a\x57\x0f\x9d\xd7\xfb\x8c\x57\x17\xc5\xc5\xfe\xcc\x79\x4e\xb0\x66\xdf\x5e\xfc\x78\x78\x63\x88\x65\x30\xb6\xe7\xeb\x60\x12\x88\xc4\x39\x06\xf9\x75\x32\x9f\x4e\x25\x6c\x0c\x9d\xdb\x6c\x80\x3f\xb4\x8f\x60\x35\x60\xde\xdd\xeb\x44\x10\xb0\xa5\xa4\x71\xd8\x38\x88\x89\xe7\x7c\xf7\xdb\xa3\x4c\x65\x8a\x65\x8e\xe9\x95\x1e\x35\x53\x33\xdf\x54\xc4\x6a\x16\x0d\xba\xec\x73\x75\x60\x8c\xd6\xbb\xde\xeb\x95\x6b\xba\x21\xec\x37\x7f\x68\x4a\xcd\x68\x76\x2f\xb0\x77\xae\xee\x93\x8b\xee\x0d\x04\x4c\x48\xd6\x32\x67\xe8\xcb\xe7\x4f\x46\xe9\xf5\x54\x8a\xa7\x0e\x2a\xd2\x3d\x64\x6f\x51\x73\x3f\x4b\x4c\x03\x77\x25\xc5\xbf\x01\x00\x55\xb5\xa2\x38\x5b\x04\x00\x00")
+
+func vaultedTrash1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedTrash1,
+		"vaulted-trash.1",
+	)
+}
+
+func vaultedTrash1() (*asset, error) {
+	bytes, err := vaultedTrash1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-trash.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedUpgrade1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x90\x4d\x6e\xc3\x20\x10\x85\xf7\x9c\x62\x2e\x10\xa4\x1e\xa1\x4d\x23\xc5\x8b\x3a\x96\xf1\xa6\x12\x9b\x89\x67\x88\x23\xd9\x90\xf2\x93\xb6\xb7\xaf\xc0\xa1\x0b\x2f\xb2\x43\xbc\xf7\xbe\x4f\x20\x87\x23\xdc\x31\xcd\x91\x49\xef\xd2\xed\xe2\x91\x18\x5e\x84\x54\x47\x68\x5f\x3f\x0e\x42\x76\x9d\x78\xe4\x50\x63\xbd\xab\xc7\x00\x33\x5f\x70\xfc\x5d\x11\x01\xa2\x83\x38\x31\x8c\xc9\x7b\xb6\x71\xbd\x05\xe3\xfc\x82\xb1\x20\xd5\x67\x7b\xea\x54\xa3\x0a\x56\x9b\x37\x6d\xf6\x1b\xb8\x36\x7d\x69\xbe\x1f\xd4\xbe\x6f\xba\xa1\x39\xb5\xa5\xdc\x33\xd2\xd6\x86\x96\x60\x74\xf6\xce\x3e\xab\x27\x5e\x9e\xf9\x25\x0c\x13\x43\xc0\x85\xc5\x0d\x43\xf8\x76\x9e\xe0\x1a\x20\x05\xa6\xdc\x58\x77\x2b\x8c\xe9\x61\x90\x45\x9d\x77\xfc\x73\x8d\x30\x3a\xe2\xbc\xe1\xaf\x84\x73\x75\xd9\xb4\x9c\xd9\x83\x33\xff\x7f\x30\x61\xae\xa6\x99\xc0\xba\x08\x67\xae\x4f\x23\x29\xfe\x06\x00\x93\xa5\x62\x52\x6e\x01\x00\x00")
 
 func vaultedUpgrade1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -320,7 +552,27 @@ func vaultedUpgrade1() (*asset, error) {
 	return a, nil
 }
 
-var _vaulted1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x7f\x6f\xdb\xbc\x11\xfe\xbb\xfc\x14\xb7\x6c\xe8\x9b\x00\x8d\xdc\x0e\x5b\x87\xb7\x03\x06\xb8\xb1\xdf\xc6\x5b\x53\x1b\x91\xdb\x75\xa8\x8a\x82\x16\x4f\x36\x51\x8a\xd4\x78\x94\x1d\xff\xb3\xcf\x3e\x1c\x29\x29\x8e\xe3\xa0\xc5\x1b\x20\x80\x45\xf1\x9e\xe7\x7e\x3c\x3c\x9e\xb2\xe5\x35\x6c\x65\x6b\x02\x2a\x78\x25\xb2\xfc\x1a\x3e\x8c\x6f\xa6\x22\x5b\x2c\x44\xbf\x5c\x5c\x02\x35\x72\x67\x81\x90\x48\x3b\x4b\x50\x79\x57\x03\x61\xd9\x7a\x34\x7b\xa0\xe0\x3c\x2a\x7e\xf6\x18\x28\x62\xe4\xff\xf9\x30\x5f\xe4\xb3\x3c\xe2\x14\xd5\xdb\xa2\xba\xea\xd0\x8a\xea\x16\xd2\x42\x71\x69\xd3\xc3\xcc\xca\x1a\x8b\x6a\x01\x5f\xfa\x17\xba\xa8\x6e\xbf\x8a\x6c\xe5\x7f\x87\x6d\x71\xc9\xc6\xfc\xea\xea\x66\x52\x54\x8b\xa7\x5c\x98\x5d\xcd\x6f\x6e\xc6\x1f\x26\x9d\xf1\x4c\xfa\x35\x65\x59\x56\x54\x8b\xaf\x31\x84\xc9\x34\xbf\xba\x9d\x2d\x96\xb3\xf9\x87\x08\x31\xab\xc0\xba\x23\x3b\x4d\xd0\x78\xb7\xd5\x0a\xd5\x0b\x78\xc4\x81\x3a\x6c\xd0\xa7\xdc\xd1\xbd\x43\x70\xae\xab\xc1\xec\x02\x9c\x17\xdd\x0e\x69\x41\xdb\x80\x5e\x96\x41\x6f\x11\x68\x83\xc6\x64\x07\xee\x77\xb1\x41\x2d\xf7\xb0\x42\x68\x09\x15\x04\x07\x4a\x57\x15\x7a\xb4\x41\xcb\x80\x10\x36\x78\x40\x15\x0b\x75\xec\x58\xf1\xfc\x17\x02\xb7\xb3\x20\xfd\xba\xad\xd1\x06\xca\x62\xc4\x5d\x60\xb9\xc8\x96\x3d\xa5\x54\x31\x92\x51\x87\x51\x7a\x94\x01\x0f\x57\x2c\xee\x8a\xea\x56\xcc\xee\xfd\x36\x7b\x48\xdb\x28\xfa\x52\x3a\x1b\xd0\x06\x70\x15\x48\xb0\xb8\x4b\x62\xcb\x20\x47\x04\x91\xbd\xbd\xed\xc5\x77\x29\x95\x82\xf3\x57\x17\xd9\x01\x7b\xd9\x3c\x20\x77\xcd\x9e\xb9\xae\x5c\xa3\x4f\x81\x47\x20\x90\x56\x01\xc9\x2d\x12\xe8\x00\x92\x0e\x49\x61\xa7\xc3\xa6\x5b\x68\x24\xd1\xce\x79\x75\xc2\x91\xb2\x39\xf6\x43\xb5\x35\x7b\x22\xfe\xed\xf5\xc9\xb0\x12\x7a\x70\x40\x41\xb9\x36\xd2\xfe\x33\x9f\x7f\x38\x81\xcd\x48\xc7\xe8\xa8\x74\x78\x9c\x43\x5e\x7d\x4c\x65\x01\xef\x34\x05\x6d\xd7\x4f\xe6\x91\x0d\x1f\x51\xd8\x2d\x33\xcc\xdb\xd0\xb4\x81\x92\xb2\xa0\x74\x75\x2d\xad\x62\x12\x19\xc0\x38\x39\x1c\x61\xa8\x9c\x1f\xc2\xd2\x36\xb8\xe8\x47\xd2\xe3\x09\x42\xbb\x7d\xc4\x77\x87\x25\x13\x4e\xef\xb0\x6c\x39\x65\x47\x8c\x5d\x21\xd6\x7a\x8b\xb6\xa3\x71\x1e\xbc\x33\x78\x0a\xff\x0e\xcb\x63\x02\xf6\x96\x09\x3e\x12\xa6\x5c\x0f\x07\xaa\x2b\x83\xb6\xfc\x23\x09\x31\x62\x63\x63\x64\x89\x4f\xd4\xee\x04\x6b\xcc\xc7\x31\x2b\x1d\xea\xd1\x68\x8a\x75\x7b\xaf\x29\x10\x48\x63\x92\x2d\x9d\x02\xa3\x63\xa8\xa8\xbf\x07\x67\xab\x57\x64\x94\xf8\x46\xda\x75\xa7\xb4\x7e\x3d\x15\xe5\x27\x04\x90\xa0\x8f\x09\x7d\x7d\x48\xa6\xd0\xe0\xc3\x83\xec\xb1\x76\x5b\x5e\x11\xb7\xf1\x17\x1d\x11\x9d\x0a\xcb\xd7\xc7\x2c\xb1\xce\x0c\x92\x07\xe9\xc3\xe9\x96\x96\xaa\x1f\x15\x75\x20\x37\x7e\x4e\x4a\xe0\xcc\xa3\xfa\xb1\xee\x12\xd8\x91\x03\x6d\xb3\xf6\x52\xc5\x38\x3e\xa6\x9f\x04\x06\xd7\xb2\xdc\x77\x61\x40\x87\x5a\xb6\x9e\x7b\x66\xc7\x59\x39\x5f\xcb\x53\xc9\xec\xf0\x3a\x9a\xfc\x1a\x7e\x9b\xbd\x9f\xc2\xfb\xf9\xd5\x98\x2f\x86\x74\xbf\x7d\x4a\xc0\xdc\x79\x4a\x59\x6e\xe2\x55\xd8\x5d\x94\xd2\x63\x7f\x3d\xca\xb2\x74\x5e\x71\x3e\x3b\x0f\x3e\x4f\xde\xc1\x5b\x49\x08\x13\xed\xb1\x0c\xce\xef\x21\x6f\xb0\xd4\x95\x2e\x65\xd0\xce\x42\xf1\xc5\xc8\xaf\x9b\x10\x1a\x7a\x33\x1a\x51\x90\x56\x49\xaf\x28\xab\x3c\xa2\x42\xfa\x1e\x5c\x93\x39\xbf\x1e\xad\x24\xa1\xd2\xfe\x92\x1a\x2c\x1f\x3c\x5c\x1a\xee\xc2\x21\xdb\x84\xda\x14\x5f\xbc\xfc\x5a\x3c\x1f\xae\x93\xe8\x73\xbc\x21\xb4\xc1\x07\x7e\x6a\xfb\x46\x64\xb7\xb9\xc8\x66\x0b\x28\xce\x57\x2d\xfc\xb9\x4b\xed\x9f\x3e\x4f\xde\x7d\x9b\x8c\x97\xe3\x6f\xd7\xf3\x9b\xe9\xa8\xcb\xd0\xa8\xbb\x4d\xcf\xc3\xbe\xd1\xa5\x34\x66\xdf\x09\xea\x7f\xa3\xcc\xb8\x52\x9a\x11\x6d\xa4\xc7\xc3\xed\x17\xf1\x56\x7e\x1a\x7e\x32\xbb\xcd\x7f\x08\x3f\x6a\xc9\x8f\x0e\x08\x78\x1f\x57\xe0\xe0\x6d\xbf\x9e\xf8\x6e\xa7\xf7\xc5\x3a\x88\x7a\xe7\x75\x08\x18\xbb\xc5\x8f\xc2\x2c\x9e\x67\xb0\x74\xb0\x92\xe5\xf7\xb6\x81\xbd\x6b\x3d\x7c\xea\x46\x24\x25\x83\x7c\x11\x7b\x40\x42\xd6\x56\x84\x8d\x26\x50\x43\x69\x69\xe3\x5a\xa3\xf8\xde\x66\x7b\x54\xd0\x36\x2c\xb7\xa8\x93\x24\x9b\xce\x54\x39\xb0\x2e\x80\xc5\xd4\xcb\x56\x08\x1e\x83\xd4\x16\xd5\x50\xbc\xce\x8c\xcb\x77\x68\xf9\xd3\x45\xbc\x1a\x5f\x5d\x4f\x7f\xba\x8a\x91\xe2\x71\xfd\x38\x9f\xf9\x35\x4c\x3f\xcf\x96\x70\x35\x9f\x4c\x79\x6c\xc8\x85\x34\x66\xe5\xee\xfe\x2e\xca\x15\x94\x2b\x51\x82\x79\xf4\x9f\x89\xe9\x9d\x0e\x50\x3a\x85\xcf\x6e\x50\x5a\x6d\xd7\xe2\xe5\xb3\xbc\x2d\x4b\x24\xca\xc4\xeb\xbf\x3c\x9b\xd9\xad\x34\x5a\xc1\xd5\xfb\x19\xb4\x24\xd7\x08\xe7\x84\x08\x35\x52\x7c\xe0\x6e\x51\x3b\x8f\xa0\x38\x2f\x86\x2e\x32\xf1\xfa\xaf\xcf\x96\x1b\xe4\x6a\xca\xd8\x73\x5a\xeb\xb1\x74\x5b\xf4\x72\x65\x90\xaf\x86\x95\xc1\xfa\xbe\xef\x6c\x07\x0d\x64\xe2\xf5\xaf\xcf\xc6\xe0\xf1\xbf\xad\x4e\x33\xac\xdf\xea\x12\xd3\x60\x87\x84\x36\x98\x3d\xb4\x56\x6e\xa5\x36\x11\xeb\x1c\xb3\x75\x06\x92\xbe\x73\x97\xbd\xc8\xc4\xdf\x7e\x1d\xdc\x1d\x1a\x35\xb5\x4d\x63\x74\xac\xd7\x32\x25\xe9\xdd\xc7\x19\x2c\xfa\xd7\x0b\xef\xea\x86\xe7\xe4\xc5\x42\x8c\x4d\xd8\xb8\x76\xbd\x19\x84\x14\x7c\x9c\x6d\x1c\xd4\xf2\x3b\x02\xb5\x1e\x59\x68\x50\x4a\x0b\x1e\x93\x9a\xba\xca\xc4\x4b\xae\x57\x7d\xe5\x35\x5a\x45\x2f\x04\xb9\x1a\x83\xae\xd3\xf8\xa3\x09\x28\x68\x63\x38\x96\xaa\x4b\x46\x70\x3c\x38\x82\x64\x9f\x8a\xcb\xd8\x2e\xee\x3d\x6f\xa2\x6b\x19\xfc\x16\x1b\xb2\x26\xe1\x51\x92\xb3\x2f\x06\xf7\xd8\x8f\x55\xbc\x41\x2b\xbd\x6e\x7d\x92\x68\xc4\xb3\x7d\x52\x40\xd7\x8d\x41\x1e\x2b\x63\x17\xcb\x7a\xdb\x5f\x48\x0c\x3b\x6c\xc0\xb5\x4f\x4d\x4e\x13\xc7\xbc\x5e\x23\x83\xed\x36\x7c\x12\xe3\x04\xcb\x21\x7e\x1a\x7f\x7c\xbf\x9c\x4e\xbe\x8d\xf3\x7f\x2d\xc6\x79\xce\xc1\x6e\xa5\xd7\x31\x0e\x8e\x0d\x43\x3a\x12\x0b\xa7\x6d\xbc\xa9\x9e\x34\x0b\x2e\x5d\x9d\x3c\x8e\x44\x73\x2e\x7e\x1a\x7c\x06\x77\x69\x88\x60\xa7\x8d\x11\xa5\xe4\xb8\x86\xba\xa4\x30\x13\x42\x1a\x23\x22\x04\xa5\x8e\x9d\x76\xa4\xf4\xc5\x97\x2d\xa1\x67\xa5\x8a\x3e\xb7\x94\xc1\x32\x1a\x79\x0a\xd0\x48\x2f\x6b\x0c\xe8\x1f\x8c\x2d\x6c\x77\xe0\x62\xfa\xb8\x88\x80\x78\x17\x04\x27\xcd\xaa\xa1\x29\xd0\x86\x27\xf8\xce\x8a\xd9\x12\xfe\xe9\x22\xf0\x26\x1b\x5b\x5d\x1a\x2a\x06\xaf\xee\xdb\x5e\x1a\x5d\x7b\x3d\x79\x0c\xad\xe7\xfb\x0b\x28\x1d\xcc\x78\x5e\xe1\xfc\xe5\x45\x06\x33\x1e\x9b\x2a\xa9\x0d\x8b\x33\x2d\x5b\x67\x8b\xcb\x97\x17\x42\x53\x67\xc9\xdf\x43\x0f\x86\x17\x6d\x9b\x36\x0a\x52\xae\x9c\x0f\x7d\x2f\xeb\xb3\xab\x93\x26\xfa\xf0\x7a\x7d\xf0\x84\x20\x6b\x83\x44\x66\x9f\x4e\xef\x30\x93\x74\x71\x8a\x87\x71\x52\x77\x3e\xbb\x90\x68\x53\x5c\x76\x1b\xb9\x6d\x25\xce\xb9\x85\x5a\x96\xf3\xfc\x05\x07\x17\xcd\x61\xdc\x34\x06\xf3\xd2\xeb\x26\x3c\x95\xc0\x4e\xf8\xfc\xcd\xf5\x26\xc2\xc4\xee\x6a\x2b\xf1\xc7\x3f\xc4\xbb\x66\xa5\xed\x88\x07\x61\x47\x92\x22\x90\x10\xce\x82\x6f\xe3\x47\xd6\x56\x00\x00\xe8\x0a\x0c\xda\x75\xd8\xc4\xc1\xd3\xaf\xb7\xf0\x0f\x78\x19\x2b\x13\x5f\xf3\x1f\x61\x18\xda\x1c\xe7\x21\x60\x0d\xaf\xfa\xed\x71\x17\x1a\xc2\xa7\xb6\x9f\xf5\x2d\xe6\xcd\x59\xda\x6b\x15\xe8\x4a\x88\x7e\x6b\xe5\x9d\x0d\xb5\xa3\xf0\x4d\x72\x83\xea\xa6\x8c\xe0\x80\xbf\xa1\x99\xe5\x5c\xdb\xca\xc5\xfe\x7a\xde\x48\xee\x95\xee\xde\x06\x0e\x6c\x2e\x2e\x22\x66\xe0\xf9\xeb\x10\xea\x24\xc1\xe0\xad\xd2\xd4\x18\xb9\x07\xa5\xa5\x71\xeb\xc1\xf1\xd4\x95\x75\x30\x08\x67\x9d\x1e\xce\xd2\xa2\x2e\x63\xe2\xdb\x88\x1d\x57\x36\x5a\x29\xb4\x20\x2d\xed\xd0\x83\xc2\xaa\xfb\xe4\x8b\x8f\x67\x67\x62\xe0\xe2\x13\x33\x48\x91\x43\xf3\x48\xad\x09\x43\x5a\xd8\x75\xc1\x3f\x7c\x6b\x45\x56\xe9\x78\x9d\xfd\x3f\x00\x00\xff\xff\xcd\x29\x77\x60\x0b\x11\x00\x00")
+var _vaultedVerify1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x55\x4d\xaf\xe2\x36\x14\xdd\xfb\x57\xdc\x55\x07\xa4\x10\x75\xba\x9c\x1d\x7d\xf3\xaa\x41\xd5\xbc\x87\x08\xd2\x6b\xd5\xcc\xc2\xb1\x4f\x88\x85\x63\x53\xdb\x21\xc3\xbf\xaf\x6c\x07\x5e\x60\xa6\x3b\xc0\xe7\x7e\x9c\x7b\xcf\xb9\x94\xfb\x2f\x74\xe6\x83\x0e\x90\xf5\xea\x0c\xa7\xda\x0b\x7d\x64\x65\xf5\x85\x5e\xd6\x5f\x9f\x59\xb9\xdd\xb2\xe9\x99\xa6\xd7\x7a\x45\xa2\x83\x38\xfa\x1c\xe7\xa9\xb5\x8e\x4e\xce\x36\x1a\xbd\x4f\x91\xd5\xdf\x2f\xaf\xdb\x6a\x53\xa5\xe8\xba\xfd\xbd\x6e\x9f\xee\x73\xd4\xed\x8e\xfe\xa9\xdb\xcd\xeb\x76\xbf\x79\x7d\xa9\xea\x76\xfb\x2d\x7d\x37\xbc\x47\xdd\x6e\xeb\x5f\xca\xb2\xfc\x96\x52\x7d\x7e\xae\x9e\x76\x9b\x04\x4b\xd9\x9e\x72\xe5\xd0\xe1\x5a\xdd\x9f\x20\x54\xab\x20\xa9\xb9\xd0\x7b\x0e\x5a\x58\x47\x5c\xeb\x09\x56\x90\x6a\xc9\xd8\x39\x40\x79\x76\x8b\x5d\xde\x91\x28\x88\x1b\x49\x0e\x27\xeb\x42\xae\xe5\xe0\x6f\x54\xc1\x45\x97\xb3\x96\xa9\xa5\x37\x15\x3a\x3b\x84\x84\xcb\x64\xeb\x55\xbd\xb2\x27\x98\x48\xd3\x9e\x82\xb2\xa6\xb8\x76\xcb\x1d\xf2\xf4\x20\x69\x9c\x02\x1b\x28\x73\xa0\x18\x00\x59\x90\xb7\x64\x2c\x3b\x71\xef\x47\xeb\x64\x8e\x30\x80\x84\x2c\x69\xdf\x81\x5a\xab\xb5\x1d\x63\xc4\x2c\xd7\x27\x56\xee\x2a\x56\x6e\xb6\x54\x2f\x9a\x81\x7e\x63\xfb\xeb\x80\xa8\x55\x1a\xa4\x3c\x8d\xd0\xba\x5e\xb5\xd6\xf5\x90\x89\xdf\xc8\x3d\x79\x70\x0d\x99\x68\xdd\x46\xfa\xc1\x53\x1c\x51\xf9\x98\xee\x88\x0b\x49\x38\x75\xe6\x91\x51\x4a\x01\x23\xdc\x25\x11\xa4\x13\x77\xbc\x47\x80\xcb\x1d\x9f\xb9\x56\xb2\xa4\x3f\xef\x62\xd8\x0c\x14\x3a\x1e\x12\x72\x04\x3f\x22\x96\xe7\x26\xf5\x20\x06\xe7\x60\x02\xf5\xca\xa8\x7e\xe8\x0b\xb2\x2e\xf5\x4e\x0d\xb4\x1d\x29\x74\xd6\x83\x09\xae\x55\xe3\x78\xb8\xf5\xae\x3c\xf5\x5c\x74\xca\x80\x16\x1e\x78\x97\x74\x1a\xa4\x5c\x7c\x5c\x2e\x8b\x54\x2e\x6f\x35\x8e\xc0\xb3\x91\x3b\xa3\xcc\xc1\x97\xff\x3f\xb9\x82\x3c\xbc\x8f\x04\x05\x17\x1d\xa6\xdf\x22\xf7\xd8\xab\x54\x0e\x22\x58\xa7\x90\x64\x72\x49\x15\x8e\x38\x05\x52\x86\xc5\xcf\x76\x34\x59\x98\x73\x66\x83\x87\x4b\x29\xb8\x83\xf9\x10\x68\x74\x2a\xf0\x46\x23\xe2\x6c\xe8\xe0\x12\xc2\xd3\x62\x2a\xcd\xde\x4b\x7b\xea\x07\x1f\xc8\xd8\xa8\x1a\x72\xe0\xf2\x67\x81\x50\xf1\xcb\xf2\x07\x5a\xf7\x54\x1e\x34\x51\xd2\x5b\x07\x33\xb3\xcc\x5c\x5f\x05\xdd\xf5\xe2\xc9\xb6\x57\x54\x5a\xa4\xb1\xa4\xad\x39\xc0\x11\xbe\x2b\x1f\x1e\x27\x4d\xb3\x49\xef\xf2\x51\x79\x9b\x7e\xa1\x05\xca\x43\x49\x7c\x9a\x79\xca\x36\x78\x78\xe2\x86\xac\x96\x70\x59\xc0\xb1\x47\x1e\x96\x77\x89\x0b\x6a\x86\x54\x8a\xc5\x79\x08\x6b\xbc\x92\x70\x90\x37\x1f\x97\xe9\x84\x4c\x47\x86\x95\xfb\x2d\xfb\xc1\x9f\x6c\xad\xbd\x4d\xde\xf3\x33\x63\xd3\xc2\xe1\xdf\x01\x3e\x44\x9b\xa9\xe0\xe9\x6a\xc7\x7c\x46\x92\x1b\x97\x14\x6c\x1e\x4f\x44\x30\x61\x4d\x80\x09\x9f\xa8\xaa\xbe\x44\xab\x4c\x9b\x6a\x10\x9d\xe1\x11\xd7\x54\x90\xb3\x1a\x69\xf3\x5f\xff\x58\x93\xc4\x59\x09\xd0\x7a\xf7\x72\xc3\xb2\xf9\x42\x8a\x24\x9a\xf5\x5b\x45\x0e\x87\xb8\xb6\x6b\xc2\xa3\xb1\xa3\xc9\xaf\xd7\x8d\xca\xc1\xf1\x30\xc3\xb0\xe4\xbf\x78\x75\xdc\x4c\x32\x0e\x2d\x1c\x8c\x40\x8c\xed\x3d\xf4\x19\xbe\x20\x61\xfb\x3e\xf6\xf4\x8e\xed\xf8\x19\xc4\xd9\xf4\x50\xe4\x0d\xc4\x9b\x30\x23\xa5\xb9\x32\xb3\x87\xec\x09\x65\x84\x1e\x24\x64\x9e\x62\x46\xb3\xac\x88\x45\x6c\xf7\xf1\x3d\x59\x24\xdd\xbd\x24\x12\x68\x9d\xee\xdf\x5c\x59\x53\x08\x4b\xcb\xc9\x22\x57\x86\x38\x89\x8b\xd0\xb8\x93\xc3\x32\xaf\xfb\xf9\xaf\xcd\x9e\x9e\x5e\x3f\x3f\xe7\xbf\x9f\xa8\x7b\x7c\x57\x51\x1f\x32\x69\x3e\xf6\x61\x86\xbe\x81\x7b\x14\xf1\x55\x36\x34\xc2\x45\xc5\x0d\x26\xdf\x68\x5a\xfc\x9a\xff\x3d\xd8\x4f\x10\xcb\x92\xfd\x37\x00\xc6\x14\x69\x90\x43\x07\x00\x00")
+
+func vaultedVerify1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedVerify1,
+		"vaulted-verify.1",
+	)
+}
+
+func vaultedVerify1() (*asset, error) {
+	bytes, err := vaultedVerify1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-verify.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaulted1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x59\x7d\x6f\xdb\x38\xd2\xff\xbb\xfc\x14\xf3\xf4\x79\xb0\x9b\x00\x8e\xd2\x3e\xb8\xdb\xc5\xf6\x80\x03\xb2\x89\xb7\xf5\x6d\x5e\x8c\xd8\xdd\x17\xac\x8b\x82\x16\x47\x36\x2f\x12\xa9\xe3\x50\x4e\xfc\xcf\x7d\xf6\xc3\x90\x94\x2c\xdb\x4a\xda\xbd\x02\x05\x2c\x89\x9c\x37\xfe\xe6\x37\x33\x4c\x36\xff\x00\x1b\xd9\x94\x1e\x15\xbc\x15\xd9\xec\x03\xdc\x5e\xdc\x8c\x45\x36\x9d\x8a\xf6\xf5\xe2\x0c\xa8\x96\x8f\x06\x08\x89\xb4\x35\x04\x85\xb3\x15\x10\xe6\x8d\xc3\x72\x0b\xe4\xad\x43\xc5\xcf\x0e\x3d\x05\x19\xb3\xdf\x6f\xef\xa6\xb3\xc9\x2c\xc8\x59\x14\x3f\x2e\x8a\xcb\x24\x6d\x51\xdc\x43\x7c\xb1\x38\x33\xf1\x61\x62\x64\x85\x8b\x62\x0a\x7f\xb4\x1f\xf4\xa2\xb8\xff\x24\xb2\xa5\xfb\x2f\xf6\x2e\xce\x78\x33\x7f\xba\xbc\xb9\x5a\x14\xd3\x61\x13\x7a\xcb\x95\x76\x49\x56\xf8\x35\xfd\xd4\xff\xf8\x80\xdb\x42\x97\x98\x16\x74\x4f\xd3\xa8\xe0\xee\xe6\xe6\xe2\xf6\x2a\xa9\x9f\x48\xb7\xa2\x2c\xcb\xf8\x6b\x08\xc2\xd5\x78\x76\x79\x3f\x99\xce\x27\x77\xb7\xc1\x88\x49\x01\xc6\x1e\xec\xd3\x04\xb5\xb3\x1b\xad\x50\x8d\xe0\xc8\x4a\xd4\x7e\x8d\x2e\x46\x9f\x76\x2e\xc1\x89\x2e\xba\x6d\xa7\x60\x9d\x48\x2b\xa4\x01\x6d\x3c\x3a\x99\x7b\xbd\x41\xa0\x35\x96\x65\xd6\x0b\x40\x8a\x0e\x54\x72\x0b\x4b\x84\x86\x50\x81\xb7\xa0\x74\x51\xa0\x43\xe3\xb5\xf4\x08\x7e\x8d\x3d\x55\xe1\xa8\x0f\x0d\x5b\x7c\xf3\x2d\x81\x7d\x34\x20\xdd\xaa\xa9\xd0\x78\xca\x82\xc7\xc9\xb1\x99\xc8\xe6\xad\x4a\xa9\x82\x27\xe7\x49\x46\xee\x50\x7a\xec\xbf\x31\xf8\xb8\x28\xee\xc5\x64\x67\x77\xb9\x85\xb8\x8c\x82\x2d\xb9\x35\x1e\x8d\x07\x5b\x80\x04\x83\x8f\x11\xae\x19\xcc\x10\x41\x64\x3f\xde\xb7\xf0\x3d\x93\x4a\xc1\xc9\xdb\xd3\xac\xaf\x7d\x85\xc6\xb3\xf8\x0f\xb6\x54\x04\x8d\x29\x6d\xfe\x80\x2a\x6e\x81\x07\xdc\x12\x68\x03\x15\x56\xd6\x6d\x47\x40\x36\x7e\x20\xc8\xa5\xe1\x00\xd9\x1a\x0d\x2a\x78\xd4\x7e\x6d\x1b\x2f\x1c\x2e\xce\x90\xed\xd4\x66\xc5\xa6\x69\x07\xb5\x24\x7a\xb4\x4e\x0d\x99\xc3\xba\x0f\x0d\xca\xad\x29\xf4\x8a\x2d\xba\x91\x46\xae\x92\x8b\x0a\x8b\xa8\x57\x9b\xd6\xe5\x42\xaf\x1a\x27\xbd\xb6\x06\x18\x72\x03\xf2\xe3\xa2\x23\x05\xf5\x5e\xb8\x6d\xbd\x65\x65\x97\xb6\xd6\x43\xe1\x8c\x71\x90\x46\x01\xc9\x0d\x12\x68\x0f\x92\xfa\x61\x0e\xbe\xa7\x17\x2f\xf8\x9a\xd7\x87\x76\xa8\xa6\x62\x4b\xc4\xaf\x4e\xfb\xe7\x35\x7b\x0b\xe4\x95\x6d\x82\xda\x7f\xcc\xee\x6e\x07\x64\xb3\xa4\x43\xe9\xa8\xb4\x3f\x46\x0d\xbf\x3d\x56\x65\x00\x9f\x34\x79\x3e\xb3\xe7\x90\xc3\x1b\x8f\x54\x98\x0d\x6b\xb8\x6b\x7c\xdd\x78\x8a\xb9\x04\xb9\xad\x2a\x69\x14\x2b\x91\x1e\x4a\x2b\x3b\xda\x83\xc2\xba\xce\x2d\x6d\xbc\x0d\x76\xc4\x0c\x1c\x50\x68\x36\x47\xfa\x9e\x30\x67\x85\xe3\x27\xcc\x1b\x0e\xd9\x81\xc6\x74\x10\x2b\xbd\x41\x93\xd4\x58\x07\xce\x0e\x62\x83\x85\x1d\x2b\xa8\xad\xf3\x51\x05\xff\xa2\x16\xec\xde\x86\x20\x99\xdc\x6d\x6b\x8f\x0a\x96\x8d\x51\xcf\x48\xe5\x7d\x87\x72\x0b\xfd\xb4\x38\xab\xd1\x55\x3a\x56\x87\x88\x37\xe7\x30\x0f\x80\x8e\x65\x02\x7a\x0b\xc0\x9a\x3e\xf0\x62\x51\x81\x5c\xe6\x6b\x0c\x58\xa7\x01\xd5\x85\x7e\xea\xeb\x38\xb4\x61\xad\xb9\x08\x05\xa8\x5f\x6b\x4a\x20\xa8\x1d\x6e\xb4\x6d\x88\x4b\x14\xca\x92\xd3\x1e\x5d\xb2\xa0\x83\xe0\x80\xae\x24\xec\x50\x87\xae\xda\xf8\x4d\xaa\xbd\xf8\x05\x8a\xfc\xba\x08\x46\x19\x87\x92\x19\x47\x2c\xf7\x23\x61\xcc\x82\x8e\xdc\x53\x82\x30\x2d\xd8\x44\x8a\xe1\xd4\xb1\x2e\x65\x8e\xcf\x64\xd5\x80\x5e\xd6\x70\xa4\x95\xfa\x4c\x51\x6a\xf2\xbb\xf0\xc9\xb2\x4c\xde\x0d\x09\x3b\x0a\x7f\x60\x86\x3d\x9e\x6f\xb9\x22\x80\x61\x2d\x4d\xcb\x74\xed\xfb\x90\x2e\xfb\x79\x39\xa4\x2a\x0a\x3e\x54\xe7\x30\xd7\xb5\xe6\xb2\x73\x48\xa4\x75\xb3\x2c\x75\x1e\x89\x3d\xa4\x28\x13\x39\xb3\xf8\x0b\xb1\xd9\x49\x3b\xd6\x13\x5a\x1b\x56\x72\x1f\x7f\x12\xc8\xe7\x61\xf5\xf2\x11\x24\x61\x47\x3a\xaa\x7e\xd8\x14\x96\xb8\x5f\x1e\x1d\x56\x76\x93\x4c\xe0\x5f\xf4\x15\x51\x73\xd5\xa1\x96\x94\x64\xbd\x03\x36\x0a\x5c\x12\x18\x32\xaf\x4b\xc4\x21\x81\xe9\xd3\x91\x54\x66\x28\x96\x39\xf3\xd2\xf9\xe1\xf6\x23\xf2\x56\xe0\xc2\x1e\x51\xf2\x73\x10\x1e\x38\x14\xd5\x97\x19\x33\x0a\x3b\x34\x60\x6b\x02\x67\xce\xb6\x26\xef\xd2\xb1\x23\x4a\xcf\x79\x62\x49\x73\x32\x0f\x49\xdc\x9a\x23\x8e\xf4\x4e\xd2\xba\x8b\xd2\x08\xd2\xa1\xd1\x28\x04\xac\x6e\x1c\x03\x2d\xc6\x4d\x3d\x1f\xff\x20\xe5\x50\x74\x53\xaf\x9c\x54\xe1\x24\x3f\xc6\x9f\x04\x25\xae\x64\xbe\xed\xf1\x70\x48\xe8\xc6\x71\x2f\x16\xdf\x72\xb4\x2a\x39\x84\xa7\x24\xef\x50\xcd\x06\x9d\x2e\x62\xcd\x5f\x63\xfe\xb0\x23\x29\xeb\x98\x55\x96\x25\x56\x43\x26\xc7\x6d\x49\xd8\xec\x03\xfc\x34\xb9\x1e\xc3\xf5\xdd\xe5\x05\x77\xaf\xb1\x8d\xff\x25\x0a\xe2\x40\x1c\x20\x06\xa4\xc3\x76\x0a\x90\x79\x6e\x9d\x0a\x0d\x52\x74\xe7\xb7\xab\xf7\xf0\xa3\x24\x84\x2b\xcd\x25\x81\x89\x75\x56\x63\xae\x0b\x9d\xc7\xf6\x66\xf1\x47\x29\x3f\xad\xbd\xaf\xe9\xdd\xf9\x39\x79\x69\x94\x74\x8a\xb2\xc2\x21\x2a\xa4\x07\x6f\xeb\xcc\xba\xd5\xf9\x52\x12\x2a\xed\xce\xa8\xc6\x7c\xef\xe1\xac\x94\x1e\xc9\x67\x6b\x5f\x95\x8b\x3f\x9c\xfc\xb4\xf8\xa6\xeb\x79\x83\xcd\xa1\x8d\xe5\xba\xd2\xb7\x53\x9b\x77\x22\xbb\x9f\x89\x6c\x32\x85\xc5\xc9\xb2\x81\xff\x4f\x01\xfc\xbf\xdf\xae\xde\x7f\xbe\xba\x98\x5f\x7c\xfe\x70\x77\x33\x3e\x4f\x01\x3a\x4f\x13\xc0\x89\xdf\xd6\x3a\x97\x65\xb9\x4d\xf9\xf9\xef\xf3\xac\xb4\xb9\x2c\xcf\x69\x2d\x1d\xf6\x97\x9f\x86\xe1\xe3\x79\xf1\x57\x93\xfb\xd9\x17\xc5\x9f\x37\xe4\xce\x7b\x0a\x78\x1d\x9f\x40\xef\x6b\xfb\x3e\xea\xbb\x1f\xef\x0e\xab\xe7\xf5\xa3\xd3\xde\x63\x28\x23\x5f\x72\x73\xf1\x4d\x06\x73\x0b\x4b\x99\x3f\x34\x35\x6c\x6d\xe3\xe0\x97\xf8\x15\x94\xf4\x72\x14\x8a\x43\x94\xac\x8d\xf0\x6b\x4d\xa0\xba\xa3\xa5\xb5\x6d\x4a\x05\x4b\x0c\xfb\x51\x41\x53\x33\xda\x8e\x4a\x3c\x28\x0b\xc6\x7a\x30\x18\x8b\xdc\x12\xc1\xa1\x97\xda\xa0\xca\x06\x1d\x90\xe5\xa3\xdc\x52\x5b\xf9\x14\x48\x6f\xab\x18\xa9\x98\x9b\xb9\x35\x6d\xe2\x68\xb3\xb1\x11\x5b\x5c\xe8\x45\x6b\x7c\x6e\x03\x30\xe3\x88\xe3\x6c\xb3\x5a\x03\x4f\x03\x49\xc7\x03\xd6\xbc\xf3\xe5\xe8\xf0\x51\x3f\x50\x0a\x92\xb8\xde\xed\x3e\xf6\x66\xe7\x7e\x70\x67\xfa\x62\x2f\x82\x32\x5f\xb7\x2d\x91\xc3\xd6\x96\x97\x01\x99\xa5\x36\xa5\x3d\xb2\x97\xdb\x98\xfb\x3d\xce\x62\x2d\xe2\xeb\x3c\x0e\x5c\xf6\xac\x8e\x3e\xd3\x0d\x1c\xda\xd2\x36\x46\x25\x22\xd0\x0e\x78\x56\xcf\xe0\xa2\x65\x36\x5d\x62\x2c\xd2\x9a\xcf\x95\x3f\x2a\xb0\x0e\x72\x9e\x55\x94\xb0\x1b\x74\x20\x8d\x0d\x13\x70\x3b\x8b\x30\xf2\xa4\x2e\x59\x24\x57\xf5\x00\xd4\xb8\xb5\xad\xbc\x23\x68\x08\xf7\x07\x56\x88\x33\x91\xb7\x82\x87\x1c\xd0\x1e\x1a\xa3\x30\x16\x21\x9e\x6b\xe2\x76\x36\x74\x8d\x26\xb1\x7b\xf8\x68\x9d\x5e\x69\x23\x53\x0d\xdb\x97\xe9\xaa\x84\x82\xc4\x34\xb3\xb6\xc2\x4e\xf7\x60\xfe\xd5\x8c\x73\x79\x71\xf9\x61\xfc\xd5\x94\x13\x54\x1c\x93\x4d\x4a\xfe\xa1\x7c\x4b\x13\xad\x36\x4c\x99\xec\xc0\xae\x03\x88\x03\xee\x4b\x15\x3f\x5e\x5e\xc4\x8b\x81\x83\x6b\x13\x5b\x07\x12\xef\xdd\x63\xc0\xc9\x12\x0b\xeb\x70\xff\xa6\x83\xaf\x29\x7a\x12\x7e\xb9\xf8\x78\x3d\x1f\x5f\x31\x09\x72\xad\x42\xb3\xd1\xce\x9a\x2a\xd6\x3d\xa7\xe5\xb2\x44\x96\x49\xe8\x47\x3d\xcc\xee\x42\x19\x81\xb3\xe3\x1d\x6d\xc8\x23\xf7\xb8\x98\xad\x32\x11\x8a\x1d\xba\xc5\x59\xed\xec\x3f\x31\x4f\xb5\x94\x4e\x07\xa9\x68\x04\x29\x53\x46\x10\xe0\x1c\xf9\xa4\x47\x0d\xfd\x64\x59\x6b\xa5\xd0\x00\x35\xcb\x56\xb7\xc6\x90\xc2\xfb\xf6\xc4\x98\xcd\xa3\xbb\x5a\xa1\xf1\xda\x6f\x19\x1b\xed\x95\x4b\xe8\x49\x93\x63\x81\xc0\xd3\x21\x6c\x6d\x13\xfc\x6e\xfd\xdc\x83\xc8\xdd\xed\x4f\x93\xf7\xfb\x18\xd9\xc9\xbe\x1f\x71\x84\xd3\x05\x42\xc8\xad\x98\x51\xcb\x2d\xbf\x11\xfb\x61\x9f\x5c\x8d\x6f\xe7\x93\xf9\xef\x7c\x82\x43\xb1\xff\x9a\x36\x79\xe7\xe0\xde\x75\x45\x5b\x6d\x47\xb0\xb6\x65\x68\x04\xba\xdb\x8d\x70\x38\xdd\xc0\xa7\xd2\x06\x3a\x85\x55\x69\x97\x0c\x70\xc1\x91\x2f\x82\x1b\x4a\x6f\xb4\x6a\x64\x3b\x86\x8c\x38\x2a\x5f\x88\x45\x77\xb7\x32\x6a\xb1\xc6\x76\x88\x7e\x18\x0e\xd0\x17\xa5\xfc\x89\x20\xec\xdd\xb9\xb4\x84\xc7\x6c\x80\x45\x43\x18\x5a\xb8\x86\x70\x37\x9e\x0f\x0c\xb7\x70\x92\x8c\xeb\x03\x88\xd1\x23\x78\x92\x93\xf1\x00\xab\xd3\xf0\x2a\x60\x9e\x0b\x8b\x7d\x34\x3b\x1f\xda\x3a\xd7\x10\xba\xe8\xa9\xf4\x5d\x91\x0f\x99\xb3\xdc\x8a\xc8\x9b\xbc\x84\x86\x0b\x70\xd5\x90\x07\x59\x52\xac\x5c\xa1\xfe\x4a\x95\x76\x43\x7f\xb7\x98\x0c\xcd\xf0\x89\x4f\xf2\x38\xe8\x0f\x72\xc8\xe0\xcc\x3e\x43\xcf\xc3\x8b\x38\x3e\x8c\x8b\xeb\xeb\xbb\x5f\x3f\x4f\x6e\x67\xe3\xcb\x8f\xf7\xe3\xcf\xd3\xf1\xfd\xcd\x64\x36\xe3\xf6\xf3\xb9\x03\xda\x75\x33\x6f\x79\x89\xd2\xc4\x6f\x29\xf6\x24\x39\xb7\xbf\xb1\x99\xfd\x79\xfc\x3b\xf7\xb3\xb1\x8d\x6d\xcb\x0f\x3b\xe0\xf0\x5f\x8d\x76\x08\x12\xd2\xcd\x2e\xe7\x8f\x54\x4a\x07\x4e\xf3\x16\xb4\xa7\xdd\xc8\x7a\x42\x88\xcf\x4c\xa7\xa7\x99\xf8\x95\x8b\x07\xa7\x35\x43\x9e\x9a\x7c\xbd\xab\x48\xec\x6b\xab\x80\x62\xef\xdb\x06\x6c\x97\x9a\x07\xb7\xcd\x89\x57\xff\x0c\x97\x26\x37\x0f\xa2\x25\xfa\x7c\xca\xf4\x33\x8a\xa7\xfb\xa8\x29\x56\xb9\x00\xa3\x50\x5a\x97\xc8\x24\x5e\x85\x2b\x8c\x76\x4a\x2b\x53\x2b\x15\x39\x0e\x45\x32\x31\x06\x76\xfc\xdb\x64\x0e\x97\x77\x57\x1c\xda\xf9\x4c\xc8\xb2\x5c\xda\xa7\xbf\x89\x7c\x09\xf9\x52\xe4\x50\x0e\xfe\xcf\xc4\xf8\x49\x7b\xc8\xad\xc2\x57\x37\x28\x39\x5e\xe2\xcd\xab\x59\x93\xe7\x48\x94\x89\xef\xfe\xf2\x6a\x62\x36\xb2\xd4\x0a\x2e\xaf\x27\xd0\x90\x5c\x61\x08\x3d\x54\x48\xe1\x81\x4d\xab\x38\x28\x0a\xbd\xd4\x25\x9d\x66\xe2\xbb\xbf\xbe\x9a\xaf\x91\x53\x40\x86\x31\xb4\x31\x0e\x73\xee\x20\x82\xe3\x69\xee\xe9\x22\xde\x6b\x40\x32\xf1\xdd\x0f\xaf\x2e\x5a\x20\x28\x20\x74\x1b\x9d\x63\xac\x67\x48\x68\x7c\xb9\x85\xc6\xc8\x8d\xd4\x65\x90\x15\x39\x4c\xd2\x03\x1f\xfe\x69\x26\xbe\xff\xfe\xd5\xc5\x4b\xf9\x0e\x6b\x39\x7c\x0b\x96\x89\xef\x7f\xe8\x3c\xed\x30\x46\x4d\x5d\x97\x3a\x34\xc1\xf3\x71\x88\xf1\xfb\x8f\x13\x98\xb6\x9f\xa7\xe1\x78\x28\x02\xb9\xe4\x5b\xe9\xd5\xba\xeb\xce\x7d\xa4\x12\x0b\x95\x7c\x40\x20\xd6\xc7\xe5\x24\x02\x3d\xb2\x4d\x42\x4c\xb8\x52\x6a\x47\x89\xc2\x69\x34\x8a\x46\x82\x6c\x85\x5e\x57\xf1\x1a\x38\x14\x21\x06\x45\xed\xb0\x48\x71\x4c\xec\x26\xd9\xa6\xc5\x59\x98\xc1\x76\x96\x47\xe4\x64\xf0\x53\x00\x8e\x26\xe1\x50\x92\x35\xa3\xce\xbc\x8e\x31\x62\xb1\x40\xd5\xc9\x33\x6d\x3c\x41\x57\x75\x89\x8c\xda\x80\xb9\xac\xdd\xfb\x2d\x89\x6e\x85\xf1\xb8\x4a\x17\xe3\x9a\xd8\xe7\xd5\x0a\x43\xf9\xe4\xfc\x1b\x60\x95\xd9\xcf\xd3\x8b\x59\xa0\x90\x83\xc6\x22\x35\xe6\x56\x9b\x70\x9b\xf2\xec\xb6\x74\x3d\x1a\xae\x65\xc3\xf6\x5e\xe3\xda\x9a\x4b\x9d\x07\x9c\x48\x22\x97\xec\x57\x77\x2e\xd1\xcd\x28\x01\x77\xf5\x79\x47\x05\xde\xa6\xf0\xed\x12\xb2\xb0\x4e\xb4\xb1\xa5\x0c\xe6\x61\x93\x23\x0f\xb5\x74\xb2\x42\x8f\x6e\xef\x92\xd0\xaf\x5b\x05\xad\x87\xad\x40\x7c\xf2\x82\x83\x66\x54\x37\x9b\xd0\x9a\xff\x76\xe3\x6d\xa7\x2d\xca\x1f\x3e\x84\xd8\x15\x3f\x76\xd7\xf8\x9d\x55\x3b\xf6\x8d\x57\xf8\x2d\x9e\x1c\xfa\xc6\x19\x02\x09\x14\x73\x3a\xa4\x3a\x9c\xbc\x39\xcd\x60\x52\x80\x0c\x1d\x3c\x83\x33\xbe\x36\xd6\x2c\xce\xde\x9c\x0a\x4d\x69\x27\x33\xd4\xde\x55\xa1\x36\x75\x13\x00\x29\x97\xd6\xf9\xbd\x01\x11\x15\xbf\xef\xbb\xd7\xe2\x03\x81\x50\x56\x25\x12\x8f\x5c\x21\xf1\xbb\x7b\xb3\xe4\xa7\xd8\xf7\xb3\x6d\x4f\x92\x4b\xb4\x5e\x9c\xa5\x85\xdc\x5e\xef\xfa\x9d\x67\xa2\xc4\xe8\x0e\xd5\x74\x1f\xe2\x27\x6d\x6b\x03\xd6\x1d\x74\x36\x22\x35\xa5\x3b\x5a\x8a\xaa\x77\x5a\x81\x62\xb1\xfc\x8a\x46\xe4\xce\x40\x25\xf3\xbb\xd9\x08\x24\x50\x30\x0d\x2e\xea\xba\xc4\x59\xee\x74\xed\x5f\x32\x3a\xfd\x1d\xf0\x5d\x10\x13\xe6\x13\x53\x88\xff\xfd\x9f\x70\xb5\xb0\xd4\xe6\x1c\xcd\x06\x2c\x49\x0a\x82\x84\xb0\x06\x5c\x13\xfe\xf0\xb7\x11\x00\x00\xba\x80\x12\xcd\xca\xaf\xc3\xed\xa7\x5b\x6d\xe0\xef\xf0\x26\x60\x26\x7c\xe6\x7f\x84\xbe\xe3\xee\x50\x53\xb1\x82\xb7\xed\xf2\xb0\x0a\x4b\xc2\xe7\x96\xbf\x6e\xc9\xef\xdd\xeb\xb8\xd6\x28\xd0\x85\x10\xed\xd2\xc2\x59\xe3\x2b\x4b\xfe\xb3\x64\xea\x4c\xd5\xca\xdb\x38\xd6\xd9\x02\x4e\xb4\x29\x6c\x88\xfd\x49\x2d\x39\xd2\x76\xb7\x07\x7a\x7b\x4e\x4f\x83\x4c\x8f\x65\xd9\x7f\x3d\xac\xa0\xb3\x56\x69\xaa\x4b\xb9\x05\xa5\x65\x69\x57\x9d\xe1\xf1\x4c\xb5\x2f\x11\x5e\x27\xa4\xbe\x8e\x2f\x75\x1e\x02\xdf\x04\xd9\xe1\x4d\x9a\x29\xa4\xa1\x47\x74\x6d\xb7\xdc\x3e\xbe\x7e\x2d\x3a\x5d\x9c\xcb\x5d\x92\xb0\x6b\x0e\xa9\x29\x7d\x17\x16\x36\x5d\xf0\x0f\xd7\x18\x91\x15\x3a\x0c\x84\xff\x19\x00\xaa\x66\x88\xfb\xe1\x1f\x00\x00")
 
 func vaulted1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -392,19 +644,31 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"vaulted-add.1":     vaultedAdd1,
-	"vaulted-cp.1":      vaultedCp1,
-	"vaulted-dump.1":    vaultedDump1,
-	"vaulted-edit.1":    vaultedEdit1,
-	"vaulted-env.1":     vaultedEnv1,
-	"vaulted-exec.1":    vaultedExec1,
-	"vaulted-load.1":    vaultedLoad1,
-	"vaulted-ls.1":      vaultedLs1,
-	"vaulted-passwd.1":  vaultedPasswd1,
-	"vaulted-rm.1":      vaultedRm1,
-	"vaulted-shell.1":   vaultedShell1,
-	"vaulted-upgrade.1": vaultedUpgrade1,
-	"vaulted.1":         vaulted1,
+	"vaulted-add.1":             vaultedAdd1,
+	"vaulted-agent.1":           vaultedAgent1,
+	"vaulted-config.1":          vaultedConfig1,
+	"vaulted-cp.1":              vaultedCp1,
+	"vaulted-dump.1":            vaultedDump1,
+	"vaulted-edit.1":            vaultedEdit1,
+	"vaulted-env.1":             vaultedEnv1,
+	"vaulted-exec.1":            vaultedExec1,
+	"vaulted-export.1":          vaultedExport1,
+	"vaulted-fix-permissions.1": vaultedFixPermissions1,
+	"vaulted-history.1":         vaultedHistory1,
+	"vaulted-import.1":          vaultedImport1,
+	"vaulted-load.1":            vaultedLoad1,
+	"vaulted-ls.1":              vaultedLs1,
+	"vaulted-passwd.1":          vaultedPasswd1,
+	"vaulted-recipients.1":      vaultedRecipients1,
+	"vaulted-restore.1":         vaultedRestore1,
+	"vaulted-rm.1":              vaultedRm1,
+	"vaulted-session.1":         vaultedSession1,
+	"vaulted-shell.1":           vaultedShell1,
+	"vaulted-sync.1":            vaultedSync1,
+	"vaulted-trash.1":           vaultedTrash1,
+	"vaulted-upgrade.1":         vaultedUpgrade1,
+	"vaulted-verify.1":          vaultedVerify1,
+	"vaulted.1":                 vaulted1,
 }
 
 // AssetDir returns the file names below a certain
@@ -448,19 +712,31 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"vaulted-add.1":     &bintree{vaultedAdd1, map[string]*bintree{}},
-	"vaulted-cp.1":      &bintree{vaultedCp1, map[string]*bintree{}},
-	"vaulted-dump.1":    &bintree{vaultedDump1, map[string]*bintree{}},
-	"vaulted-edit.1":    &bintree{vaultedEdit1, map[string]*bintree{}},
-	"vaulted-env.1":     &bintree{vaultedEnv1, map[string]*bintree{}},
-	"vaulted-exec.1":    &bintree{vaultedExec1, map[string]*bintree{}},
-	"vaulted-load.1":    &bintree{vaultedLoad1, map[string]*bintree{}},
-	"vaulted-ls.1":      &bintree{vaultedLs1, map[string]*bintree{}},
-	"vaulted-passwd.1":  &bintree{vaultedPasswd1, map[string]*bintree{}},
-	"vaulted-rm.1":      &bintree{vaultedRm1, map[string]*bintree{}},
-	"vaulted-shell.1":   &bintree{vaultedShell1, map[string]*bintree{}},
-	"vaulted-upgrade.1": &bintree{vaultedUpgrade1, map[string]*bintree{}},
-	"vaulted.1":         &bintree{vaulted1, map[string]*bintree{}},
+	"vaulted-add.1":             &bintree{vaultedAdd1, map[string]*bintree{}},
+	"vaulted-agent.1":           &bintree{vaultedAgent1, map[string]*bintree{}},
+	"vaulted-config.1":          &bintree{vaultedConfig1, map[string]*bintree{}},
+	"vaulted-cp.1":              &bintree{vaultedCp1, map[string]*bintree{}},
+	"vaulted-dump.1":            &bintree{vaultedDump1, map[string]*bintree{}},
+	"vaulted-edit.1":            &bintree{vaultedEdit1, map[string]*bintree{}},
+	"vaulted-env.1":             &bintree{vaultedEnv1, map[string]*bintree{}},
+	"vaulted-exec.1":            &bintree{vaultedExec1, map[string]*bintree{}},
+	"vaulted-export.1":          &bintree{vaultedExport1, map[string]*bintree{}},
+	"vaulted-fix-permissions.1": &bintree{vaultedFixPermissions1, map[string]*bintree{}},
+	"vaulted-history.1":         &bintree{vaultedHistory1, map[string]*bintree{}},
+	"vaulted-import.1":          &bintree{vaultedImport1, map[string]*bintree{}},
+	"vaulted-load.1":            &bintree{vaultedLoad1, map[string]*bintree{}},
+	"vaulted-ls.1":              &bintree{vaultedLs1, map[string]*bintree{}},
+	"vaulted-passwd.1":          &bintree{vaultedPasswd1, map[string]*bintree{}},
+	"vaulted-recipients.1":      &bintree{vaultedRecipients1, map[string]*bintree{}},
+	"vaulted-restore.1":         &bintree{vaultedRestore1, map[string]*bintree{}},
+	"vaulted-rm.1":              &bintree{vaultedRm1, map[string]*bintree{}},
+	"vaulted-session.1":         &bintree{vaultedSession1, map[string]*bintree{}},
+	"vaulted-shell.1":           &bintree{vaultedShell1, map[string]*bintree{}},
+	"vaulted-sync.1":            &bintree{vaultedSync1, map[string]*bintree{}},
+	"vaulted-trash.1":           &bintree{vaultedTrash1, map[string]*bintree{}},
+	"vaulted-upgrade.1":         &bintree{vaultedUpgrade1, map[string]*bintree{}},
+	"vaulted-verify.1":          &bintree{vaultedVerify1, map[string]*bintree{}},
+	"vaulted.1":                 &bintree{vaulted1, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
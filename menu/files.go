@@ -0,0 +1,160 @@
+package menu
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/fatih/color"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+// FilesMenu edits the secret files written for spawned sessions
+type FilesMenu struct {
+	*Menu
+}
+
+func (m *FilesMenu) Help() {
+	menuColor.Set()
+	defer color.Unset()
+
+	fmt.Println("a,add    - Add")
+	fmt.Println("D,delete - Delete")
+	fmt.Println("?,help   - Help")
+	fmt.Println("b,back   - Back")
+	fmt.Println("q,quit   - Quit")
+}
+
+func (m *FilesMenu) Handler() error {
+	for {
+		var err error
+		m.Printer()
+		input, err := interaction.ReadMenu("Edit files: [a,D,b]: ")
+		if err != nil {
+			return err
+		}
+		switch input {
+		case "a", "add", "file", "files":
+			err = m.AddFile()
+		case "D", "delete", "remove":
+			var name string
+			name, err = interaction.ReadValue("File name: ")
+			if err == nil {
+				if _, exists := m.Vault.Files[name]; exists {
+					delete(m.Vault.Files, name)
+				} else {
+					color.Red("File '%s' not found", name)
+				}
+			}
+		case "b", "back":
+			return nil
+		case "q", "quit", "exit":
+			var confirm string
+			confirm, err = interaction.ReadValue("Are you sure you wish to save and exit the vault? (y/n): ")
+			if err == nil {
+				if confirm == "y" {
+					return ErrSaveAndExit
+				}
+			}
+		case "?", "help":
+			m.Help()
+		default:
+			color.Red("Command not recognized")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (m *FilesMenu) AddFile() error {
+	source, err := interaction.ReadValue("Read content from file: ")
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(source)
+	if err != nil {
+		color.Red("%v", err)
+		return nil
+	}
+
+	name, err := interaction.ReadValue("File name (written to the session's files directory): ")
+	if err != nil {
+		return err
+	}
+	if !vaulted.ValidSecretFileName(name) {
+		color.Red("Invalid file name: %s", name)
+		return nil
+	}
+	if _, exists := m.Vault.Files[name]; exists {
+		confirm, err := interaction.ReadValue(fmt.Sprintf("File '%s' already exists. Overwrite? (y/n): ", name))
+		if err != nil {
+			return err
+		}
+		if confirm != "y" {
+			return nil
+		}
+	}
+
+	envVar, err := interaction.ReadValue("Variable set to the file's path (optional): ")
+	if err != nil {
+		return err
+	}
+
+	modeStr, err := interaction.ReadValue(fmt.Sprintf("Mode (default: %04o): ", vaulted.DefaultSecretFileMode))
+	if err != nil {
+		return err
+	}
+	var mode os.FileMode
+	if modeStr != "" {
+		parsed, err := strconv.ParseUint(modeStr, 8, 32)
+		if err != nil || parsed == 0 || parsed > 0777 {
+			color.Red("Invalid mode: %s", modeStr)
+			return nil
+		}
+		mode = os.FileMode(parsed)
+	}
+
+	if m.Vault.Files == nil {
+		m.Vault.Files = make(map[string]*vaulted.SecretFile)
+	}
+	m.Vault.Files[name] = &vaulted.SecretFile{
+		Content: string(content),
+		Mode:    mode,
+		EnvVar:  envVar,
+	}
+
+	return nil
+}
+
+func (m *FilesMenu) Printer() {
+	color.Cyan("\nFiles:")
+	if len(m.Vault.Files) == 0 {
+		fmt.Println("  [Empty]")
+		return
+	}
+
+	var names []string
+	for name := range m.Vault.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		file := m.Vault.Files[name]
+		green.Printf("  %s: ", name)
+		fmt.Printf("%04o", file.FileMode())
+		if file.EnvVar != "" {
+			fmt.Printf(" ($%s)", file.EnvVar)
+		}
+		if m.Menu.ShowHidden {
+			fmt.Printf("\n%s\n", file.Content)
+		} else {
+			fmt.Printf(" %s\n", faintColor.Sprint("<hidden>"))
+		}
+	}
+}
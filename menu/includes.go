@@ -0,0 +1,29 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IncludesMenu edits the list of vaults included by the vault
+type IncludesMenu struct {
+	*Menu
+}
+
+func (m *IncludesMenu) Handler() error {
+	includes, err := interaction.ReadValue("Included vaults (comma separated, later vaults take precedence): ")
+	if err == nil {
+		m.Vault.Includes = parseList(includes)
+	}
+	return err
+}
+
+func (m *IncludesMenu) Printer() {
+	cyan.Println("\nIncludes:")
+	if len(m.Vault.Includes) == 0 {
+		fmt.Println("  [Empty]")
+		return
+	}
+
+	fmt.Printf("  %s\n", strings.Join(m.Vault.Includes, ", "))
+}
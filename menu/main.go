@@ -19,6 +19,9 @@ func (m *MainMenu) Handler() error {
 	awsMenu := &AWSMenu{Menu: &m.Menu}
 	variableMenu := &VariableMenu{Menu: &m.Menu}
 	sshKeysMenu := &SSHKeyMenu{Menu: &m.Menu}
+	filesMenu := &FilesMenu{Menu: &m.Menu}
+	metadataMenu := &MetadataMenu{Menu: &m.Menu}
+	includesMenu := &IncludesMenu{Menu: &m.Menu}
 
 	for {
 		cyan.Printf("\nVault: ")
@@ -26,10 +29,13 @@ func (m *MainMenu) Handler() error {
 		variableMenu.Printer()
 		awsMenu.Printer()
 		sshKeysMenu.Printer()
+		filesMenu.Printer()
 		durationMenu.Printer()
+		includesMenu.Printer()
+		metadataMenu.Printer()
 
 		var input string
-		input, err = interaction.ReadMenu("Edit vault: [a,s,v,d,S]: ")
+		input, err = interaction.ReadMenu("Edit vault: [a,s,v,f,d,i,m,S]: ")
 		if err != nil {
 			break
 		}
@@ -40,8 +46,14 @@ func (m *MainMenu) Handler() error {
 			err = sshKeysMenu.Handler()
 		case "v", "vars", "variables":
 			err = variableMenu.Handler()
+		case "f", "files":
+			err = filesMenu.Handler()
 		case "d", "duration":
 			err = durationMenu.Handler()
+		case "i", "includes":
+			err = includesMenu.Handler()
+		case "m", "metadata":
+			err = metadataMenu.Handler()
 		case "S", "show", "hide":
 			m.toggleHidden()
 		case "b", "q", "quit", "exit":
@@ -70,7 +82,10 @@ func (m *MainMenu) Help() {
 	fmt.Println("a,aws      - AWS Key")
 	fmt.Println("s,ssh      - SSH Keys")
 	fmt.Println("v,vars     - Variables")
+	fmt.Println("f,files    - Files")
 	fmt.Println("d,duration - Session Duration")
+	fmt.Println("i,includes - Included Vaults")
+	fmt.Println("m,metadata - Metadata (not encrypted)")
 	fmt.Println("S,show     - Show/Hide Secrets")
 	fmt.Println("?,help     - Help")
 	fmt.Println("q,quit     - Quit")
@@ -0,0 +1,109 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+// MetadataMenu edits the vault's metadata, which is stored unencrypted
+type MetadataMenu struct {
+	*Menu
+}
+
+func (m *MetadataMenu) Help() {
+	menuColor.Set()
+	defer color.Unset()
+
+	fmt.Println("d,description - Description")
+	fmt.Println("t,tags        - Tags")
+	fmt.Println("o,owner       - Owner")
+	fmt.Println("a,account     - AWS Account ID")
+	fmt.Println("?,help        - Help")
+	fmt.Println("b,back        - Back")
+	fmt.Println("q,quit        - Quit")
+}
+
+func (m *MetadataMenu) Handler() error {
+	for {
+		m.Printer()
+		input, err := interaction.ReadMenu("Edit metadata [d,t,o,a,b]: ")
+		if err != nil {
+			return err
+		}
+
+		if m.Vault.Metadata == nil {
+			m.Vault.Metadata = &vaulted.VaultMetadata{}
+		}
+
+		switch input {
+		case "d", "description":
+			m.Vault.Metadata.Description, err = interaction.ReadValue("Description: ")
+		case "t", "tags":
+			var tags string
+			tags, err = interaction.ReadValue("Tags (comma separated): ")
+			m.Vault.Metadata.Tags = parseList(tags)
+		case "o", "owner":
+			m.Vault.Metadata.Owner, err = interaction.ReadValue("Owner: ")
+		case "a", "account":
+			m.Vault.Metadata.AWSAccountID, err = interaction.ReadValue("AWS Account ID: ")
+		case "b", "back":
+			return nil
+		case "q", "quit", "exit":
+			var confirm string
+			confirm, err = interaction.ReadValue("Are you sure you wish to save and exit the vault? (y/n): ")
+			if err == nil && confirm == "y" {
+				return ErrSaveAndExit
+			}
+		case "?", "help":
+			m.Help()
+		default:
+			color.Red("Command not recognized")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func parseList(tags string) []string {
+	var parsed []string
+	for _, tag := range strings.Split(tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			parsed = append(parsed, tag)
+		}
+	}
+	return parsed
+}
+
+func (m *MetadataMenu) Printer() {
+	cyan.Print("\nMetadata")
+	faintColor.Println(" (not encrypted)")
+
+	metadata := m.Vault.Metadata
+	if metadata == nil {
+		metadata = &vaulted.VaultMetadata{}
+	}
+
+	if metadata.Description == "" && len(metadata.Tags) == 0 && metadata.Owner == "" && metadata.AWSAccountID == "" {
+		fmt.Println("  [Empty]")
+		return
+	}
+
+	m.printValue("Description", metadata.Description)
+	m.printValue("Tags", strings.Join(metadata.Tags, ", "))
+	m.printValue("Owner", metadata.Owner)
+	m.printValue("AWS Account ID", metadata.AWSAccountID)
+}
+
+func (m *MetadataMenu) printValue(label, value string) {
+	if value != "" {
+		green.Printf("  %s: ", label)
+		fmt.Println(value)
+	}
+}
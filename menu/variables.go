@@ -3,8 +3,11 @@ package menu
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/fatih/color"
+
+	"github.com/miquella/vaulted/lib"
 )
 
 type VariableMenu struct {
@@ -17,10 +20,10 @@ func (m *VariableMenu) Handler() error {
 	for {
 		var input string
 		m.Printer()
-		if m.Vault.Vars == nil {
-			input, varErr = interaction.ReadMenu("Edit environment variables: [a,b]: ")
+		if m.Vault.Vars == nil && m.Vault.CommandVars == nil {
+			input, varErr = interaction.ReadMenu("Edit environment variables: [a,c,b]: ")
 		} else {
-			input, varErr = interaction.ReadMenu("Edit environment variables: [a,S,D,b]: ")
+			input, varErr = interaction.ReadMenu("Edit environment variables: [a,c,S,D,b]: ")
 		}
 		if varErr != nil {
 			return varErr
@@ -48,6 +51,12 @@ func (m *VariableMenu) Handler() error {
 				}
 			}
 			m.Vault.Vars[variableKey] = variableValue
+			delete(m.Vault.CommandVars, variableKey)
+		case "c", "command":
+			err := m.AddCommandVar()
+			if err != nil {
+				return err
+			}
 		case "S", "show", "hide":
 			m.toggleHidden()
 		case "D", "delete", "remove":
@@ -57,6 +66,8 @@ func (m *VariableMenu) Handler() error {
 			}
 			if _, exists := m.Vault.Vars[variable]; exists {
 				delete(m.Vault.Vars, variable)
+			} else if _, exists := m.Vault.CommandVars[variable]; exists {
+				delete(m.Vault.CommandVars, variable)
 			} else {
 				color.Red("Variable '%s' not found", variable)
 			}
@@ -84,6 +95,7 @@ func (m *VariableMenu) Help() {
 	defer color.Unset()
 	fmt.Println("")
 	fmt.Println("a,add    - Add")
+	fmt.Println("c        - Add Command")
 	fmt.Println("S,show   - Show/Hide Secrets")
 	fmt.Println("D,delete - Delete")
 	fmt.Println("?,help   - Help")
@@ -91,18 +103,84 @@ func (m *VariableMenu) Help() {
 	fmt.Println("q,quit   - Quit")
 }
 
+func (m *VariableMenu) AddCommandVar() error {
+	name, err := interaction.ReadValue("Name: ")
+	if err != nil {
+		return err
+	}
+	command, err := interaction.ReadValue("Command (its output is the value): ")
+	if err != nil {
+		return err
+	}
+	if command == "" {
+		color.Red("A command is required")
+		return nil
+	}
+
+	timeoutStr, err := interaction.ReadValue(fmt.Sprintf("Timeout (default: %s): ", vaulted.DefaultCommandVarTimeout))
+	if err != nil {
+		return err
+	}
+	var timeout time.Duration
+	if timeoutStr != "" {
+		timeout, err = time.ParseDuration(timeoutStr)
+		if err != nil || timeout <= 0 {
+			color.Red("Invalid timeout: %s", timeoutStr)
+			return nil
+		}
+	}
+
+	cache, err := interaction.ReadValue("Cache the value with the session, until it expires? (y/n): ")
+	if err != nil {
+		return err
+	}
+
+	if _, exists := m.Vault.Vars[name]; exists {
+		confirm, err := interaction.ReadValue(fmt.Sprintf("Variable '%s' already exists. Overwrite? (y/n): ", name))
+		if err != nil {
+			return err
+		}
+		if confirm != "y" {
+			return nil
+		}
+		delete(m.Vault.Vars, name)
+	}
+
+	if m.Vault.CommandVars == nil {
+		m.Vault.CommandVars = make(map[string]*vaulted.CommandVar)
+	}
+	m.Vault.CommandVars[name] = &vaulted.CommandVar{
+		Command: command,
+		Timeout: timeout,
+		Cache:   cache == "y",
+	}
+
+	return nil
+}
+
 func (m *VariableMenu) Printer() {
 	color.Cyan("\nVariables:")
-	if len(m.Vault.Vars) > 0 {
+	if len(m.Vault.Vars) > 0 || len(m.Vault.CommandVars) > 0 {
 		var keys []string
 		for key := range m.Vault.Vars {
 			keys = append(keys, key)
 		}
+		for key := range m.Vault.CommandVars {
+			if _, exists := m.Vault.Vars[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
 		sort.Strings(keys)
 
 		for _, key := range keys {
 			green.Printf("  %s: ", key)
-			if m.Menu.ShowHidden {
+			if commandVar, exists := m.Vault.CommandVars[key]; exists {
+				details := fmt.Sprintf("timeout %s", commandVar.EffectiveTimeout())
+				if commandVar.Cache {
+					details += ", cached"
+				}
+				fmt.Printf("$(%s) %s\n", commandVar.Command, faintColor.Sprintf("(%s)", details))
+			} else if m.Menu.ShowHidden {
 				fmt.Printf("%s\n", m.Vault.Vars[key])
 			} else {
 				fmt.Printf("%s\n", faintColor.Sprint("<hidden>"))
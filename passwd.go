@@ -0,0 +1,148 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+var (
+	ErrPasswdFailed     = errors.New("Vault password could not be changed")
+	ErrNoMatchingVaults = errors.New("No vaults match")
+)
+
+// Passwd changes the password of several vaults at once, sealing all of them
+// with a single new password.
+type Passwd struct {
+	// VaultNames lists the names of the vaults (or glob patterns matching
+	// them) to change the password of.
+	VaultNames []string
+	All        bool
+
+	SealOptions vaulted.SealOptions
+}
+
+type openedVault struct {
+	name  string
+	vault *vaulted.Vault
+}
+
+func (p *Passwd) Run(store vaulted.Store) error {
+	names, failures, err := p.matchVaults(store)
+	if err != nil {
+		return err
+	}
+
+	// open all of the vaults first, so the new password is only requested
+	// once the old passwords are known to be correct
+	var vaults []openedVault
+	var lastPassword string
+	for _, name := range names {
+		vault, password, err := openVaultTryingPassword(store, name, lastPassword)
+		if os.IsNotExist(err) {
+			err = ErrVaultNotExist
+		}
+		if err != nil {
+			failures++
+			fmt.Printf("%s: %v\n", name, err)
+			continue
+		}
+		if password != "" {
+			lastPassword = password
+		}
+
+		vaults = append(vaults, openedVault{name, vault})
+	}
+
+	if len(vaults) > 0 {
+		prompt := vaults[0].name
+		if len(vaults) > 1 {
+			prompt = fmt.Sprintf("%d vaults", len(vaults))
+		}
+		password, err := store.Steward().GetPassword(vaulted.SealOperation, prompt)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range vaults {
+			err = store.SealVaultWithOptions(v.vault, v.name, password, &p.SealOptions)
+			if err != nil {
+				failures++
+				fmt.Printf("%s: %v\n", v.name, err)
+				continue
+			}
+
+			fmt.Printf("%s: password changed\n", v.name)
+		}
+	}
+
+	if failures > 0 {
+		return ErrorWithExitCode{ErrPasswdFailed, failures}
+	}
+
+	return nil
+}
+
+// matchVaults returns the names of the vaults to change the password of, in
+// order. Patterns that don't match any vaults are reported and counted as
+// failures.
+func (p *Passwd) matchVaults(store vaulted.Store) ([]string, int, error) {
+	existing, err := store.ListVaults()
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.Strings(existing)
+
+	if p.All {
+		return existing, 0, nil
+	}
+
+	var names []string
+	seen := map[string]bool{}
+	failures := 0
+	for _, pattern := range p.VaultNames {
+		// plain names are used as is (so a missing vault is reported as such)
+		if !strings.ContainsAny(pattern, "*?[") {
+			if !seen[pattern] {
+				seen[pattern] = true
+				names = append(names, pattern)
+			}
+			continue
+		}
+
+		matched := false
+		for _, name := range existing {
+			if ok, _ := path.Match(pattern, name); ok {
+				matched = true
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+		}
+		if !matched {
+			failures++
+			fmt.Printf("%s: %v\n", pattern, ErrNoMatchingVaults)
+		}
+	}
+
+	return names, failures, nil
+}
+
+// openVaultTryingPassword opens a vault, trying the specified password before
+// requesting one (vaults often share a password).
+func openVaultTryingPassword(store vaulted.Store, name, password string) (*vaulted.Vault, string, error) {
+	if password != "" {
+		vault, password, err := store.OpenVaultWithPassword(name, password)
+		if err != vaulted.ErrIncorrectPassword {
+			return vault, password, err
+		}
+	}
+
+	return store.OpenVault(name)
+}
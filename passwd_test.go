@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestPasswdAll(t *testing.T) {
+	store := NewTestStore()
+	for _, name := range []string{"one", "two", "three"} {
+		store.Vaults[name] = &vaulted.Vault{}
+		store.Passwords[name] = name + " old password"
+	}
+
+	output := CaptureStdout(func() {
+		p := Passwd{
+			All: true,
+			SealOptions: vaulted.SealOptions{
+				KeyMethod: "argon2id",
+			},
+		}
+		err := p.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte("one: password changed\nthree: password changed\ntwo: password changed\n")
+	if !bytes.Equal(output, expected) {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+
+	for _, name := range []string{"one", "two", "three"} {
+		if store.Passwords[name] != "prompted seal password" {
+			t.Fatalf("Expected %s to be sealed with the new password, got: %s", name, store.Passwords[name])
+		}
+		if store.SealOptions[name].KeyMethod != "argon2id" {
+			t.Fatalf("Expected %s to be sealed with the seal options, got: %#v", name, store.SealOptions[name])
+		}
+	}
+}
+
+func TestPasswdPatterns(t *testing.T) {
+	store := NewTestStore()
+	for _, name := range []string{"prod-web", "prod-db", "staging-web"} {
+		store.Vaults[name] = &vaulted.Vault{}
+		store.Passwords[name] = "old password"
+	}
+
+	var err error
+	output := CaptureStdout(func() {
+		p := Passwd{
+			VaultNames: []string{"prod-*", "prod-web", "missing", "dev-*"},
+		}
+		err = p.Run(store)
+	})
+
+	if exiterr, ok := err.(ErrorWithExitCode); !ok || exiterr.ExitCode != 2 {
+		t.Fatalf("Expected an exit code of 2, got: %v", err)
+	}
+
+	expected := []byte("dev-*: No vaults match\nmissing: The vault does not exist\nprod-db: password changed\nprod-web: password changed\n")
+	if !bytes.Equal(output, expected) {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+
+	passwords := map[string]string{
+		"prod-web":    "prompted seal password",
+		"prod-db":     "prompted seal password",
+		"staging-web": "old password",
+	}
+	if !reflect.DeepEqual(passwords, store.Passwords) {
+		t.Fatalf("Expected: %#v, got: %#v", passwords, store.Passwords)
+	}
+}
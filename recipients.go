@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type RecipientsList struct {
+	VaultName string
+}
+
+func (l *RecipientsList) Run(store vaulted.Store) error {
+	recipients, err := store.ListRecipients(l.VaultName)
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range recipients {
+		fmt.Println(recipient)
+	}
+
+	return nil
+}
+
+type RecipientsAdd struct {
+	VaultName  string
+	Recipients []string
+}
+
+func (a *RecipientsAdd) Run(store vaulted.Store) error {
+	return store.AddRecipients(a.VaultName, a.Recipients)
+}
+
+type RecipientsRemove struct {
+	VaultName  string
+	Recipients []string
+}
+
+func (r *RecipientsRemove) Run(store vaulted.Store) error {
+	return store.RemoveRecipients(r.VaultName, r.Recipients)
+}
+
+type RecipientsIdentity struct{}
+
+func (i *RecipientsIdentity) Run(store vaulted.Store) error {
+	identity, err := store.Identity()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(identity)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestRecipientsList(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Recipients["one"] = []string{"key1", "key2"}
+
+	output := CaptureStdout(func() {
+		l := RecipientsList{
+			VaultName: "one",
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := "key1\nkey2\n"
+	if string(output) != expected {
+		t.Fatalf("Incorrect output!\nExpected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestRecipientsAddRemove(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	a := RecipientsAdd{
+		VaultName:  "one",
+		Recipients: []string{"key1", "key2"},
+	}
+	err := a.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := RecipientsRemove{
+		VaultName:  "one",
+		Recipients: []string{"key1"},
+	}
+	err = r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(store.Recipients["one"], []string{"key2"}) {
+		t.Fatalf("Expected recipients [key2], got: %v", store.Recipients["one"])
+	}
+
+	err = r.Run(store)
+	if err != vaulted.ErrRecipientNotFound {
+		t.Fatalf("Expected ErrRecipientNotFound, got: %v", err)
+	}
+}
+
+func TestRecipientsIdentity(t *testing.T) {
+	store := NewTestStore()
+
+	output := CaptureStdout(func() {
+		i := RecipientsIdentity{}
+		err := i.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := "identity\n"
+	if string(output) != expected {
+		t.Fatalf("Incorrect output!\nExpected:\n%s\ngot:\n%s", expected, output)
+	}
+}
@@ -9,12 +9,18 @@ import (
 
 type Remove struct {
 	VaultNames []string
+	Permanent  bool
 }
 
 func (r *Remove) Run(store vaulted.Store) error {
 	failures := 0
 	for _, name := range r.VaultNames {
-		err := store.RemoveVault(name)
+		var err error
+		if r.Permanent {
+			err = store.RemoveVault(name)
+		} else {
+			err = store.TrashVault(name)
+		}
 		if err != nil {
 			failures++
 			fmt.Printf("%s: %v\n", name, err)
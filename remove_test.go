@@ -44,3 +44,35 @@ func TestRemove(t *testing.T) {
 		t.Fatal("Still expected 'two' to be removed")
 	}
 }
+
+func TestRemoveMovesToTrash(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{}
+
+	r := Remove{
+		VaultNames: []string{"one"},
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := store.Trash["one"]; !exists {
+		t.Fatal("The vault 'one' was not moved to the trash")
+	}
+
+	r = Remove{
+		VaultNames: []string{"two"},
+		Permanent:  true,
+	}
+	err = r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := store.Trash["two"]; exists {
+		t.Fatal("The vault 'two' was moved to the trash instead of being removed")
+	}
+	if store.VaultExists("two") {
+		t.Fatal("The vault 'two' was not removed")
+	}
+}
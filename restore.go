@@ -0,0 +1,14 @@
+package main
+
+import (
+	"github.com/miquella/vaulted/lib"
+)
+
+type Restore struct {
+	VaultName string
+	Version   int
+}
+
+func (r *Restore) Run(store vaulted.Store) error {
+	return store.RestoreVaultVersion(r.VaultName, r.Version)
+}
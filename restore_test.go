@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestRestore(t *testing.T) {
+	store := NewTestStore()
+	store.SealVaultWithPassword(&vaulted.Vault{Vars: map[string]string{"VERSION": "1"}}, "one", "password")
+	store.SealVaultWithPassword(&vaulted.Vault{Vars: map[string]string{"VERSION": "2"}}, "one", "password")
+
+	r := Restore{
+		VaultName: "one",
+		Version:   1,
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.Vaults["one"].Vars["VERSION"] != "1" {
+		t.Fatalf("Expected version 1 to be restored, got: %v", store.Vaults["one"].Vars)
+	}
+
+	r = Restore{
+		VaultName: "one",
+		Version:   5,
+	}
+	err = r.Run(store)
+	if err != vaulted.ErrVaultVersionNotFound {
+		t.Fatalf("Expected ErrVaultVersionNotFound, got: %v", err)
+	}
+}
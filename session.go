@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type SessionList struct {
+	VaultName string
+}
+
+func (l *SessionList) Run(store vaulted.Store) error {
+	if l.VaultName == "" {
+		return l.listCaches(store)
+	}
+
+	vault, _, err := store.OpenVault(l.VaultName)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := store.ListSessions(vault, l.VaultName)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		role := session.Role
+		if role == "" {
+			role = "-"
+		}
+
+		fmt.Printf("%s  %s  %s\n", role, session.Expiration.Format("2 Jan 2006 15:04:05 MST"), sessionStatus(session))
+	}
+
+	return nil
+}
+
+func (l *SessionList) listCaches(store vaulted.Store) error {
+	names, err := store.ListSessionCaches()
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		if !store.VaultExists(name) {
+			name = fmt.Sprintf("%s (orphaned)", name)
+		}
+		fmt.Println(name)
+	}
+
+	return nil
+}
+
+func sessionStatus(session vaulted.CachedSession) string {
+	switch {
+	case session.Reusable:
+		return "reused"
+	case !session.Current:
+		return "stale"
+	default:
+		return "expiring"
+	}
+}
+
+type SessionRemove struct {
+	VaultNames []string
+}
+
+func (r *SessionRemove) Run(store vaulted.Store) error {
+	failures := 0
+	for _, name := range r.VaultNames {
+		err := store.RemoveSessionCache(name)
+		if os.IsNotExist(err) {
+			// there's nothing to remove, which isn't a failure
+			fmt.Printf("%s: no session cache\n", name)
+			continue
+		}
+		if err != nil {
+			failures++
+			fmt.Printf("%s: %v\n", name, err)
+		}
+	}
+
+	if failures > 0 {
+		return ErrorWithExitCode{
+			errors.New("Session cache could not be removed"),
+			failures,
+		}
+	}
+
+	return nil
+}
+
+type SessionPurge struct {
+	Orphaned bool
+}
+
+func (p *SessionPurge) Run(store vaulted.Store) error {
+	names, err := store.ListSessionCaches()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if p.Orphaned && store.VaultExists(name) {
+			continue
+		}
+
+		err = store.RemoveSessionCache(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"errors"
 	"os"
-	"time"
 
 	"github.com/miquella/ssh-proxy-agent/lib/proxyagent"
 
@@ -45,18 +44,25 @@ func GetSessionWithOptions(store vaulted.Store, options *SessionOptions) (*vault
 			return nil, ErrNoSessionIncompatibleWithRegion
 		}
 
+		err := configureSettings(options.VaultName)
+		if err != nil {
+			return nil, err
+		}
+
 		return getVaultSessionWithNoSession(store, options)
 	}
 
-	var session *vaulted.Session
-	var err error
-
 	// Get a session
-	if options.VaultName == "" {
-		session, err = getDefaultSession(options)
-	} else {
-		session, err = getVaultSession(store, options)
+	if options.VaultName != "" {
+		err := configureSettings(options.VaultName)
+		if err != nil {
+			return nil, err
+		}
+
+		return getVaultSession(store, options)
 	}
+
+	session, err := getDefaultSession(options)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +81,11 @@ func getVaultSessionWithNoSession(store vaulted.Store, options *SessionOptions)
 		return nil, err
 	}
 
+	err = store.ResolveIncludes(vault, options.VaultName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Change the in-memory vault to forgo temp cred generation
 	if vault.AWSKey != nil {
 		vault.AWSKey.ForgoTempCredGeneration = true
@@ -91,7 +102,7 @@ func getVaultSessionWithNoSession(store vaulted.Store, options *SessionOptions)
 func getDefaultSession(options *SessionOptions) (*vaulted.Session, error) {
 	// Create the vault
 	vault := &vaulted.Vault{
-		Duration: time.Hour,
+		Duration: vaulted.STSDurationDefault,
 	}
 
 	updateVaultFromEnvAndOptions(vault, options)
@@ -101,7 +112,12 @@ func getDefaultSession(options *SessionOptions) (*vaulted.Session, error) {
 }
 
 func getVaultSession(store vaulted.Store, options *SessionOptions) (*vaulted.Session, error) {
-	vault, password, err := store.OpenVault(options.VaultName)
+	vault, _, err := store.OpenVault(options.VaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.ResolveIncludes(vault, options.VaultName)
 	if err != nil {
 		return nil, err
 	}
@@ -111,16 +127,32 @@ func getVaultSession(store vaulted.Store, options *SessionOptions) (*vaulted.Ses
 	// Create/get cached session
 	var session *vaulted.Session
 	if options.Refresh {
-		session, err = store.CreateSession(vault, options.VaultName, password)
+		session, err = store.CreateSession(vault, options.VaultName)
 	} else {
-		session, err = store.GetSession(vault, options.VaultName, password)
+		session, err = store.GetSession(vault, options.VaultName)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Assume the session's role
-	return session.AssumeSessionRole()
+	// Assume the session's role (roles assumed with a vault's session are
+	// cached along with the session)
+	if session.Role != "" {
+		role := session.Role
+		session.Role = ""
+
+		session, err = store.AssumeRole(vault, options.VaultName, session, role)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Assume any role specified
+	if options.Role != "" {
+		return store.AssumeRole(vault, options.VaultName, session, options.Role)
+	}
+
+	return session, nil
 }
 
 func updateVaultFromEnvAndOptions(vault *vaulted.Vault, options *SessionOptions) {
@@ -152,6 +184,8 @@ func updateVaultFromEnvAndOptions(vault *vaulted.Vault, options *SessionOptions)
 }
 
 func updateVaultFromSSHOptions(vault *vaulted.Vault, options *SessionOptions) {
+	// the options override those of the included vaults as well
+	vault.SSHOptions = vault.MergedSSHOptions()
 	if vault.SSHOptions == nil {
 		vault.SSHOptions = &vaulted.SSHOptions{}
 	}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestGetSessionWithOptionsAssumesRolesWithStore(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		AWSKey: &vaulted.AWSKey{
+			Role: "arn:aws:iam::123456789012:role/vault-role",
+		},
+	}
+
+	session, err := GetSessionWithOptions(store, &SessionOptions{
+		VaultName: "one",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.ActiveRole != "arn:aws:iam::123456789012:role/vault-role" {
+		t.Errorf("Expected the vault's role to be assumed, got: %s", session.ActiveRole)
+	}
+
+	session, err = GetSessionWithOptions(store, &SessionOptions{
+		VaultName: "one",
+		Role:      "arn:aws:iam::123456789012:role/other-role",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.ActiveRole != "arn:aws:iam::123456789012:role/other-role" {
+		t.Errorf("Expected the specified role to be assumed, got: %s", session.ActiveRole)
+	}
+}
+
+func TestGetSessionWithOptionsMergesIncludes(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["common"] = &vaulted.Vault{
+		Vars: map[string]string{
+			"COMMON": "common",
+			"SHARED": "common",
+		},
+		SSHOptions: &vaulted.SSHOptions{
+			DisableProxy:    true,
+			VaultSigningUrl: "https://vault.example.com/v1/ssh/sign/common",
+		},
+	}
+	store.Vaults["one"] = &vaulted.Vault{
+		Vars: map[string]string{
+			"SHARED": "one",
+		},
+		Includes: []string{"common"},
+	}
+
+	session, err := GetSessionWithOptions(store, &SessionOptions{
+		VaultName: "one",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"COMMON": "common",
+		"SHARED": "one",
+	}
+	if !reflect.DeepEqual(session.Vars, expected) {
+		t.Errorf("Expected vars: %#v, got: %#v", expected, session.Vars)
+	}
+
+	// options override the included vaults' SSH options
+	proxyAgent := true
+	vault, _, _ := store.OpenVault("one")
+	store.ResolveIncludes(vault, "one")
+	updateVaultFromSSHOptions(vault, &SessionOptions{ProxyAgent: &proxyAgent})
+	if vault.SSHOptions.DisableProxy || vault.SSHOptions.VaultSigningUrl != "https://vault.example.com/v1/ssh/sign/common" {
+		t.Errorf("Expected the included SSH options to be overridden, got: %#v", vault.SSHOptions)
+	}
+
+	store.Vaults["common"].Includes = []string{"common"}
+	_, err = GetSessionWithOptions(store, &SessionOptions{
+		VaultName: "one",
+	})
+	if _, ok := err.(*vaulted.IncludeCycleError); !ok {
+		t.Errorf("Expected an include cycle error, got: %v", err)
+	}
+}
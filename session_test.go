@@ -0,0 +1,81 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSessionList(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Sessions["one"] = &vaulted.Session{}
+	store.Sessions["two"] = &vaulted.Session{}
+
+	output := CaptureStdout(func() {
+		l := SessionList{}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := "one\ntwo (orphaned)\n"
+	if string(output) != expected {
+		t.Fatalf("Incorrect output!\nExpected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestSessionRemove(t *testing.T) {
+	store := NewTestStore()
+	store.Sessions["one"] = &vaulted.Session{}
+
+	r := SessionRemove{
+		VaultNames: []string{"one"},
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := store.Sessions["one"]; exists {
+		t.Fatal("The session cache of 'one' was not removed")
+	}
+
+	// a vault without a session cache isn't a failure
+	output := CaptureStdout(func() {
+		err = r.Run(store)
+	})
+	if err != nil {
+		t.Fatalf("Expected a missing session cache not to fail, got: %v", err)
+	}
+	if string(output) != "one: no session cache\n" {
+		t.Fatalf("Unexpected output: %s", output)
+	}
+}
+
+func TestSessionPurge(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Sessions["one"] = &vaulted.Session{}
+	store.Sessions["two"] = &vaulted.Session{}
+
+	p := SessionPurge{
+		Orphaned: true,
+	}
+	err := p.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := store.Sessions["one"]; !exists || len(store.Sessions) != 1 {
+		t.Fatal("Expected only the orphaned session cache to be purged")
+	}
+
+	p = SessionPurge{}
+	err = p.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Sessions) != 0 {
+		t.Fatal("The session caches were not purged")
+	}
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/miquella/xdg"
+)
+
+var (
+	ErrUnknownSetting = ErrorWithExitCode{errors.New("Unknown setting. See 'vaulted config list' for the available settings."), EX_USAGE_ERROR}
+)
+
+// ConfigPath is the location of the configuration file (overridden by the
+// VAULTED_CONFIG environment variable).
+var ConfigPath = xdg.CONFIG_HOME.Join("vaulted", "config")
+
+// Setting describes a setting that can be configured in the configuration
+// file, globally or for a single vault.
+type Setting struct {
+	Name        string
+	Description string
+
+	// Env is the environment variable that takes precedence over the
+	// configuration file (if any), while DefaultEnv is the environment
+	// variable used when the setting isn't configured (before Default).
+	Env        string
+	DefaultEnv string
+	Default    string
+	Integer    bool
+
+	validate func(value string) error
+}
+
+var Settings = []*Setting{
+	{
+		Name:        "session_duration",
+		Description: "Duration of sessions for vaults that don't specify one",
+		Default:     "1h",
+		validate:    validateDuration(15*time.Minute, 36*time.Hour),
+	},
+	{
+		Name:        "session_tolerance",
+		Description: "How long before a cached session expires that it is no longer reused",
+		Env:         "VAULTED_SESSION_TOLERANCE",
+		Default:     "15m",
+		validate:    validateDuration(0, 0),
+	},
+	{
+		Name:        "role_duration",
+		Description: "Maximum duration of sessions for assumed roles",
+		Default:     "1h",
+		validate:    validateDuration(15*time.Minute, 12*time.Hour),
+	},
+	{
+		Name:        "askpass",
+		Description: "Program used to request passwords (instead of the terminal)",
+		Env:         "VAULTED_ASKPASS",
+		validate:    validateNotEmpty,
+	},
+	{
+		Name:        "password_tries",
+		Description: "How many times a password is requested before giving up",
+		Default:     "3",
+		Integer:     true,
+		validate:    validatePositiveInteger,
+	},
+	{
+		Name:        "shell",
+		Description: "Shell spawned by 'vaulted shell' (instead of SHELL)",
+		DefaultEnv:  "SHELL",
+		Default:     "/bin/sh",
+		validate:    validateNotEmpty,
+	},
+}
+
+func findSetting(name string) *Setting {
+	for _, setting := range Settings {
+		if setting.Name == name {
+			return setting
+		}
+	}
+	return nil
+}
+
+// Validate checks that value is a valid value for the setting.
+func (s *Setting) Validate(value string) error {
+	err := s.validate(value)
+	if err != nil {
+		return ErrorWithExitCode{fmt.Errorf("Invalid value for %s: %v", s.Name, err), EX_USAGE_ERROR}
+	}
+	return nil
+}
+
+func validateDuration(min, max time.Duration) func(string) error {
+	return func(value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		if d < min || max > 0 && d > max {
+			if max > 0 {
+				return fmt.Errorf("must be between %s and %s", min, max)
+			}
+			return fmt.Errorf("must be at least %s", min)
+		}
+		return nil
+	}
+}
+
+func validatePositiveInteger(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return errors.New("must be a positive integer")
+	}
+	return nil
+}
+
+func validateNotEmpty(value string) error {
+	if value == "" {
+		return errors.New("must not be empty")
+	}
+	return nil
+}
+
+// Config holds the settings of the configuration file. Vaults holds the
+// settings that override the global settings for a single vault.
+type Config struct {
+	Global map[string]string
+	Vaults map[string]map[string]string
+}
+
+// LoadConfig reads the configuration file. A missing file is an empty
+// configuration.
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{
+		Global: make(map[string]string),
+		Vaults: make(map[string]map[string]string),
+	}
+
+	var content map[string]interface{}
+	_, err := toml.DecodeFile(path, &content)
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Invalid configuration file %s: %v", path, err)
+	}
+
+	for key, value := range content {
+		if key != "vault" {
+			err = config.load(config.Global, key, value)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid configuration file %s: %v", path, err)
+			}
+			continue
+		}
+
+		vaults, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Invalid configuration file %s: vault must be a table of vaults", path)
+		}
+		for name, vaultValue := range vaults {
+			vaultContent, ok := vaultValue.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Invalid configuration file %s: vault.%s must be a table", path, name)
+			}
+
+			config.Vaults[name] = make(map[string]string)
+			for key, value := range vaultContent {
+				err = config.load(config.Vaults[name], key, value)
+				if err != nil {
+					return nil, fmt.Errorf("Invalid configuration file %s: vault.%s: %v", path, name, err)
+				}
+			}
+		}
+	}
+
+	return config, nil
+}
+
+func (c *Config) load(settings map[string]string, key string, value interface{}) error {
+	setting := findSetting(key)
+	if setting == nil {
+		return fmt.Errorf("unknown setting %s", key)
+	}
+
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case int64:
+		str = strconv.FormatInt(v, 10)
+	default:
+		return fmt.Errorf("%s must be a string or an integer", key)
+	}
+
+	err := setting.validate(str)
+	if err != nil {
+		return fmt.Errorf("%s %v", key, err)
+	}
+
+	settings[key] = str
+	return nil
+}
+
+// Save writes the configuration file (creating its directory, if needed).
+func (c *Config) Save(path string) error {
+	var buf bytes.Buffer
+	err := encodeSettings(&buf, c.Global)
+	if err != nil {
+		return err
+	}
+
+	// each vault's table is written separately (rather than as a nested
+	// table), so the file doesn't end up with an empty [vault] table
+	for _, name := range c.VaultNames() {
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "[vault.%s]\n", tomlKey(name))
+		err = encodeSettings(&buf, c.Vaults[name])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0600)
+}
+
+func encodeSettings(buf *bytes.Buffer, settings map[string]string) error {
+	content := make(map[string]interface{})
+	for key, value := range settings {
+		content[key] = settingValue(key, value)
+	}
+	return toml.NewEncoder(buf).Encode(content)
+}
+
+// tomlKey quotes the key, unless it is a valid bare key.
+func tomlKey(key string) string {
+	if bareKeyRegexp.MatchString(key) {
+		return key
+	}
+	return strconv.Quote(key)
+}
+
+var bareKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func settingValue(key, value string) interface{} {
+	if setting := findSetting(key); setting != nil && setting.Integer {
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	}
+	return value
+}
+
+// Lookup returns the value of the setting for the vault (or the global value,
+// if vaultName is empty), along with where the value comes from. The
+// environment takes precedence over the vault's settings, which take
+// precedence over the global settings, which take precedence over the
+// setting's default (from DefaultEnv, if it is set).
+func (c *Config) Lookup(setting *Setting, vaultName string) (string, string) {
+	if setting.Env != "" {
+		if value := os.Getenv(setting.Env); value != "" {
+			return value, "environment variable " + setting.Env
+		}
+	}
+
+	if vaultName != "" {
+		if value, exists := c.Vaults[vaultName][setting.Name]; exists {
+			return value, fmt.Sprintf("vault '%s'", vaultName)
+		}
+	}
+
+	if value, exists := c.Global[setting.Name]; exists {
+		return value, "configuration file"
+	}
+
+	if setting.DefaultEnv != "" {
+		if value := os.Getenv(setting.DefaultEnv); value != "" {
+			return value, "environment variable " + setting.DefaultEnv
+		}
+	}
+
+	return setting.Default, "default"
+}
+
+// VaultNames returns the names of the vaults with settings.
+func (c *Config) VaultNames() []string {
+	var names []string
+	for name, settings := range c.Vaults {
+		if len(settings) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+var (
+	loadedConfig    *Config
+	loadedConfigErr error
+)
+
+// currentConfig returns the configuration, reading the configuration file the
+// first time it is needed.
+func currentConfig() (*Config, error) {
+	if loadedConfig == nil && loadedConfigErr == nil {
+		loadedConfig, loadedConfigErr = LoadConfig(configPath())
+	}
+	return loadedConfig, loadedConfigErr
+}
+
+func configPath() string {
+	if path := os.Getenv("VAULTED_CONFIG"); path != "" {
+		return path
+	}
+	return ConfigPath
+}
+
+// configValue returns the value of the named setting for the vault. Problems
+// reading the configuration file are reported by configureSettings, so they
+// are ignored (using the environment and defaults only).
+func configValue(name, vaultName string) string {
+	config, err := currentConfig()
+	if err != nil {
+		config = &Config{}
+	}
+	value, _ := config.Lookup(findSetting(name), vaultName)
+	return value
+}
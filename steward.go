@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/miquella/ask"
@@ -14,26 +15,56 @@ import (
 )
 
 func NewSteward() vaulted.Steward {
-	if askpass, present := os.LookupEnv("VAULTED_ASKPASS"); present {
+	return &ConfiguredSteward{}
+}
+
+// ConfiguredSteward requests passwords (and MFA tokens and keyfiles) with the
+// askpass program configured for the vault, or the terminal if there isn't
+// one.
+type ConfiguredSteward struct{}
+
+type keyfileSteward interface {
+	vaulted.Steward
+	vaulted.StewardKeyfile
+}
+
+func (s *ConfiguredSteward) steward(name string) keyfileSteward {
+	if askpass := configValue("askpass", name); askpass != "" {
 		return &AskPassSteward{
 			Command: askpass,
 		}
-	} else {
-		return &TTYSteward{}
 	}
+	return &TTYSteward{}
 }
 
-type AskPassSteward struct {
-	Command string
+func (s *ConfiguredSteward) GetMaxOpenTries() int {
+	return s.GetMaxOpenTriesForVault("")
 }
 
-func (t *AskPassSteward) GetMaxOpenTries() int {
+func (s *ConfiguredSteward) GetMaxOpenTriesForVault(name string) int {
 	if _, present := os.LookupEnv("VAULTED_PASSWORD"); present {
 		return 1
 	}
 
-	// we'll try askpass up to 3 times
-	return 3
+	// the setting has already been validated
+	tries, _ := strconv.Atoi(configValue("password_tries", name))
+	return tries
+}
+
+func (s *ConfiguredSteward) GetPassword(operation vaulted.Operation, name string) (string, error) {
+	return s.steward(name).GetPassword(operation, name)
+}
+
+func (s *ConfiguredSteward) GetMFAToken(name string) (string, error) {
+	return s.steward(name).GetMFAToken(name)
+}
+
+func (s *ConfiguredSteward) GetKeyfile(name string) (string, error) {
+	return s.steward(name).GetKeyfile(name)
+}
+
+type AskPassSteward struct {
+	Command string
 }
 
 func (t *AskPassSteward) GetPassword(operation vaulted.Operation, name string) (string, error) {
@@ -89,6 +120,19 @@ func (t *AskPassSteward) GetMFAToken(name string) (string, error) {
 	return t.askpass(fmt.Sprintf("'%s' MFA token: ", name))
 }
 
+func (t *AskPassSteward) GetKeyfile(name string) (string, error) {
+	if keyfile := keyfileFromArgsOrEnv(); keyfile != "" {
+		return keyfile, nil
+	}
+
+	keyfile, err := t.askpass(fmt.Sprintf("'%s' keyfile: ", name))
+	if err != nil || keyfile == "" {
+		return "", ErrNoKeyfileEntered
+	}
+
+	return keyfile, nil
+}
+
 func (t *AskPassSteward) askpass(prompt string) (string, error) {
 	cmd := exec.Command(t.Command, prompt)
 	output, err := cmd.Output()
@@ -101,15 +145,6 @@ func (t *AskPassSteward) askpass(prompt string) (string, error) {
 
 type TTYSteward struct{}
 
-func (t *TTYSteward) GetMaxOpenTries() int {
-	if _, present := os.LookupEnv("VAULTED_PASSWORD"); present {
-		return 1
-	}
-
-	// we'll try tty prompt up to 3 times
-	return 3
-}
-
 func (t *TTYSteward) GetPassword(operation vaulted.Operation, name string) (string, error) {
 	// environment variables take precedence
 	switch operation {
@@ -178,3 +213,31 @@ func (t *TTYSteward) GetMFAToken(name string) (string, error) {
 
 	return "", ErrNoMFATokenEntered
 }
+
+func (t *TTYSteward) GetKeyfile(name string) (string, error) {
+	if keyfile := keyfileFromArgsOrEnv(); keyfile != "" {
+		return keyfile, nil
+	}
+
+	keyfile, err := ask.Ask("   Keyfile: ")
+	if err != nil {
+		return "", err
+	}
+
+	keyfile = strings.TrimSpace(keyfile)
+	if keyfile == "" {
+		return "", ErrNoKeyfileEntered
+	}
+
+	return keyfile, nil
+}
+
+// keyfileFromArgsOrEnv returns the keyfile specified with --keyfile (or the
+// VAULTED_KEYFILE environment variable), if any.
+func keyfileFromArgsOrEnv() string {
+	if Keyfile != "" {
+		return Keyfile
+	}
+
+	return os.Getenv("VAULTED_KEYFILE")
+}
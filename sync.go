@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type Sync struct {
+	SyncOptions vaulted.SyncOptions
+}
+
+func (s *Sync) Run(store vaulted.Store) error {
+	result, err := store.SyncVaults(&s.SyncOptions)
+	if result != nil {
+		for _, name := range result.Updated {
+			fmt.Printf("%s: updated\n", name)
+		}
+		for _, name := range result.Conflicts {
+			if copyName, exists := result.RemoteCopies[name]; exists {
+				fmt.Printf("%s: changed locally and remotely (kept local version, remote version saved as %s)\n", name, copyName)
+			} else if s.SyncOptions.KeepBoth {
+				fmt.Printf("%s: changed locally and remotely (kept local version, removed remotely)\n", name)
+			} else {
+				fmt.Printf("%s: changed locally and remotely\n", name)
+			}
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSync(t *testing.T) {
+	store := NewTestStore()
+
+	s := Sync{
+		SyncOptions: vaulted.SyncOptions{
+			Remote:   "git@example.com:vaults.git",
+			KeepBoth: true,
+		},
+	}
+	err := s.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !store.Synced["git@example.com:vaults.git"].KeepBoth {
+		t.Fatalf("Expected vaults to be synced with the sync options, got: %#v", store.Synced)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type TrashList struct{}
+
+func (l *TrashList) Run(store vaulted.Store) error {
+	trashed, err := store.ListTrash()
+	if err != nil {
+		return err
+	}
+
+	for _, t := range trashed {
+		fmt.Printf("%s  %s\n", t.Name, t.Removed.Format("2 Jan 2006 15:04:05 MST"))
+	}
+
+	return nil
+}
+
+type TrashRestore struct {
+	VaultName string
+}
+
+func (r *TrashRestore) Run(store vaulted.Store) error {
+	return store.RestoreTrashedVault(r.VaultName)
+}
+
+type TrashPurge struct {
+	OlderThan time.Duration
+}
+
+func (p *TrashPurge) Run(store vaulted.Store) error {
+	return store.PurgeTrash(time.Now().Add(-p.OlderThan))
+}
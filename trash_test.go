@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestTrashList(t *testing.T) {
+	store := NewTestStore()
+	store.Trash["one"] = &vaulted.Vault{}
+	store.Trash["two"] = &vaulted.Vault{}
+
+	output := CaptureStdout(func() {
+		l := TrashList{}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := "one  2 Jan 2006 22:04:05 UTC\ntwo  2 Jan 2006 22:04:05 UTC\n"
+	if string(output) != expected {
+		t.Fatalf("Incorrect output!\nExpected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTrashRestore(t *testing.T) {
+	store := NewTestStore()
+	store.Trash["one"] = &vaulted.Vault{}
+
+	r := TrashRestore{
+		VaultName: "one",
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !store.VaultExists("one") {
+		t.Fatal("The vault 'one' was not restored")
+	}
+
+	err = r.Run(store)
+	if err != vaulted.ErrVaultExists {
+		t.Fatalf("Expected ErrVaultExists, got: %v", err)
+	}
+}
+
+func TestTrashPurge(t *testing.T) {
+	store := NewTestStore()
+	store.Trash["one"] = &vaulted.Vault{}
+
+	p := TrashPurge{
+		OlderThan: time.Since(time.Unix(1136239445, 0)) + time.Hour,
+	}
+	err := p.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Trash) != 1 {
+		t.Fatal("The trash was purged of vaults that were too recently removed")
+	}
+
+	p = TrashPurge{}
+	err = p.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Trash) != 0 {
+		t.Fatal("The trash was not purged")
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type Verify struct {
+	VaultNames []string
+	Open       bool
+}
+
+func (v *Verify) Run(store vaulted.Store) error {
+	names := v.VaultNames
+	if len(names) == 0 {
+		var err error
+		names, err = store.ListVaults()
+		if err != nil {
+			return err
+		}
+		sort.Strings(names)
+	}
+
+	failures := 0
+	for _, name := range names {
+		report, err := store.VerifyVault(name, &vaulted.VerifyOptions{Open: v.Open})
+		if os.IsNotExist(err) {
+			err = ErrVaultNotExist
+		}
+		if err != nil {
+			report = &vaulted.VaultReport{
+				Name:     name,
+				Problems: []string{err.Error()},
+			}
+		}
+
+		printVaultReport(report)
+		if !report.OK() {
+			failures++
+		}
+	}
+
+	// orphaned session caches are only found when verifying every vault
+	if len(v.VaultNames) == 0 {
+		err := v.reportOrphanedSessionCaches(store)
+		if err != nil {
+			return err
+		}
+	}
+
+	if failures > 0 {
+		return ErrorWithExitCode{
+			errors.New("Problems were found with some vaults"),
+			failures,
+		}
+	}
+
+	return nil
+}
+
+func (v *Verify) reportOrphanedSessionCaches(store vaulted.Store) error {
+	names, err := store.ListSessionCaches()
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		if store.VaultExists(name) {
+			continue
+		}
+
+		printVaultReport(&vaulted.VaultReport{
+			Name:     name,
+			Warnings: []string{"The session cache belongs to a vault that no longer exists (see 'vaulted session purge --orphaned')"},
+		})
+	}
+
+	return nil
+}
+
+func printVaultReport(report *vaulted.VaultReport) {
+	status := "ok"
+	if !report.OK() {
+		status = "problems found"
+	}
+	fmt.Printf("%s: %s\n", report.Name, status)
+
+	for _, problem := range report.Problems {
+		fmt.Printf("  problem: %s\n", problem)
+	}
+	for _, warning := range report.Warnings {
+		fmt.Printf("  warning: %s\n", warning)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVerify(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{
+		AWSKey: &vaulted.AWSKey{},
+	}
+	store.Sessions["three"] = &vaulted.Session{}
+
+	var err error
+	output := CaptureStdout(func() {
+		v := Verify{}
+		err = v.Run(store)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "" +
+		"one: ok\n" +
+		"two: ok\n" +
+		"three: ok\n" +
+		"  warning: The session cache belongs to a vault that no longer exists (see 'vaulted session purge --orphaned')\n"
+	if string(output) != expected {
+		t.Fatalf("Incorrect output!\nExpected:\n%s\ngot:\n%s", expected, output)
+	}
+
+	output = CaptureStdout(func() {
+		v := Verify{
+			VaultNames: []string{"two", "missing"},
+			Open:       true,
+		}
+		err = v.Run(store)
+	})
+	if exiterr, ok := err.(ErrorWithExitCode); !ok || exiterr.ExitCode != 2 {
+		t.Fatalf("Expected an exit code of 2, got: %v", err)
+	}
+
+	expected = "" +
+		"two: problems found\n" +
+		"  problem: The AWS key is missing its ID or secret\n" +
+		"missing: problems found\n" +
+		"  problem: The vault does not exist\n"
+	if string(output) != expected {
+		t.Fatalf("Incorrect output!\nExpected:\n%s\ngot:\n%s", expected, output)
+	}
+}